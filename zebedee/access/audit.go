@@ -0,0 +1,298 @@
+package access
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+)
+
+// AuditAPI defines the Zebedee endpoints used to audit access.
+type AuditAPI interface {
+	API
+	zebedee.KeyringAPI
+}
+
+// Flag is a problem found by an access audit.
+type Flag string
+
+// Possible values for Flag
+const (
+	// FlagInactiveAdmin is an inactive user who still has admin permission.
+	FlagInactiveAdmin Flag = "inactive-admin"
+	// FlagNoTeam is a user who is not a member of any team.
+	FlagNoTeam Flag = "no-team"
+	// FlagStaleLastAdmin is a user whose last admin is no longer an active admin.
+	FlagStaleLastAdmin Flag = "stale-last-admin"
+	// FlagTemporaryPassword is an editor who has never changed the temporary password set by an admin.
+	FlagTemporaryPassword Flag = "temporary-password"
+	// FlagEmptyTeam is a team with no members.
+	FlagEmptyTeam Flag = "empty-team"
+)
+
+// AuditOptions are the options for Audit.
+type AuditOptions struct {
+	// Sessions of other users to list the keyrings of. Zebedee only lists the keyring of the session user, so keyrings
+	// are listed for the auditor and these sessions only. A session without an email, such as a token session, cannot
+	// be matched to a user, so its keyring is reported in AuditReport.UnattributedKeyrings.
+	Sessions []zebedee.Session
+}
+
+// UserAudit is the access of a single user.
+type UserAudit struct {
+	Name              string   `json:"name"`
+	Email             string   `json:"email"`
+	Admin             bool     `json:"admin"`
+	Editor            bool     `json:"editor"`
+	Inactive          bool     `json:"inactive"`
+	TemporaryPassword bool     `json:"temporaryPassword"`
+	LastAdmin         string   `json:"lastAdmin,omitempty"`
+	Teams             []string `json:"teams"`
+	// Keyring is the IDs of the collections the user has keys for, or nil if it was not listed.
+	Keyring []string `json:"keyring,omitempty"`
+	Flags   []Flag   `json:"flags"`
+}
+
+// TeamAudit is the members of a single team.
+type TeamAudit struct {
+	Name    string   `json:"name"`
+	Members []string `json:"members"`
+	Flags   []Flag   `json:"flags"`
+}
+
+// AuditReport is the access of every user and team in Zebedee, ordered by email and team name.
+type AuditReport struct {
+	GeneratedAt time.Time   `json:"generatedAt"`
+	Users       []UserAudit `json:"users"`
+	Teams       []TeamAudit `json:"teams"`
+	// UnattributedKeyrings is the keyring of each session without an email, which could not be matched to a user.
+	UnattributedKeyrings [][]string `json:"unattributedKeyrings,omitempty"`
+}
+
+// Audit list the permissions and teams of every user and the members of every team, flagging access that should be
+// reviewed.
+func Audit(ctx context.Context, cli AuditAPI, s zebedee.Session, opts AuditOptions) (AuditReport, error) {
+	report := AuditReport{GeneratedAt: time.Now().UTC()}
+
+	users, err := cli.GetUsersContext(ctx, s)
+	if err != nil {
+		return report, err
+	}
+
+	teams, err := cli.ListTeamsContext(ctx, s)
+	if err != nil {
+		return report, err
+	}
+
+	keyrings := make(map[string][]string)
+	for _, session := range append([]zebedee.Session{s}, opts.Sessions...) {
+		keys, err := cli.ListUserKeyringContext(ctx, session)
+		if err != nil {
+			return report, fmt.Errorf("list keyring of %s: %w", sessionName(session), err)
+		}
+		if session.Email == "" {
+			report.UnattributedKeyrings = append(report.UnattributedKeyrings, sorted(keys))
+			continue
+		}
+		keyrings[session.Email] = sorted(keys)
+	}
+
+	memberOf := make(map[string][]string)
+	report.Teams = make([]TeamAudit, 0, len(teams.Teams))
+	for _, t := range teams.Teams {
+		team := TeamAudit{Name: t.Name, Members: sorted(t.Members), Flags: make([]Flag, 0)}
+		if len(team.Members) == 0 {
+			team.Flags = append(team.Flags, FlagEmptyTeam)
+		}
+		for _, m := range t.Members {
+			memberOf[m] = append(memberOf[m], t.Name)
+		}
+		report.Teams = append(report.Teams, team)
+	}
+	sort.Slice(report.Teams, func(i, j int) bool { return report.Teams[i].Name < report.Teams[j].Name })
+
+	report.Users = make([]UserAudit, 0, len(users))
+	for _, u := range users {
+		p, err := cli.GetPermissionsContext(ctx, s, u.Email)
+		if err != nil {
+			return report, fmt.Errorf("get permissions of %s: %w", u.Email, err)
+		}
+
+		report.Users = append(report.Users, UserAudit{
+			Name:              u.Name,
+			Email:             u.Email,
+			Admin:             p.Admin,
+			Editor:            p.Editor,
+			Inactive:          u.Inactive,
+			TemporaryPassword: u.TemporaryPassword,
+			LastAdmin:         u.LastAdmin,
+			Teams:             sorted(memberOf[u.Email]),
+			Keyring:           keyrings[u.Email],
+		})
+	}
+	sort.Slice(report.Users, func(i, j int) bool { return report.Users[i].Email < report.Users[j].Email })
+
+	activeAdmins := make(map[string]bool)
+	for _, u := range report.Users {
+		if u.Admin && !u.Inactive {
+			activeAdmins[u.Email] = true
+		}
+	}
+
+	for i := range report.Users {
+		u := &report.Users[i]
+		u.Flags = make([]Flag, 0)
+		if u.Inactive && u.Admin {
+			u.Flags = append(u.Flags, FlagInactiveAdmin)
+		}
+		if len(u.Teams) == 0 {
+			u.Flags = append(u.Flags, FlagNoTeam)
+		}
+		if u.LastAdmin != "" && !activeAdmins[u.LastAdmin] {
+			u.Flags = append(u.Flags, FlagStaleLastAdmin)
+		}
+		if u.Editor && u.TemporaryPassword {
+			u.Flags = append(u.Flags, FlagTemporaryPassword)
+		}
+	}
+
+	return report, nil
+}
+
+// sessionName return the email of the session, or a description if it has none
+func sessionName(s zebedee.Session) string {
+	if s.Email == "" {
+		return "session without an email"
+	}
+	return s.Email
+}
+
+// FlagCounts return the number of users and teams with each flag.
+func (r AuditReport) FlagCounts() map[Flag]int {
+	counts := make(map[Flag]int)
+	for _, u := range r.Users {
+		for _, f := range u.Flags {
+			counts[f]++
+		}
+	}
+	for _, t := range r.Teams {
+		for _, f := range t.Flags {
+			counts[f]++
+		}
+	}
+	return counts
+}
+
+// WriteJSON write the report as indented JSON.
+func (r AuditReport) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteCSV write the report as CSV, with a row for each user followed by a row for each team and for each unattributed
+// keyring. Lists are separated by semicolons.
+func (r AuditReport) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	rows := [][]string{{"type", "name", "email", "admin", "editor", "inactive", "temporaryPassword", "lastAdmin", "teams", "members", "keyring", "flags"}}
+
+	for _, u := range r.Users {
+		rows = append(rows, []string{
+			"user", u.Name, u.Email,
+			strconv.FormatBool(u.Admin), strconv.FormatBool(u.Editor), strconv.FormatBool(u.Inactive),
+			strconv.FormatBool(u.TemporaryPassword), u.LastAdmin,
+			strings.Join(u.Teams, ";"), "", strings.Join(u.Keyring, ";"), joinFlags(u.Flags, ";"),
+		})
+	}
+	for _, t := range r.Teams {
+		rows = append(rows, []string{
+			"team", t.Name, "", "", "", "", "", "", "", strings.Join(t.Members, ";"), "", joinFlags(t.Flags, ";"),
+		})
+	}
+	for _, keys := range r.UnattributedKeyrings {
+		rows = append(rows, []string{"session", "", "", "", "", "", "", "", "", "", strings.Join(keys, ";"), ""})
+	}
+
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+// WriteMarkdown write the report as Markdown, with a summary of the flags, a table of users and of teams, and a list of
+// any unattributed keyrings.
+func (r AuditReport) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# CMS access audit\n\nGenerated at %s\n\n", r.GeneratedAt.Format(time.RFC3339))
+
+	b.WriteString("## Summary\n\n")
+	counts := r.FlagCounts()
+	if len(counts) == 0 {
+		b.WriteString("No access flagged for review.\n")
+	}
+	for _, f := range []Flag{FlagInactiveAdmin, FlagNoTeam, FlagStaleLastAdmin, FlagTemporaryPassword, FlagEmptyTeam} {
+		if counts[f] > 0 {
+			fmt.Fprintf(&b, "- %s: %d\n", f, counts[f])
+		}
+	}
+
+	b.WriteString("\n## Users\n\n")
+	b.WriteString("| Email | Name | Permissions | Inactive | Last admin | Teams | Keyring | Flags |\n")
+	b.WriteString("| --- | --- | --- | --- | --- | --- | --- | --- |\n")
+	for _, u := range r.Users {
+		fmt.Fprintf(&b, "| %s | %s | %s | %s | %s | %s | %s | %s |\n",
+			markdownCell(u.Email), markdownCell(u.Name),
+			permissionNames(zebedee.Permissions{Admin: u.Admin, Editor: u.Editor}),
+			yesNo(u.Inactive), markdownCell(u.LastAdmin),
+			markdownCell(strings.Join(u.Teams, ", ")), markdownCell(strings.Join(u.Keyring, ", ")),
+			joinFlags(u.Flags, ", "))
+	}
+
+	b.WriteString("\n## Teams\n\n")
+	b.WriteString("| Team | Members | Flags |\n")
+	b.WriteString("| --- | --- | --- |\n")
+	for _, t := range r.Teams {
+		fmt.Fprintf(&b, "| %s | %s | %s |\n", markdownCell(t.Name), markdownCell(strings.Join(t.Members, ", ")), joinFlags(t.Flags, ", "))
+	}
+
+	if len(r.UnattributedKeyrings) > 0 {
+		b.WriteString("\n## Sessions without an email\n\n")
+		b.WriteString("These keyrings could not be matched to a user.\n\n")
+		for _, keys := range r.UnattributedKeyrings {
+			fmt.Fprintf(&b, "- %s\n", markdownCell(strings.Join(keys, ", ")))
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func joinFlags(flags []Flag, sep string) string {
+	s := make([]string, 0, len(flags))
+	for _, f := range flags {
+		s = append(s, string(f))
+	}
+	return strings.Join(s, sep)
+}
+
+var markdownEscaper = strings.NewReplacer("|", "\\|", "\n", " ")
+
+// markdownCell escape the value for use in a Markdown table cell
+func markdownCell(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
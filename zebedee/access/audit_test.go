@@ -0,0 +1,122 @@
+package access_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee/access"
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee/zebedeetest"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAudit(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("Given a Zebedee with users and teams needing review", t, func() {
+		fake := zebedeetest.NewServer()
+		defer fake.Close()
+
+		fake.AddUser(zebedee.User{Name: "Admin", Email: adminEmail}, "password", zebedee.Permissions{Admin: true, Editor: true})
+		fake.AddUser(zebedee.User{Name: "Leaver", Email: "leaver@ons.gov.uk", Inactive: true}, "password", zebedee.Permissions{Admin: true})
+		fake.AddUser(zebedee.User{Name: "Starter", Email: "starter@ons.gov.uk", TemporaryPassword: true, LastAdmin: "leaver@ons.gov.uk"}, "password", zebedee.Permissions{Editor: true})
+
+		cli := zebedee.NewClient(fake.URL, fake.HttpClient())
+		s, _ := fake.NewSession(adminEmail)
+
+		_, err := cli.CreateTeam(s, "census")
+		So(err, ShouldBeNil)
+		So(cli.AddTeamMember(s, "census", adminEmail), ShouldBeNil)
+		So(cli.AddTeamMember(s, "census", "starter@ons.gov.uk"), ShouldBeNil)
+		_, err = cli.CreateTeam(s, "old-team")
+		So(err, ShouldBeNil)
+
+		desc, err := cli.CreateCollection(s, zebedee.NewCollection("Census"))
+		So(err, ShouldBeNil)
+
+		Convey("When access is audited", func() {
+			report, err := access.Audit(ctx, cli, s, access.AuditOptions{})
+			So(err, ShouldBeNil)
+
+			Convey("Then each user is listed with their permissions, teams and flags", func() {
+				So(report.Users, ShouldHaveLength, 3)
+
+				So(report.Users[0].Email, ShouldEqual, adminEmail)
+				So(report.Users[0].Teams, ShouldResemble, []string{"census"})
+				So(report.Users[0].Keyring, ShouldResemble, []string{desc.ID})
+				So(report.Users[0].Flags, ShouldBeEmpty)
+
+				So(report.Users[1].Email, ShouldEqual, "leaver@ons.gov.uk")
+				So(report.Users[1].Keyring, ShouldBeNil)
+				So(report.Users[1].Flags, ShouldResemble, []access.Flag{access.FlagInactiveAdmin, access.FlagNoTeam})
+
+				So(report.Users[2].Email, ShouldEqual, "starter@ons.gov.uk")
+				So(report.Users[2].Flags, ShouldResemble, []access.Flag{access.FlagStaleLastAdmin, access.FlagTemporaryPassword})
+			})
+
+			Convey("Then each team is listed with the empty team flagged", func() {
+				So(report.Teams, ShouldResemble, []access.TeamAudit{
+					{Name: "census", Members: []string{adminEmail, "starter@ons.gov.uk"}, Flags: []access.Flag{}},
+					{Name: "old-team", Members: []string{}, Flags: []access.Flag{access.FlagEmptyTeam}},
+				})
+				So(report.FlagCounts()[access.FlagEmptyTeam], ShouldEqual, 1)
+			})
+
+			Convey("Then the report is written as JSON", func() {
+				var b bytes.Buffer
+				So(report.WriteJSON(&b), ShouldBeNil)
+
+				var decoded access.AuditReport
+				So(json.Unmarshal(b.Bytes(), &decoded), ShouldBeNil)
+				So(decoded.Users, ShouldResemble, report.Users)
+			})
+
+			Convey("Then the report is written as CSV with a row for each user and team", func() {
+				var b bytes.Buffer
+				So(report.WriteCSV(&b), ShouldBeNil)
+
+				rows, err := csv.NewReader(&b).ReadAll()
+				So(err, ShouldBeNil)
+				So(rows, ShouldHaveLength, 6)
+				So(rows[0][0], ShouldEqual, "type")
+				So(rows[2], ShouldResemble, []string{"user", "Leaver", "leaver@ons.gov.uk", "true", "false", "true", "false", "", "", "", "", "inactive-admin;no-team"})
+				So(rows[5], ShouldResemble, []string{"team", "old-team", "", "", "", "", "", "", "", "", "", "empty-team"})
+			})
+
+			Convey("Then the report is written as Markdown", func() {
+				var b bytes.Buffer
+				So(report.WriteMarkdown(&b), ShouldBeNil)
+				So(b.String(), ShouldContainSubstring, "- inactive-admin: 1\n")
+				So(b.String(), ShouldContainSubstring, "| leaver@ons.gov.uk | Leaver | admin | yes |  |  |  | inactive-admin, no-team |\n")
+				So(b.String(), ShouldContainSubstring, "| old-team |  | empty-team |\n")
+				So(b.String(), ShouldNotContainSubstring, "Sessions without an email")
+			})
+		})
+
+		Convey("When access is audited with a session which has no email", func() {
+			report, err := access.Audit(ctx, cli, zebedee.Session{ID: s.ID}, access.AuditOptions{})
+			So(err, ShouldBeNil)
+
+			Convey("Then its keyring is not attributed to a user but is reported separately", func() {
+				So(report.Users[0].Email, ShouldEqual, adminEmail)
+				So(report.Users[0].Keyring, ShouldBeNil)
+				So(report.UnattributedKeyrings, ShouldResemble, [][]string{{desc.ID}})
+			})
+
+			Convey("Then the keyring is written in the CSV and Markdown", func() {
+				var b bytes.Buffer
+				So(report.WriteCSV(&b), ShouldBeNil)
+				rows, err := csv.NewReader(&b).ReadAll()
+				So(err, ShouldBeNil)
+				So(rows[len(rows)-1], ShouldResemble, []string{"session", "", "", "", "", "", "", "", "", "", desc.ID, ""})
+
+				b.Reset()
+				So(report.WriteMarkdown(&b), ShouldBeNil)
+				So(b.String(), ShouldContainSubstring, "## Sessions without an email\n\nThese keyrings could not be matched to a user.\n\n- "+desc.ID+"\n")
+			})
+		})
+	})
+}
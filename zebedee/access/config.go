@@ -0,0 +1,135 @@
+// Package access manages the users, teams and permissions in Zebedee CMS, reconciling them with a desired state
+// document, provisioning users in bulk from a CSV file or auditing the access of existing users.
+//
+//	cfg, err := access.LoadFile("access.yaml")
+//	plan, err := access.Reconcile(ctx, cli, s, cfg, access.Options{DryRun: true})
+//	fmt.Print(plan)
+package access
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrInvalidConfig is returned when the desired state document is not valid.
+var ErrInvalidConfig = errors.New("invalid access config")
+
+// Config is the desired state of the users and teams in Zebedee CMS.
+type Config struct {
+	Users []User `json:"users" yaml:"users"`
+	Teams []Team `json:"teams" yaml:"teams"`
+	// Prune deletes the users and teams which are not in the config.
+	Prune bool `json:"prune" yaml:"prune"`
+}
+
+// User is a CMS user and their permissions. The name is required by Zebedee but is only used when creating the user.
+type User struct {
+	Name   string `json:"name" yaml:"name"`
+	Email  string `json:"email" yaml:"email"`
+	Admin  bool   `json:"admin" yaml:"admin"`
+	Editor bool   `json:"editor" yaml:"editor"`
+}
+
+// Team is a CMS team and the emails of its members.
+type Team struct {
+	Name    string   `json:"name" yaml:"name"`
+	Members []string `json:"members" yaml:"members"`
+}
+
+// Load read the desired state document as JSON, rejecting unknown fields.
+func Load(r io.Reader) (Config, error) {
+	var cfg Config
+
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&cfg); err != nil {
+		return Config{}, fmt.Errorf("%w: %v", ErrInvalidConfig, err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// LoadYAML read the desired state document as YAML, rejecting unknown fields.
+func LoadYAML(r io.Reader) (Config, error) {
+	var cfg Config
+
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	if err := dec.Decode(&cfg); err != nil {
+		return Config{}, fmt.Errorf("%w: %v", ErrInvalidConfig, err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// LoadFile read the desired state document from the file, as YAML if it has a .yaml or .yml extension and as JSON
+// otherwise. See Load and LoadYAML.
+func LoadFile(name string) (Config, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return Config{}, err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml":
+		return LoadYAML(f)
+	default:
+		return Load(f)
+	}
+}
+
+// Validate check each user has a name and email and each team has a name, and that no user or team is listed more
+// than once.
+func (cfg Config) Validate() error {
+	var problems []string
+
+	users := make(map[string]bool)
+	for i, u := range cfg.Users {
+		switch {
+		case strings.TrimSpace(u.Email) == "":
+			problems = append(problems, fmt.Sprintf("users[%d].email is required", i))
+		case users[u.Email]:
+			problems = append(problems, fmt.Sprintf("users[%d].email %s is listed more than once", i, u.Email))
+		}
+		if strings.TrimSpace(u.Name) == "" {
+			problems = append(problems, fmt.Sprintf("users[%d].name is required", i))
+		}
+		users[u.Email] = true
+	}
+
+	teams := make(map[string]bool)
+	for i, t := range cfg.Teams {
+		switch {
+		case strings.TrimSpace(t.Name) == "":
+			problems = append(problems, fmt.Sprintf("teams[%d].name is required", i))
+		case teams[t.Name]:
+			problems = append(problems, fmt.Sprintf("teams[%d].name %s is listed more than once", i, t.Name))
+		}
+		teams[t.Name] = true
+
+		for j, m := range t.Members {
+			if strings.TrimSpace(m) == "" {
+				problems = append(problems, fmt.Sprintf("teams[%d].members[%d] is empty", i, j))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidConfig, strings.Join(problems, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package access
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLoad(t *testing.T) {
+	Convey("Given a valid desired state document", t, func() {
+		doc := `{
+			"users": [{"name": "Editor", "email": "editor@ons.gov.uk", "editor": true}],
+			"teams": [{"name": "census", "members": ["editor@ons.gov.uk"]}],
+			"prune": true
+		}`
+
+		Convey("Then it is loaded", func() {
+			cfg, err := Load(strings.NewReader(doc))
+			So(err, ShouldBeNil)
+			So(cfg, ShouldResemble, Config{
+				Users: []User{{Name: "Editor", Email: "editor@ons.gov.uk", Editor: true}},
+				Teams: []Team{{Name: "census", Members: []string{"editor@ons.gov.uk"}}},
+				Prune: true,
+			})
+		})
+	})
+
+	Convey("Given a valid desired state document in YAML", t, func() {
+		doc := `
+users:
+  - name: Editor
+    email: editor@ons.gov.uk
+    editor: true
+teams:
+  - name: census
+    members: [editor@ons.gov.uk]
+prune: true
+`
+
+		Convey("Then it is loaded", func() {
+			cfg, err := LoadYAML(strings.NewReader(doc))
+			So(err, ShouldBeNil)
+			So(cfg, ShouldResemble, Config{
+				Users: []User{{Name: "Editor", Email: "editor@ons.gov.uk", Editor: true}},
+				Teams: []Team{{Name: "census", Members: []string{"editor@ons.gov.uk"}}},
+				Prune: true,
+			})
+		})
+
+		Convey("Then it is loaded from a file with a .yaml extension", func() {
+			name := filepath.Join(t.TempDir(), "access.yaml")
+			So(os.WriteFile(name, []byte(doc), 0600), ShouldBeNil)
+
+			cfg, err := LoadFile(name)
+			So(err, ShouldBeNil)
+			So(cfg.Users, ShouldHaveLength, 1)
+		})
+	})
+
+	Convey("Given a YAML document with an unknown field", t, func() {
+		_, err := LoadYAML(strings.NewReader("users:\n  - name: Editor\n    email: editor@ons.gov.uk\n    viewer: true\n"))
+
+		Convey("Then an invalid config error is returned", func() {
+			So(errors.Is(err, ErrInvalidConfig), ShouldBeTrue)
+			So(err.Error(), ShouldContainSubstring, "viewer")
+		})
+	})
+
+	Convey("Given a document with an unknown field", t, func() {
+		_, err := Load(strings.NewReader(`{"users": [{"name": "Editor", "email": "editor@ons.gov.uk", "viewer": true}]}`))
+
+		Convey("Then an invalid config error is returned", func() {
+			So(errors.Is(err, ErrInvalidConfig), ShouldBeTrue)
+			So(err.Error(), ShouldContainSubstring, "viewer")
+		})
+	})
+
+	Convey("Given a document with missing and duplicate values", t, func() {
+		_, err := Load(strings.NewReader(`{
+			"users": [{"name": "Editor", "email": "editor@ons.gov.uk"}, {"email": "editor@ons.gov.uk"}],
+			"teams": [{"name": ""}, {"name": "census", "members": [""]}]
+		}`))
+
+		Convey("Then each problem is listed in the error", func() {
+			So(errors.Is(err, ErrInvalidConfig), ShouldBeTrue)
+			So(err.Error(), ShouldContainSubstring, "users[1].email editor@ons.gov.uk is listed more than once")
+			So(err.Error(), ShouldContainSubstring, "users[1].name is required")
+			So(err.Error(), ShouldContainSubstring, "teams[0].name is required")
+			So(err.Error(), ShouldContainSubstring, "teams[1].members[0] is empty")
+		})
+	})
+}
@@ -0,0 +1,292 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+)
+
+// API defines the Zebedee endpoints used to reconcile users, teams and permissions.
+type API interface {
+	zebedee.UsersAPI
+	zebedee.PermissionsAPI
+	zebedee.TeamsAPI
+}
+
+// ErrSessionEmail is returned when a pruned config is planned with a session without an email, such as a JWT session,
+// as the user of the session could not be protected from deletion.
+var ErrSessionEmail = errors.New("session email required to prune users")
+
+// Action is the type of a change in a plan.
+type Action string
+
+// Possible values for Action, in the order they are applied
+const (
+	CreateUser        Action = "create user"
+	UpdatePermissions Action = "update permissions"
+	CreateTeam        Action = "create team"
+	AddMember         Action = "add member"
+	RemoveMember      Action = "remove member"
+	DeleteTeam        Action = "delete team"
+	DeleteUser        Action = "delete user"
+)
+
+var actionOrder = map[Action]int{
+	CreateUser:        0,
+	UpdatePermissions: 1,
+	CreateTeam:        2,
+	AddMember:         3,
+	RemoveMember:      4,
+	DeleteTeam:        5,
+	DeleteUser:        6,
+}
+
+// Options are the options for Reconcile.
+type Options struct {
+	// DryRun plans the changes without applying them.
+	DryRun bool
+}
+
+// Change is a single change to the users, teams or permissions.
+type Change struct {
+	Action Action
+	// Email of the user changed or the team member added or removed.
+	Email string
+	// Name of the user created.
+	Name string
+	// Team is the name of the team changed.
+	Team string
+	// From and To are the permissions of the user before and after the change.
+	From zebedee.Permissions
+	To   zebedee.Permissions
+}
+
+// Plan is the changes needed to reconcile Zebedee with the desired state.
+type Plan struct {
+	Changes []Change
+}
+
+// Reconcile plan the changes needed for Zebedee to match the config and apply them, unless it is a dry run. The plan is
+// returned in either case, including when an error is returned while applying it.
+func Reconcile(ctx context.Context, cli API, s zebedee.Session, cfg Config, opts Options) (Plan, error) {
+	plan, err := NewPlan(ctx, cli, s, cfg)
+	if err != nil || opts.DryRun {
+		return plan, err
+	}
+
+	return plan, plan.Apply(ctx, cli, s)
+}
+
+// NewPlan compare the config with the users, permissions and teams in Zebedee, returning the changes needed for Zebedee
+// to match the config. Users and teams not in the config are only deleted if the config is pruned, and the user of
+// the session is never deleted. Pruning requires a session with an email so the user of the session is known.
+func NewPlan(ctx context.Context, cli API, s zebedee.Session, cfg Config) (Plan, error) {
+	var plan Plan
+
+	if err := cfg.Validate(); err != nil {
+		return plan, err
+	}
+
+	if cfg.Prune && s.Email == "" {
+		return plan, ErrSessionEmail
+	}
+
+	users, err := cli.GetUsersContext(ctx, s)
+	if err != nil {
+		return plan, err
+	}
+	liveUsers := make(map[string]bool)
+	for _, u := range users {
+		liveUsers[u.Email] = true
+	}
+
+	configUsers := make(map[string]bool)
+	for _, u := range cfg.Users {
+		configUsers[u.Email] = true
+		want := zebedee.Permissions{Email: u.Email, Admin: u.Admin, Editor: u.Editor}
+
+		if !liveUsers[u.Email] {
+			plan.add(Change{Action: CreateUser, Email: u.Email, Name: u.Name, To: want})
+			continue
+		}
+
+		have, err := cli.GetPermissionsContext(ctx, s, u.Email)
+		if err != nil {
+			return plan, err
+		}
+		if have.Admin != want.Admin || have.Editor != want.Editor {
+			plan.add(Change{Action: UpdatePermissions, Email: u.Email, From: have, To: want})
+		}
+	}
+
+	teams, err := cli.ListTeamsContext(ctx, s)
+	if err != nil {
+		return plan, err
+	}
+	liveTeams := make(map[string]bool)
+	for _, t := range teams.Teams {
+		liveTeams[t.Name] = true
+	}
+
+	configTeams := make(map[string]bool)
+	for _, t := range cfg.Teams {
+		configTeams[t.Name] = true
+
+		var members []string
+		if liveTeams[t.Name] {
+			team, err := cli.GetTeamContext(ctx, s, t.Name)
+			if err != nil {
+				return plan, err
+			}
+			members = team.Members
+		} else {
+			plan.add(Change{Action: CreateTeam, Team: t.Name})
+		}
+
+		for _, m := range t.Members {
+			if !contains(members, m) {
+				plan.add(Change{Action: AddMember, Team: t.Name, Email: m})
+			}
+		}
+		for _, m := range sorted(members) {
+			if !contains(t.Members, m) {
+				plan.add(Change{Action: RemoveMember, Team: t.Name, Email: m})
+			}
+		}
+	}
+
+	if cfg.Prune {
+		for _, t := range teams.Teams {
+			if !configTeams[t.Name] {
+				plan.add(Change{Action: DeleteTeam, Team: t.Name})
+			}
+		}
+		for _, u := range users {
+			if !configUsers[u.Email] && u.Email != s.Email {
+				plan.add(Change{Action: DeleteUser, Email: u.Email})
+			}
+		}
+	}
+
+	sort.SliceStable(plan.Changes, func(i, j int) bool {
+		return actionOrder[plan.Changes[i].Action] < actionOrder[plan.Changes[j].Action]
+	})
+	return plan, nil
+}
+
+// Apply make each change in the plan in order, stopping at the first error. Users are created without a password.
+func (p Plan) Apply(ctx context.Context, cli API, s zebedee.Session) error {
+	for _, c := range p.Changes {
+		if err := c.apply(ctx, cli, s); err != nil {
+			return fmt.Errorf("%s: %w", c, err)
+		}
+	}
+	return nil
+}
+
+func (c Change) apply(ctx context.Context, cli API, s zebedee.Session) error {
+	switch c.Action {
+	case CreateUser:
+		if _, err := cli.CreateUserContext(ctx, s, zebedee.User{Name: c.Name, Email: c.Email}); err != nil {
+			return err
+		}
+		return cli.SetPermissionsContext(ctx, s, c.To)
+	case UpdatePermissions:
+		return cli.SetPermissionsContext(ctx, s, c.To)
+	case CreateTeam:
+		ok, err := cli.CreateTeamContext(ctx, s, c.Team)
+		if err == nil && !ok {
+			err = zebedee.ErrUnsuccessful
+		}
+		return err
+	case AddMember:
+		return cli.AddTeamMemberContext(ctx, s, c.Team, c.Email)
+	case RemoveMember:
+		return cli.RemoveTeamMemberContext(ctx, s, c.Team, c.Email)
+	case DeleteTeam:
+		return cli.DeleteTeamContext(ctx, s, c.Team)
+	case DeleteUser:
+		return cli.DeleteUserContext(ctx, s, c.Email)
+	default:
+		return fmt.Errorf("unknown action %q", c.Action)
+	}
+}
+
+// Empty returns true if there are no changes to make.
+func (p Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String return the plan as a diff, one change per line. Additions are prefixed with +, deletions with - and updates
+// with ~.
+func (p Plan) String() string {
+	if p.Empty() {
+		return "no changes\n"
+	}
+
+	var b strings.Builder
+	for _, c := range p.Changes {
+		b.WriteString(c.String())
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func (c Change) String() string {
+	switch c.Action {
+	case CreateUser:
+		return fmt.Sprintf("+ user %s (%s)", c.Email, permissionNames(c.To))
+	case UpdatePermissions:
+		return fmt.Sprintf("~ user %s permissions: %s -> %s", c.Email, permissionNames(c.From), permissionNames(c.To))
+	case CreateTeam:
+		return fmt.Sprintf("+ team %s", c.Team)
+	case AddMember:
+		return fmt.Sprintf("+ team %s member %s", c.Team, c.Email)
+	case RemoveMember:
+		return fmt.Sprintf("- team %s member %s", c.Team, c.Email)
+	case DeleteTeam:
+		return fmt.Sprintf("- team %s", c.Team)
+	case DeleteUser:
+		return fmt.Sprintf("- user %s", c.Email)
+	default:
+		return string(c.Action)
+	}
+}
+
+func (p *Plan) add(c Change) {
+	p.Changes = append(p.Changes, c)
+}
+
+// permissionNames return the names of the permissions granted, e.g. "admin, editor" or "none"
+func permissionNames(p zebedee.Permissions) string {
+	var names []string
+	if p.Admin {
+		names = append(names, "admin")
+	}
+	if p.Editor {
+		names = append(names, "editor")
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ", ")
+}
+
+func contains(values []string, v string) bool {
+	for _, s := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+func sorted(values []string) []string {
+	s := append([]string{}, values...)
+	sort.Strings(s)
+	return s
+}
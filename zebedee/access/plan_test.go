@@ -0,0 +1,122 @@
+package access_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee/access"
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee/zebedeetest"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const adminEmail = "admin@ons.gov.uk"
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("Given a Zebedee with existing users and teams", t, func() {
+		fake := zebedeetest.NewServer()
+		defer fake.Close()
+
+		fake.AddUser(zebedee.User{Name: "Admin", Email: adminEmail}, "password", zebedee.Permissions{Admin: true, Editor: true})
+		fake.AddUser(zebedee.User{Name: "Editor", Email: "editor@ons.gov.uk"}, "password", zebedee.Permissions{Editor: true})
+		fake.AddUser(zebedee.User{Name: "Leaver", Email: "leaver@ons.gov.uk"}, "password", zebedee.Permissions{Editor: true})
+
+		cli := zebedee.NewClient(fake.URL, fake.HttpClient())
+		s, _ := fake.NewSession(adminEmail)
+
+		_, err := cli.CreateTeam(s, "census")
+		So(err, ShouldBeNil)
+		So(cli.AddTeamMember(s, "census", "leaver@ons.gov.uk"), ShouldBeNil)
+		_, err = cli.CreateTeam(s, "old-team")
+		So(err, ShouldBeNil)
+
+		cfg := access.Config{
+			Users: []access.User{
+				{Name: "Editor", Email: "editor@ons.gov.uk", Admin: true, Editor: true},
+				{Name: "Starter", Email: "starter@ons.gov.uk", Editor: true},
+			},
+			Teams: []access.Team{
+				{Name: "census", Members: []string{"editor@ons.gov.uk", "starter@ons.gov.uk"}},
+				{Name: "gdp", Members: []string{"starter@ons.gov.uk"}},
+			},
+			Prune: true,
+		}
+
+		Convey("When a dry run is reconciled", func() {
+			plan, err := access.Reconcile(ctx, cli, s, cfg, access.Options{DryRun: true})
+			So(err, ShouldBeNil)
+
+			Convey("Then the plan lists the changes in the order they are applied", func() {
+				So(plan.String(), ShouldEqual, `+ user starter@ons.gov.uk (editor)
+~ user editor@ons.gov.uk permissions: editor -> admin, editor
++ team gdp
++ team census member editor@ons.gov.uk
++ team census member starter@ons.gov.uk
++ team gdp member starter@ons.gov.uk
+- team census member leaver@ons.gov.uk
+- team old-team
+- user leaver@ons.gov.uk
+`)
+			})
+
+			Convey("Then nothing is changed", func() {
+				_, err := cli.GetUser(s, "starter@ons.gov.uk")
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When the config is reconciled", func() {
+			_, err := access.Reconcile(ctx, cli, s, cfg, access.Options{})
+			So(err, ShouldBeNil)
+
+			Convey("Then Zebedee matches the config", func() {
+				p, err := cli.GetPermissions(s, "editor@ons.gov.uk")
+				So(err, ShouldBeNil)
+				So(p.Admin, ShouldBeTrue)
+
+				census, err := cli.GetTeam(s, "census")
+				So(err, ShouldBeNil)
+				So(census.Members, ShouldResemble, []string{"editor@ons.gov.uk", "starter@ons.gov.uk"})
+
+				teams, err := cli.ListTeams(s)
+				So(err, ShouldBeNil)
+				So(teams.Teams, ShouldHaveLength, 2)
+
+				users, err := cli.GetUsers(s)
+				So(err, ShouldBeNil)
+				So(users, ShouldHaveLength, 3)
+			})
+
+			Convey("And a second plan has no changes", func() {
+				plan, err := access.NewPlan(ctx, cli, s, cfg)
+				So(err, ShouldBeNil)
+				So(plan.Empty(), ShouldBeTrue)
+				So(plan.String(), ShouldEqual, "no changes\n")
+			})
+		})
+
+		Convey("When the config is pruned with a session without an email", func() {
+			_, err := access.NewPlan(ctx, cli, zebedee.Session{ID: s.ID}, cfg)
+
+			Convey("Then the plan is refused", func() {
+				So(errors.Is(err, access.ErrSessionEmail), ShouldBeTrue)
+			})
+		})
+
+		Convey("When the config is not pruned", func() {
+			cfg.Prune = false
+			plan, err := access.NewPlan(ctx, cli, s, cfg)
+			So(err, ShouldBeNil)
+
+			Convey("Then no users or teams are deleted", func() {
+				for _, c := range plan.Changes {
+					So(c.Action, ShouldNotEqual, access.DeleteUser)
+					So(c.Action, ShouldNotEqual, access.DeleteTeam)
+				}
+			})
+		})
+	})
+}
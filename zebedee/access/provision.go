@@ -0,0 +1,301 @@
+package access
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+)
+
+// DefaultConcurrency is the number of users provisioned at the same time if ProvisionOptions.Concurrency is not set.
+const DefaultConcurrency = 4
+
+// DefaultPasswordLength is the length of the temporary passwords generated if ProvisionOptions.PasswordLength is not set.
+const DefaultPasswordLength = 20
+
+// password characters, excluding those easily confused when read, e.g. 0 and O
+const (
+	passwordLower   = "abcdefghijkmnpqrstuvwxyz"
+	passwordUpper   = "ABCDEFGHJKLMNPQRSTUVWXYZ"
+	passwordDigits  = "23456789"
+	passwordSymbols = "!#$%&*+-=?@^_"
+)
+
+// csvColumns are the columns of a provisioning CSV file. Only name and email are required.
+var csvColumns = []string{"name", "email", "admin", "editor", "teams"}
+
+// NewUser is a user to provision, with the teams they are a member of.
+type NewUser struct {
+	User
+	Teams []string
+	// Line is the line of the CSV file the user was read from.
+	Line int
+}
+
+// ProvisionOptions are the options for Provision.
+type ProvisionOptions struct {
+	// Concurrency is the maximum number of users provisioned at the same time.
+	Concurrency int
+	// PasswordLength is the length of the temporary passwords generated.
+	PasswordLength int
+}
+
+// ProvisionResult is the result of provisioning a single user.
+type ProvisionResult struct {
+	NewUser
+	// Password is the temporary password set for the user.
+	Password string
+	// TemporaryPassword is true if Zebedee will force the user to change their password when they log in.
+	TemporaryPassword bool
+	Err               error
+}
+
+// ProvisionReport is the result of provisioning each user, in the order they were provided.
+type ProvisionReport struct {
+	Results []ProvisionResult
+}
+
+// ReadUsers read the users to provision from a CSV file. The first line is a header naming the columns, which are
+// name, email, admin, editor and teams, in any order. Only name and email are required. Admin and editor are booleans,
+// e.g. true or false, and teams is a list of team names separated by semicolons.
+func ReadUsers(r io.Reader) ([]NewUser, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot read csv header: %v", ErrInvalidConfig, err)
+	}
+
+	index := make(map[string]int)
+	for i, h := range header {
+		name := strings.ToLower(strings.TrimSpace(h))
+		if !contains(csvColumns, name) {
+			return nil, fmt.Errorf("%w: unknown csv column %q", ErrInvalidConfig, h)
+		}
+		index[name] = i
+	}
+	for _, required := range []string{"name", "email"} {
+		if _, ok := index[required]; !ok {
+			return nil, fmt.Errorf("%w: csv column %q is required", ErrInvalidConfig, required)
+		}
+	}
+
+	var users []NewUser
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidConfig, err)
+		}
+
+		line, _ := cr.FieldPos(0)
+		value := func(column string) string {
+			if i, ok := index[column]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		u := NewUser{User: User{Name: value("name"), Email: value("email")}, Line: line}
+		if u.Admin, err = parseBool(value("admin")); err != nil {
+			return nil, fmt.Errorf("%w: line %d: admin: %v", ErrInvalidConfig, line, err)
+		}
+		if u.Editor, err = parseBool(value("editor")); err != nil {
+			return nil, fmt.Errorf("%w: line %d: editor: %v", ErrInvalidConfig, line, err)
+		}
+		for _, t := range strings.Split(value("teams"), ";") {
+			if t = strings.TrimSpace(t); t != "" {
+				u.Teams = append(u.Teams, t)
+			}
+		}
+		users = append(users, u)
+	}
+
+	cfg := Config{Users: make([]User, 0, len(users))}
+	for _, u := range users {
+		cfg.Users = append(cfg.Users, u.User)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// Provision create each user with a generated temporary password, their permissions and team memberships, provisioning
+// up to the configured number of users at the same time. A failure for one user does not stop the others, and the
+// teams must already exist. The report has a result for every user.
+func Provision(ctx context.Context, cli API, s zebedee.Session, users []NewUser, opts ProvisionOptions) ProvisionReport {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	length := opts.PasswordLength
+	if length <= 0 {
+		length = DefaultPasswordLength
+	}
+
+	report := ProvisionReport{Results: make([]ProvisionResult, len(users))}
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, u := range users {
+		report.Results[i] = ProvisionResult{NewUser: u}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			report.Results[i].Err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func(result *ProvisionResult) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			result.Err = provisionUser(ctx, cli, s, result, length)
+		}(&report.Results[i])
+	}
+
+	wg.Wait()
+	return report
+}
+
+func provisionUser(ctx context.Context, cli API, s zebedee.Session, result *ProvisionResult, length int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	password, err := GeneratePassword(length)
+	if err != nil {
+		return err
+	}
+
+	if _, err := cli.CreateUserContext(ctx, s, zebedee.User{Name: result.Name, Email: result.Email}); err != nil {
+		return fmt.Errorf("create user: %w", err)
+	}
+
+	if err := cli.SetPasswordContext(ctx, s, zebedee.Credentials{Email: result.Email, Password: password}); err != nil {
+		return fmt.Errorf("set password: %w", err)
+	}
+	result.Password = password
+
+	p := zebedee.Permissions{Email: result.Email, Admin: result.Admin, Editor: result.Editor}
+	if err := cli.SetPermissionsContext(ctx, s, p); err != nil {
+		return fmt.Errorf("set permissions: %w", err)
+	}
+
+	for _, team := range result.Teams {
+		if err := cli.AddTeamMemberContext(ctx, s, team, result.Email); err != nil {
+			return fmt.Errorf("add to team %s: %w", team, err)
+		}
+	}
+
+	u, err := cli.GetUserContext(ctx, s, result.Email)
+	if err != nil {
+		return fmt.Errorf("get user: %w", err)
+	}
+	result.TemporaryPassword = u.TemporaryPassword
+
+	return nil
+}
+
+// Failed return the results of the users which could not be provisioned.
+func (r ProvisionReport) Failed() []ProvisionResult {
+	failed := make([]ProvisionResult, 0)
+	for _, res := range r.Results {
+		if res.Err != nil {
+			failed = append(failed, res)
+		}
+	}
+	return failed
+}
+
+// WriteCredentials write the name, email and temporary password of each user a password was set for as a CSV file,
+// including users where a later step failed. The temporaryPassword column is true if the user must change their
+// password when they log in. The file holds passwords so must be kept securely.
+func (r ProvisionReport) WriteCredentials(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"name", "email", "password", "temporaryPassword"}); err != nil {
+		return err
+	}
+
+	for _, res := range r.Results {
+		if res.Password == "" {
+			continue
+		}
+		if err := cw.Write([]string{res.Name, res.Email, res.Password, strconv.FormatBool(res.TemporaryPassword)}); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// GeneratePassword return a random password of the length provided, which must be at least 4, with at least one lower
+// case letter, upper case letter, digit and symbol.
+func GeneratePassword(length int) (string, error) {
+	if length < 4 {
+		return "", fmt.Errorf("password length %d is less than 4", length)
+	}
+
+	classes := []string{passwordLower, passwordUpper, passwordDigits, passwordSymbols}
+	all := strings.Join(classes, "")
+
+	password := make([]byte, length)
+	for i := range password {
+		chars := all
+		if i < len(classes) {
+			chars = classes[i]
+		}
+
+		c, err := randomChar(chars)
+		if err != nil {
+			return "", err
+		}
+		password[i] = c
+	}
+
+	// shuffle so the characters from each class are not always first
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+
+	return string(password), nil
+}
+
+func randomChar(chars string) (byte, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
+	if err != nil {
+		return 0, err
+	}
+	return chars[n.Int64()], nil
+}
+
+// parseBool parse a CSV boolean, which may be empty for false or yes/no
+func parseBool(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "", "no", "n":
+		return false, nil
+	case "yes", "y":
+		return true, nil
+	default:
+		return strconv.ParseBool(s)
+	}
+}
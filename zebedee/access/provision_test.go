@@ -0,0 +1,165 @@
+package access_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee/access"
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee/zebedeetest"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const usersCSV = `name,email,editor,admin,teams
+Alice,alice@ons.gov.uk,true,,census;gdp
+Bob,bob@ons.gov.uk,yes,yes,
+Carol,carol@ons.gov.uk,true,false,missing-team
+Admin,admin@ons.gov.uk,true,true,
+`
+
+func TestReadUsers(t *testing.T) {
+	Convey("Given a CSV of users with the columns in any order", t, func() {
+		users, err := access.ReadUsers(strings.NewReader(usersCSV))
+		So(err, ShouldBeNil)
+
+		Convey("Then each user is read with their permissions and teams", func() {
+			So(users, ShouldHaveLength, 4)
+			So(users[0], ShouldResemble, access.NewUser{
+				User:  access.User{Name: "Alice", Email: "alice@ons.gov.uk", Editor: true},
+				Teams: []string{"census", "gdp"},
+				Line:  2,
+			})
+			So(users[1].Admin, ShouldBeTrue)
+			So(users[1].Teams, ShouldBeEmpty)
+		})
+	})
+
+	Convey("Given a CSV without an email column", t, func() {
+		_, err := access.ReadUsers(strings.NewReader("name,editor\nAlice,true\n"))
+
+		Convey("Then an invalid config error is returned", func() {
+			So(errors.Is(err, access.ErrInvalidConfig), ShouldBeTrue)
+		})
+	})
+
+	Convey("Given a CSV with an invalid boolean", t, func() {
+		_, err := access.ReadUsers(strings.NewReader("name,email,admin\nAlice,alice@ons.gov.uk,maybe\n"))
+
+		Convey("Then the line is reported in the error", func() {
+			So(errors.Is(err, access.ErrInvalidConfig), ShouldBeTrue)
+			So(err.Error(), ShouldContainSubstring, "line 2: admin")
+		})
+	})
+}
+
+func TestProvision(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("Given a Zebedee with an admin and teams", t, func() {
+		fake := zebedeetest.NewServer()
+		defer fake.Close()
+
+		fake.AddUser(zebedee.User{Name: "Admin", Email: adminEmail}, "password", zebedee.Permissions{Admin: true, Editor: true})
+		cli := zebedee.NewClient(fake.URL, fake.HttpClient())
+		s, _ := fake.NewSession(adminEmail)
+
+		for _, team := range []string{"census", "gdp"} {
+			_, err := cli.CreateTeam(s, team)
+			So(err, ShouldBeNil)
+		}
+
+		users, err := access.ReadUsers(strings.NewReader(usersCSV))
+		So(err, ShouldBeNil)
+
+		Convey("When the users are provisioned", func() {
+			report := access.Provision(ctx, cli, s, users, access.ProvisionOptions{Concurrency: 2})
+
+			Convey("Then there is a result for each row in order", func() {
+				So(report.Results, ShouldHaveLength, 4)
+				for i, res := range report.Results {
+					So(res.Email, ShouldEqual, users[i].Email)
+				}
+			})
+
+			Convey("Then the valid users are created with a temporary password, permissions and teams", func() {
+				alice := report.Results[0]
+				So(alice.Err, ShouldBeNil)
+				So(alice.TemporaryPassword, ShouldBeTrue)
+
+				err := cli.SetPassword(zebedee.Session{}, zebedee.Credentials{Email: "alice@ons.gov.uk", Password: "a new password", OldPassword: alice.Password})
+				So(err, ShouldBeNil)
+
+				p, err := cli.GetPermissions(s, "bob@ons.gov.uk")
+				So(err, ShouldBeNil)
+				So(p.Admin, ShouldBeTrue)
+
+				gdp, err := cli.GetTeam(s, "gdp")
+				So(err, ShouldBeNil)
+				So(gdp.Members, ShouldResemble, []string{"alice@ons.gov.uk"})
+			})
+
+			Convey("Then the failures are reported with the step that failed", func() {
+				failed := report.Failed()
+				So(failed, ShouldHaveLength, 2)
+				So(failed[0].Email, ShouldEqual, "carol@ons.gov.uk")
+				So(failed[0].Err.Error(), ShouldStartWith, "add to team missing-team")
+				So(failed[1].Email, ShouldEqual, adminEmail)
+				So(failed[1].Err.Error(), ShouldStartWith, "create user")
+			})
+
+			Convey("Then the credentials file lists each user a password was set for", func() {
+				var buf bytes.Buffer
+				So(report.WriteCredentials(&buf), ShouldBeNil)
+
+				records, err := csv.NewReader(&buf).ReadAll()
+				So(err, ShouldBeNil)
+				So(records, ShouldHaveLength, 4)
+				So(records[0], ShouldResemble, []string{"name", "email", "password", "temporaryPassword"})
+				So(records[1], ShouldResemble, []string{"Alice", "alice@ons.gov.uk", report.Results[0].Password, "true"})
+				So(records[3][1], ShouldEqual, "carol@ons.gov.uk")
+			})
+		})
+
+		Convey("When the context is cancelled", func() {
+			cancelled, cancel := context.WithCancel(ctx)
+			cancel()
+			report := access.Provision(cancelled, cli, s, users, access.ProvisionOptions{})
+
+			Convey("Then every user fails with the context error", func() {
+				So(report.Failed(), ShouldHaveLength, 4)
+				So(errors.Is(report.Results[0].Err, context.Canceled), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestGeneratePassword(t *testing.T) {
+	Convey("When passwords are generated", t, func() {
+		a, err := access.GeneratePassword(access.DefaultPasswordLength)
+		So(err, ShouldBeNil)
+		b, err := access.GeneratePassword(access.DefaultPasswordLength)
+		So(err, ShouldBeNil)
+
+		Convey("Then they are random with each class of character", func() {
+			So(a, ShouldHaveLength, access.DefaultPasswordLength)
+			So(a, ShouldNotEqual, b)
+			So(strings.IndexFunc(a, unicode.IsLower), ShouldBeGreaterThanOrEqualTo, 0)
+			So(strings.IndexFunc(a, unicode.IsUpper), ShouldBeGreaterThanOrEqualTo, 0)
+			So(strings.IndexFunc(a, unicode.IsDigit), ShouldBeGreaterThanOrEqualTo, 0)
+			So(strings.ContainsAny(a, "!#$%&*+-=?@^_"), ShouldBeTrue)
+		})
+	})
+
+	Convey("When the length is too short", t, func() {
+		_, err := access.GeneratePassword(3)
+
+		Convey("Then an error is returned", func() {
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
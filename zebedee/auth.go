@@ -2,22 +2,26 @@ package zebedee
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
 	"io"
 	"net/http"
 )
 
 // OpenSession opens a new user session using the login credentials provided
 func (z *zebedeeClient) OpenSession(c Credentials) (Session, error) {
+	return z.OpenSessionContext(context.Background(), c)
+}
+
+// OpenSessionContext opens a new user session using the login credentials provided, bound to the provided context
+func (z *zebedeeClient) OpenSessionContext(ctx context.Context, c Credentials) (Session, error) {
 	var s Session
 	body, err := json.Marshal(c)
 	if err != nil {
 		return s, err
 	}
 
-	url := fmt.Sprintf("%s/login", z.Host)
-	r, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(body))
+	r, err := z.newRequest(ctx, "/login", http.MethodPost, bytes.NewBuffer(body))
 	if err != nil {
 		return s, err
 	}
@@ -47,6 +51,12 @@ func (z *zebedeeClient) OpenSession(c Credentials) (Session, error) {
 
 // OpenSessionJWT opens a new session using the auth token provided
 func (z *zebedeeClient) OpenSessionJWT(authToken string) (Session, error) {
+	return z.OpenSessionJWTContext(context.Background(), authToken)
+}
+
+// OpenSessionJWTContext opens a new session using the auth token provided.
+// No request is made to Zebedee so the context is currently unused.
+func (z *zebedeeClient) OpenSessionJWTContext(_ context.Context, authToken string) (Session, error) {
 	s := Session{
 		ID: authToken,
 	}
@@ -56,20 +66,31 @@ func (z *zebedeeClient) OpenSessionJWT(authToken string) (Session, error) {
 
 // SetPermissions  set the user's CMS permissions
 func (z *zebedeeClient) SetPermissions(s Session, p Permissions) error {
-	r, err := z.newAuthenticatedRequest("/permission", s.ID, http.MethodPost, p)
+	return z.SetPermissionsContext(context.Background(), s, p)
+}
+
+// SetPermissionsContext set the user's CMS permissions, bound to the provided context
+func (z *zebedeeClient) SetPermissionsContext(ctx context.Context, s Session, p Permissions) error {
+	r, err := z.newAuthenticatedRequest(ctx, "/permission", s, http.MethodPost, p)
 	if err != nil {
 		return err
 	}
+	r = markIdempotent(r)
 
 	return z.executeRequestNoResponse(r, http.StatusOK)
 }
 
 // GetPermissions  get the user's CMS permissions
 func (z *zebedeeClient) GetPermissions(s Session, email string) (Permissions, error) {
+	return z.GetPermissionsContext(context.Background(), s, email)
+}
+
+// GetPermissionsContext get the user's CMS permissions, bound to the provided context
+func (z *zebedeeClient) GetPermissionsContext(ctx context.Context, s Session, email string) (Permissions, error) {
 	var p Permissions
-	uri := fmt.Sprintf("/permission?email=%s", email)
+	uri := newEndpoint("permission").query("email", email).String()
 
-	r, err := z.newAuthenticatedRequest(uri, s.ID, http.MethodGet, nil)
+	r, err := z.newAuthenticatedRequest(ctx, uri, s, http.MethodGet, nil)
 	if err != nil {
 		return p, err
 	}
@@ -84,7 +105,12 @@ func (z *zebedeeClient) GetPermissions(s Session, email string) (Permissions, er
 
 // SetPassword set the user password
 func (z *zebedeeClient) SetPassword(s Session, c Credentials) error {
-	r, err := z.newAuthenticatedRequest("/password", s.ID, http.MethodPost, c)
+	return z.SetPasswordContext(context.Background(), s, c)
+}
+
+// SetPasswordContext set the user password, bound to the provided context
+func (z *zebedeeClient) SetPasswordContext(ctx context.Context, s Session, c Credentials) error {
+	r, err := z.newAuthenticatedRequest(ctx, "/password", s, http.MethodPost, c)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,77 @@
+package zebedee
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// CloneOptions are the options for CloneCollection.
+type CloneOptions struct {
+	// PublishDate of the new collection, which must be in the future. A zero time keeps the publish date of the source
+	// collection, which must also be in the future if the source is scheduled.
+	PublishDate time.Time
+	// Filter returns true for the content URIs to copy to the new collection. If nil all the content is copied.
+	Filter func(uri string) bool
+}
+
+// CloneCollection create a new collection with the teams, type, publish date and release URI of the source collection,
+// and copy the in progress, complete and reviewed content of the source to it. The content of the new collection is in
+// progress. Zebedee does not allow the same URI in more than one collection, so the source collection would usually
+// have been published. If an error is returned after the collection is created, the description of the partly cloned
+// collection is returned with it.
+func CloneCollection(ctx context.Context, cli Client, s Session, sourceID, newName string, opts CloneOptions) (CollectionDescription, error) {
+	if !opts.PublishDate.IsZero() {
+		if err := validateSchedule(opts.PublishDate); err != nil {
+			return CollectionDescription{}, err
+		}
+	}
+
+	source, err := cli.GetCollectionDetailsContext(ctx, s, sourceID)
+	if err != nil {
+		return CollectionDescription{}, err
+	}
+
+	spec := NewCollection(newName)
+	spec.Type = source.Type
+	spec.PublishDate = source.PublishDate
+	spec.ReleaseURI = source.ReleaseURI
+	if source.Teams != nil {
+		spec.Teams = source.Teams
+	}
+	if opts.PublishDate.IsZero() {
+		if err := validateCopiedSchedule(spec); err != nil {
+			return CollectionDescription{}, err
+		}
+	} else {
+		spec.PublishDate = FormatPublishDate(opts.PublishDate)
+	}
+
+	desc, err := cli.CreateCollectionContext(ctx, s, spec)
+	if err != nil {
+		return CollectionDescription{}, err
+	}
+
+	for _, uri := range contentURIs(source) {
+		if opts.Filter != nil && !opts.Filter(uri) {
+			continue
+		}
+
+		if err := cloneContent(ctx, cli, s, sourceID, desc.ID, uri); err != nil {
+			return desc, fmt.Errorf("clone %s: %w", uri, err)
+		}
+	}
+
+	return desc, nil
+}
+
+// cloneContent stream the content at the URI from one collection to another
+func cloneContent(ctx context.Context, cli Client, s Session, fromID, toID, uri string) error {
+	body, info, err := cli.GetContentStream(ctx, s, fromID, uri)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	return importContent(ctx, cli, s, toID, uri, body, info.ContentType)
+}
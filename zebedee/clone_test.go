@@ -0,0 +1,118 @@
+package zebedee_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCloneCollection(t *testing.T) {
+	ctx := context.Background()
+	items := []zebedee.ContentItem{
+		{URI: "/bulletins/gdp/january/data.json", Content: newPage("GDP January")},
+		{URI: "/bulletins/gdp/january/notes/data.json", Content: newPage("Notes")},
+	}
+
+	Convey("Given a published scheduled collection with pages and a file", t, func() {
+		fake, cli, editor, reviewer := newFake()
+		defer fake.Close()
+
+		spec, err := zebedee.NewScheduledCollection("GDP January", time.Now().Add(time.Hour))
+		So(err, ShouldBeNil)
+		spec.Teams = []string{"gdp-team"}
+		spec.ReleaseURI = "/releases/gdpjanuary"
+		source, err := cli.CreateCollection(editor, spec)
+		So(err, ShouldBeNil)
+
+		So(cli.UploadFile(editor, source.ID, "/bulletins/gdp/january/gdp.csv", strings.NewReader("quarter,value\n"), "text/csv"), ShouldBeNil)
+		So(cli.CompleteCollectionContent(editor, source.ID, "/bulletins/gdp/january/gdp.csv"), ShouldBeNil)
+		So(cli.ReviewCollectionContent(reviewer, source.ID, "/bulletins/gdp/january/gdp.csv"), ShouldBeNil)
+
+		_, err = zebedee.NewPublisher(cli, editor, reviewer).Publish(ctx, source, items)
+		So(err, ShouldBeNil)
+		So(cli.PublishCollection(editor, source.ID), ShouldBeNil)
+
+		Convey("When it is cloned with a new publish date", func() {
+			next := time.Now().Add(30 * 24 * time.Hour).Truncate(time.Millisecond)
+			clone, err := zebedee.CloneCollection(ctx, cli, editor, source.ID, "GDP February", zebedee.CloneOptions{PublishDate: next})
+			So(err, ShouldBeNil)
+
+			Convey("Then the new collection has the teams, type and release URI of the source", func() {
+				So(clone.Name, ShouldEqual, "GDP February")
+				So(clone.Type, ShouldEqual, zebedee.Scheduled)
+				So(clone.Teams, ShouldResemble, []string{"gdp-team"})
+				So(clone.ReleaseURI, ShouldEqual, "/releases/gdpjanuary")
+
+				publishTime, err := clone.PublishTime()
+				So(err, ShouldBeNil)
+				So(publishTime.Equal(next), ShouldBeTrue)
+			})
+
+			Convey("Then all the content is copied in progress", func() {
+				details, err := cli.GetCollectionDetails(editor, clone.ID)
+				So(err, ShouldBeNil)
+
+				state := zebedee.NewCollectionState(details)
+				So(state.URIs(zebedee.ContentInProgress), ShouldResemble, []string{
+					"/bulletins/gdp/january/data.json",
+					"/bulletins/gdp/january/gdp.csv",
+					"/bulletins/gdp/january/notes/data.json",
+				})
+
+				b, err := cli.GetContent(editor, clone.ID, "/bulletins/gdp/january/gdp.csv")
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, "quarter,value\n")
+			})
+		})
+
+		Convey("When it is cloned with a filter", func() {
+			clone, err := zebedee.CloneCollection(ctx, cli, editor, source.ID, "GDP February", zebedee.CloneOptions{
+				Filter: func(uri string) bool { return strings.HasSuffix(uri, "/data.json") },
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then only the matching content is copied", func() {
+				details, err := cli.GetCollectionDetails(editor, clone.ID)
+				So(err, ShouldBeNil)
+				So(details.InProgress, ShouldHaveLength, 2)
+				So(clone.PublishDate, ShouldEqual, source.PublishDate)
+			})
+		})
+
+		Convey("When it is cloned with a publish date in the past", func() {
+			_, err := zebedee.CloneCollection(ctx, cli, editor, source.ID, "GDP February", zebedee.CloneOptions{PublishDate: time.Now().Add(-time.Hour)})
+
+			Convey("Then an invalid publish date error is returned", func() {
+				So(errors.Is(err, zebedee.ErrInvalidPublishDate), ShouldBeTrue)
+			})
+		})
+	})
+
+	Convey("Given a scheduled collection with a publish date in the past", t, func() {
+		fake, cli, editor, _ := newFake()
+		defer fake.Close()
+
+		spec := zebedee.NewCollection("GDP January")
+		spec.Type = zebedee.Scheduled
+		spec.PublishDate = zebedee.FormatPublishDate(time.Now().Add(-30 * 24 * time.Hour))
+		source, err := cli.CreateCollection(editor, spec)
+		So(err, ShouldBeNil)
+
+		Convey("When it is cloned without a new publish date", func() {
+			_, err := zebedee.CloneCollection(ctx, cli, editor, source.ID, "GDP February", zebedee.CloneOptions{})
+
+			Convey("Then an invalid publish date error is returned without creating a collection", func() {
+				So(errors.Is(err, zebedee.ErrInvalidPublishDate), ShouldBeTrue)
+
+				collections, err := cli.GetCollections(editor)
+				So(err, ShouldBeNil)
+				So(collections, ShouldHaveLength, 1)
+			})
+		})
+	})
+}
@@ -1,7 +1,7 @@
 package zebedee
 
 import (
-	"fmt"
+	"context"
 	"net/http"
 	"time"
 )
@@ -12,7 +12,7 @@ func NewCollection(name string) CollectionDescription {
 		collectionBase: collectionBase{
 			Name:        name,
 			Type:        Manual,
-			PublishDate: time.Now().Format(CollectionDateFMT),
+			PublishDate: FormatPublishDate(time.Now()),
 			Teams:       make([]string, 0),
 		},
 		Encrypted:             false,
@@ -30,12 +30,39 @@ func NewCollection(name string) CollectionDescription {
 	}
 }
 
+// ContentOptions are the flags sent to Zebedee when updating, completing and reviewing collection content.
+type ContentOptions struct {
+	// OverwriteExisting replaces any existing content at the URI. If false the update fails with a conflict when content
+	// already exists, either in the collection or published.
+	OverwriteExisting bool
+	// Recursive applies the request to all the files in the directory of the content URI, e.g. the charts and
+	// downloads alongside a data.json page, rather than the single file.
+	Recursive bool
+	// ValidateJSON has Zebedee check the content is a valid page JSON structure.
+	ValidateJSON bool
+}
+
+// DefaultContentOptions return the content options used by the methods without an options argument, which overwrite
+// existing content, apply to a single file and validate the page JSON.
+func DefaultContentOptions() ContentOptions {
+	return ContentOptions{
+		OverwriteExisting: true,
+		Recursive:         false,
+		ValidateJSON:      true,
+	}
+}
+
 // CreateCollection create a new collection. Returns an updated collection description containing the generated collection ID or an error.
 func (z *zebedeeClient) CreateCollection(s Session, desc CollectionDescription) (CollectionDescription, error) {
+	return z.CreateCollectionContext(context.Background(), s, desc)
+}
+
+// CreateCollectionContext create a new collection, bound to the provided context. Returns an updated collection description containing the generated collection ID or an error.
+func (z *zebedeeClient) CreateCollectionContext(ctx context.Context, s Session, desc CollectionDescription) (CollectionDescription, error) {
 	var updated CollectionDescription
 
 	uri := "/collection"
-	req, err := z.newAuthenticatedRequest(uri, s.ID, http.MethodPost, desc)
+	req, err := z.newAuthenticatedRequest(ctx, uri, s, http.MethodPost, desc)
 	if err != nil {
 		return updated, err
 	}
@@ -50,10 +77,15 @@ func (z *zebedeeClient) CreateCollection(s Session, desc CollectionDescription)
 
 // GetCollectionByID get a collection by ID. Returns the collection description or an error.
 func (z *zebedeeClient) GetCollectionByID(s Session, id string) (CollectionDescription, error) {
+	return z.GetCollectionByIDContext(context.Background(), s, id)
+}
+
+// GetCollectionByIDContext get a collection by ID, bound to the provided context. Returns the collection description or an error.
+func (z *zebedeeClient) GetCollectionByIDContext(ctx context.Context, s Session, id string) (CollectionDescription, error) {
 	var desc CollectionDescription
 
-	uri := fmt.Sprintf("/collection/%s", id)
-	req, err := z.newAuthenticatedRequest(uri, s.ID, http.MethodGet, nil)
+	uri := newEndpoint("collection", id).String()
+	req, err := z.newAuthenticatedRequest(ctx, uri, s, http.MethodGet, nil)
 	if err != nil {
 		return desc, err
 	}
@@ -68,8 +100,13 @@ func (z *zebedeeClient) GetCollectionByID(s Session, id string) (CollectionDescr
 
 // DeleteCollection deletes a collection with the provided ID. Returns error if unsuccessful
 func (z *zebedeeClient) DeleteCollection(s Session, id string) error {
-	uri := fmt.Sprintf("/collection/%s", id)
-	req, err := z.newAuthenticatedRequest(uri, s.ID, http.MethodDelete, nil)
+	return z.DeleteCollectionContext(context.Background(), s, id)
+}
+
+// DeleteCollectionContext deletes a collection with the provided ID, bound to the provided context. Returns error if unsuccessful
+func (z *zebedeeClient) DeleteCollectionContext(ctx context.Context, s Session, id string) error {
+	uri := newEndpoint("collection", id).String()
+	req, err := z.newAuthenticatedRequest(ctx, uri, s, http.MethodDelete, nil)
 	if err != nil {
 		return err
 	}
@@ -81,7 +118,7 @@ func (z *zebedeeClient) DeleteCollection(s Session, id string) error {
 	}
 
 	if !success {
-		return fmt.Errorf("delete collection request unsuccessful: %s", id)
+		return &UnsuccessfulError{Operation: "delete collection", ID: id}
 	}
 
 	return nil
@@ -89,7 +126,12 @@ func (z *zebedeeClient) DeleteCollection(s Session, id string) error {
 
 // GetCollections returns a list of collection descriptions for each current collection
 func (z *zebedeeClient) GetCollections(s Session) ([]CollectionDescription, error) {
-	req, err := z.newAuthenticatedRequest("/collections", s.ID, http.MethodGet, nil)
+	return z.GetCollectionsContext(context.Background(), s)
+}
+
+// GetCollectionsContext returns a list of collection descriptions for each current collection, bound to the provided context
+func (z *zebedeeClient) GetCollectionsContext(ctx context.Context, s Session) ([]CollectionDescription, error) {
+	req, err := z.newAuthenticatedRequest(ctx, "/collections", s, http.MethodGet, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -105,8 +147,13 @@ func (z *zebedeeClient) GetCollections(s Session) ([]CollectionDescription, erro
 
 // UpdateCollection updates the collection description
 func (z *zebedeeClient) UpdateCollection(s Session, desc CollectionDescription) error {
-	uri := fmt.Sprintf("/collection/%s", desc.ID)
-	req, err := z.newAuthenticatedRequest(uri, s.ID, http.MethodPut, desc)
+	return z.UpdateCollectionContext(context.Background(), s, desc)
+}
+
+// UpdateCollectionContext updates the collection description, bound to the provided context
+func (z *zebedeeClient) UpdateCollectionContext(ctx context.Context, s Session, desc CollectionDescription) error {
+	uri := newEndpoint("collection", desc.ID).String()
+	req, err := z.newAuthenticatedRequest(ctx, uri, s, http.MethodPut, desc)
 	if err != nil {
 		return err
 	}
@@ -116,23 +163,45 @@ func (z *zebedeeClient) UpdateCollection(s Session, desc CollectionDescription)
 
 // UpdateCollectionContent updates content within a collection
 func (z *zebedeeClient) UpdateCollectionContent(s Session, id, contentUri string, content interface{}) error {
+	return z.UpdateCollectionContentContext(context.Background(), s, id, contentUri, content)
+}
 
-	// using defaults for these flags until it's understood where the alternatives are needed.
-	overwriteExisting := true // if false, any existing content will not be overwritten
-	// if recursive=true, all associated files alongside the page will be added to the collection's in progress directory
-	// if recursive=false, only the data.json file will be added to the collection's in progress directory
-	recursive := false
-	//  if true, the json will be validated to ensure it's a valid page JSON structure
-	validateJson := true
+// UpdateCollectionContentContext updates content within a collection, bound to the provided context
+func (z *zebedeeClient) UpdateCollectionContentContext(ctx context.Context, s Session, id, contentUri string, content interface{}) error {
+	return z.UpdateCollectionContentWithOptionsContext(ctx, s, id, contentUri, content, DefaultContentOptions())
+}
 
-	uri := fmt.Sprintf("/content/%s?uri=%s&overwriteExisting=%t&recursive=%t&validateJson=%t",
-		id, contentUri, overwriteExisting, recursive, validateJson)
+// UpdateCollectionContentWithOptions updates content within a collection using the provided content options
+func (z *zebedeeClient) UpdateCollectionContentWithOptions(s Session, id, contentUri string, content interface{}, opts ContentOptions) error {
+	return z.UpdateCollectionContentWithOptionsContext(context.Background(), s, id, contentUri, content, opts)
+}
+
+// UpdateCollectionContentWithOptionsContext updates content within a collection using the provided content options,
+// bound to the provided context
+func (z *zebedeeClient) UpdateCollectionContentWithOptionsContext(ctx context.Context, s Session, id, contentUri string, content interface{}, opts ContentOptions) error {
+	if z.validatePages && opts.ValidateJSON {
+		if problems := ValidatePage(contentUri, content); len(problems) > 0 {
+			return &PageValidationError{URI: contentUri, Problems: problems}
+		}
+	}
 
-	req, err := z.newAuthenticatedRequest(uri, s.ID, http.MethodPost, content)
+	uri := newEndpoint("content", id).
+		query("uri", contentUri).
+		queryBool("overwriteExisting", opts.OverwriteExisting).
+		queryBool("recursive", opts.Recursive).
+		queryBool("validateJson", opts.ValidateJSON).
+		String()
+
+	req, err := z.newAuthenticatedRequest(ctx, uri, s, http.MethodPost, content)
 	if err != nil {
 		return err
 	}
 
+	// overwriting the existing content makes the update safe to repeat, whereas a repeated create only request fails
+	if opts.OverwriteExisting {
+		req = markIdempotent(req)
+	}
+
 	var success bool
 	err = z.requestObject(req, 200, &success)
 	if err != nil {
@@ -140,7 +209,7 @@ func (z *zebedeeClient) UpdateCollectionContent(s Session, id, contentUri string
 	}
 
 	if !success {
-		return fmt.Errorf("update collection content request unsuccessful: %s", id)
+		return &UnsuccessfulError{Operation: "update collection content", ID: id}
 	}
 
 	return nil
@@ -148,9 +217,14 @@ func (z *zebedeeClient) UpdateCollectionContent(s Session, id, contentUri string
 
 // DeleteCollectionContent deletes content from a collection
 func (z *zebedeeClient) DeleteCollectionContent(s Session, id, contentUri string) error {
-	uri := fmt.Sprintf("/content/%s?uri=%s", id, contentUri)
+	return z.DeleteCollectionContentContext(context.Background(), s, id, contentUri)
+}
+
+// DeleteCollectionContentContext deletes content from a collection, bound to the provided context
+func (z *zebedeeClient) DeleteCollectionContentContext(ctx context.Context, s Session, id, contentUri string) error {
+	uri := newEndpoint("content", id).query("uri", contentUri).String()
 
-	req, err := z.newAuthenticatedRequest(uri, s.ID, http.MethodDelete, nil)
+	req, err := z.newAuthenticatedRequest(ctx, uri, s, http.MethodDelete, nil)
 	if err != nil {
 		return err
 	}
@@ -162,7 +236,7 @@ func (z *zebedeeClient) DeleteCollectionContent(s Session, id, contentUri string
 	}
 
 	if !success {
-		return fmt.Errorf("delete collection content request unsuccessful: %s", id)
+		return &UnsuccessfulError{Operation: "delete collection content", ID: id}
 	}
 
 	return nil
@@ -171,15 +245,27 @@ func (z *zebedeeClient) DeleteCollectionContent(s Session, id, contentUri string
 // CompleteCollectionContent sets content in a collection to the complete state.
 // This is done once the content has been updated and the user is satisfied that the changes are complete
 func (z *zebedeeClient) CompleteCollectionContent(s Session, id, contentUri string) error {
+	return z.CompleteCollectionContentContext(context.Background(), s, id, contentUri)
+}
 
-	// using default for the recursive flag until it's understood where the alternative is needed.
-	// if recursive=true, all associated files alongside the page will be added to the collection's in progress directory
-	// if recursive=false, only the data.json file will be added to the collection's in progress directory
-	recursive := false
+// CompleteCollectionContentContext sets content in a collection to the complete state, bound to the provided context.
+// This is done once the content has been updated and the user is satisfied that the changes are complete
+func (z *zebedeeClient) CompleteCollectionContentContext(ctx context.Context, s Session, id, contentUri string) error {
+	return z.CompleteCollectionContentWithOptionsContext(ctx, s, id, contentUri, DefaultContentOptions())
+}
 
-	uri := fmt.Sprintf("/complete/%s?uri=%s&recursive=%t", id, contentUri, recursive)
+// CompleteCollectionContentWithOptions sets content in a collection to the complete state using the provided content options.
+// Only the Recursive option applies.
+func (z *zebedeeClient) CompleteCollectionContentWithOptions(s Session, id, contentUri string, opts ContentOptions) error {
+	return z.CompleteCollectionContentWithOptionsContext(context.Background(), s, id, contentUri, opts)
+}
+
+// CompleteCollectionContentWithOptionsContext sets content in a collection to the complete state using the provided content options, bound to the
+// provided context. Only the Recursive option applies.
+func (z *zebedeeClient) CompleteCollectionContentWithOptionsContext(ctx context.Context, s Session, id, contentUri string, opts ContentOptions) error {
+	uri := newEndpoint("complete", id).query("uri", contentUri).queryBool("recursive", opts.Recursive).String()
 
-	req, err := z.newAuthenticatedRequest(uri, s.ID, http.MethodPost, nil)
+	req, err := z.newAuthenticatedRequest(ctx, uri, s, http.MethodPost, nil)
 	if err != nil {
 		return err
 	}
@@ -195,15 +281,27 @@ func (z *zebedeeClient) CompleteCollectionContent(s Session, id, contentUri stri
 // ReviewCollectionContent sets content in a collection to the reviewed state.
 // This is done once the content has been reviewed by a user who is not the original editor.
 func (z *zebedeeClient) ReviewCollectionContent(s Session, id, contentUri string) error {
+	return z.ReviewCollectionContentContext(context.Background(), s, id, contentUri)
+}
 
-	// using default for the recursive flag until it's understood where the alternative is needed.
-	// if recursive=true, all associated files alongside the page will be added to the collection's in progress directory
-	// if recursive=false, only the data.json file will be added to the collection's in progress directory
-	recursive := false
+// ReviewCollectionContentContext sets content in a collection to the reviewed state, bound to the provided context.
+// This is done once the content has been reviewed by a user who is not the original editor.
+func (z *zebedeeClient) ReviewCollectionContentContext(ctx context.Context, s Session, id, contentUri string) error {
+	return z.ReviewCollectionContentWithOptionsContext(ctx, s, id, contentUri, DefaultContentOptions())
+}
 
-	uri := fmt.Sprintf("/review/%s?uri=%s&recursive=%t", id, contentUri, recursive)
+// ReviewCollectionContentWithOptions sets content in a collection to the reviewed state using the provided content options.
+// Only the Recursive option applies.
+func (z *zebedeeClient) ReviewCollectionContentWithOptions(s Session, id, contentUri string, opts ContentOptions) error {
+	return z.ReviewCollectionContentWithOptionsContext(context.Background(), s, id, contentUri, opts)
+}
+
+// ReviewCollectionContentWithOptionsContext sets content in a collection to the reviewed state using the provided content options, bound to the
+// provided context. Only the Recursive option applies.
+func (z *zebedeeClient) ReviewCollectionContentWithOptionsContext(ctx context.Context, s Session, id, contentUri string, opts ContentOptions) error {
+	uri := newEndpoint("review", id).query("uri", contentUri).queryBool("recursive", opts.Recursive).String()
 
-	req, err := z.newAuthenticatedRequest(uri, s.ID, http.MethodPost, nil)
+	req, err := z.newAuthenticatedRequest(ctx, uri, s, http.MethodPost, nil)
 	if err != nil {
 		return err
 	}
@@ -220,8 +318,15 @@ func (z *zebedeeClient) ReviewCollectionContent(s Session, id, contentUri string
 // The approval can only take place once all collection content is reviewed
 // A scheduled collection will only be published if the collection is approved
 func (z *zebedeeClient) ApproveCollection(s Session, id string) error {
-	uri := fmt.Sprintf("/approve/%s", id)
-	req, err := z.newAuthenticatedRequest(uri, s.ID, http.MethodPost, nil)
+	return z.ApproveCollectionContext(context.Background(), s, id)
+}
+
+// ApproveCollectionContext approves a collection with the provided ID, bound to the provided context.
+// The approval can only take place once all collection content is reviewed
+// A scheduled collection will only be published if the collection is approved
+func (z *zebedeeClient) ApproveCollectionContext(ctx context.Context, s Session, id string) error {
+	uri := newEndpoint("approve", id).String()
+	req, err := z.newAuthenticatedRequest(ctx, uri, s, http.MethodPost, nil)
 	if err != nil {
 		return err
 	}
@@ -233,7 +338,7 @@ func (z *zebedeeClient) ApproveCollection(s Session, id string) error {
 	}
 
 	if !success {
-		return fmt.Errorf("approve collection request unsuccessful: %s", id)
+		return &UnsuccessfulError{Operation: "approve collection", ID: id}
 	}
 
 	return nil
@@ -241,8 +346,13 @@ func (z *zebedeeClient) ApproveCollection(s Session, id string) error {
 
 // UnlockCollection reverses the approval state, allowing collection content to be edited
 func (z *zebedeeClient) UnlockCollection(s Session, id string) error {
-	uri := fmt.Sprintf("/unlock/%s", id)
-	req, err := z.newAuthenticatedRequest(uri, s.ID, http.MethodPost, nil)
+	return z.UnlockCollectionContext(context.Background(), s, id)
+}
+
+// UnlockCollectionContext reverses the approval state, allowing collection content to be edited, bound to the provided context
+func (z *zebedeeClient) UnlockCollectionContext(ctx context.Context, s Session, id string) error {
+	uri := newEndpoint("unlock", id).String()
+	req, err := z.newAuthenticatedRequest(ctx, uri, s, http.MethodPost, nil)
 	if err != nil {
 		return err
 	}
@@ -254,7 +364,7 @@ func (z *zebedeeClient) UnlockCollection(s Session, id string) error {
 	}
 
 	if !success {
-		return fmt.Errorf("unlock collection request unsuccessful: %s", id)
+		return &UnsuccessfulError{Operation: "unlock collection", ID: id}
 	}
 
 	return nil
@@ -262,8 +372,13 @@ func (z *zebedeeClient) UnlockCollection(s Session, id string) error {
 
 // PublishCollection publishes the updated collection content to the public website
 func (z *zebedeeClient) PublishCollection(s Session, id string) error {
-	uri := fmt.Sprintf("/publish/%s", id)
-	req, err := z.newAuthenticatedRequest(uri, s.ID, http.MethodPost, nil)
+	return z.PublishCollectionContext(context.Background(), s, id)
+}
+
+// PublishCollectionContext publishes the updated collection content to the public website, bound to the provided context
+func (z *zebedeeClient) PublishCollectionContext(ctx context.Context, s Session, id string) error {
+	uri := newEndpoint("publish", id).String()
+	req, err := z.newAuthenticatedRequest(ctx, uri, s, http.MethodPost, nil)
 	if err != nil {
 		return err
 	}
@@ -275,7 +390,7 @@ func (z *zebedeeClient) PublishCollection(s Session, id string) error {
 	}
 
 	if !success {
-		return fmt.Errorf("publish collection request unsuccessful: %s", id)
+		return &UnsuccessfulError{Operation: "publish collection", ID: id}
 	}
 
 	return nil
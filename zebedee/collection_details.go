@@ -1,16 +1,21 @@
 package zebedee
 
 import (
-	"fmt"
+	"context"
 	"net/http"
 )
 
 // GetCollectionDetails return the requested collection details from the CMS.
 func (z *zebedeeClient) GetCollectionDetails(s Session, id string) (CollectionDetails, error) {
+	return z.GetCollectionDetailsContext(context.Background(), s, id)
+}
+
+// GetCollectionDetailsContext return the requested collection details from the CMS, bound to the provided context.
+func (z *zebedeeClient) GetCollectionDetailsContext(ctx context.Context, s Session, id string) (CollectionDetails, error) {
 	var details CollectionDetails
 
-	uri := fmt.Sprintf("/collectionDetails/%s", id)
-	req, err := z.newAuthenticatedRequest(uri, s.ID, http.MethodGet, nil)
+	uri := newEndpoint("collectionDetails", id).String()
+	req, err := z.newAuthenticatedRequest(ctx, uri, s, http.MethodGet, nil)
 	if err != nil {
 		return details, err
 	}
@@ -188,6 +188,34 @@ func Test_UpdateCollectionContent(t *testing.T) {
 	})
 }
 
+func Test_UpdateCollectionContentWithOptions(t *testing.T) {
+	session := newSession()
+
+	Convey("Given create only content options without JSON validation", t, func() {
+		httpClient := mockHttpResponse(http.StatusOK, "true")
+		zebedeeClient := NewClient(host, httpClient)
+		opts := ContentOptions{OverwriteExisting: false, Recursive: true, ValidateJSON: false}
+		expectedUrl := fmt.Sprintf("%s/content/%s?uri=%s&overwriteExisting=false&recursive=true&validateJson=false", host, collectionId, uri)
+
+		Convey("When UpdateCollectionContentWithOptions is called", func() {
+			err := zebedeeClient.UpdateCollectionContentWithOptions(session, collectionId, uri, getContent(), opts)
+
+			Convey("Then the request is sent with the flags from the options", func() {
+				So(err, ShouldBeNil)
+				So(httpClient.DoCalls(), ShouldHaveLength, 1)
+
+				req := httpClient.DoCalls()[0].Req
+				So(req.Method, ShouldEqual, http.MethodPost)
+				So(req.URL.String(), ShouldEqual, expectedUrl)
+			})
+
+			Convey("Then the request is not marked as safe to retry", func() {
+				So(IsIdempotent(httpClient.DoCalls()[0].Req), ShouldBeFalse)
+			})
+		})
+	})
+}
+
 func Test_UpdateCollectionContent_FalseResponse(t *testing.T) {
 	session := newSession()
 
@@ -324,6 +352,28 @@ func Test_CompleteCollectionContent(t *testing.T) {
 	})
 }
 
+func Test_CompleteCollectionContentWithOptions(t *testing.T) {
+	session := newSession()
+
+	Convey("Given recursive content options", t, func() {
+		httpClient := mockHttpResponse(http.StatusOK, "")
+		zebedeeClient := NewClient(host, httpClient)
+		opts := DefaultContentOptions()
+		opts.Recursive = true
+		expectedUrl := fmt.Sprintf("%s/complete/%s?uri=%s&recursive=true", host, collectionId, uri)
+
+		Convey("When CompleteCollectionContentWithOptions is called", func() {
+			err := zebedeeClient.CompleteCollectionContentWithOptions(session, collectionId, uri, opts)
+
+			Convey("Then the request is sent with the recursive flag", func() {
+				So(err, ShouldBeNil)
+				So(httpClient.DoCalls(), ShouldHaveLength, 1)
+				So(httpClient.DoCalls()[0].Req.URL.String(), ShouldEqual, expectedUrl)
+			})
+		})
+	})
+}
+
 func Test_CompleteCollectionContent_HttpError(t *testing.T) {
 	session := newSession()
 
@@ -371,6 +421,28 @@ func Test_ReviewCollectionContent(t *testing.T) {
 	})
 }
 
+func Test_ReviewCollectionContentWithOptions(t *testing.T) {
+	session := newSession()
+
+	Convey("Given recursive content options", t, func() {
+		httpClient := mockHttpResponse(http.StatusOK, "")
+		zebedeeClient := NewClient(host, httpClient)
+		opts := DefaultContentOptions()
+		opts.Recursive = true
+		expectedUrl := fmt.Sprintf("%s/review/%s?uri=%s&recursive=true", host, collectionId, uri)
+
+		Convey("When ReviewCollectionContentWithOptions is called", func() {
+			err := zebedeeClient.ReviewCollectionContentWithOptions(session, collectionId, uri, opts)
+
+			Convey("Then the request is sent with the recursive flag", func() {
+				So(err, ShouldBeNil)
+				So(httpClient.DoCalls(), ShouldHaveLength, 1)
+				So(httpClient.DoCalls()[0].Req.URL.String(), ShouldEqual, expectedUrl)
+			})
+		})
+	})
+}
+
 func Test_ReviewCollectionContent_HttpError(t *testing.T) {
 	session := newSession()
 
@@ -594,6 +666,49 @@ func Test_PublishCollection_HttpError(t *testing.T) {
 	})
 }
 
+func Test_PublishCollectionContext(t *testing.T) {
+	session := newSession()
+
+	Convey("Given a context carrying a request scoped value", t, func() {
+		type ctxKey struct{}
+		ctx := context.WithValue(context.Background(), ctxKey{}, "trace-id")
+		httpClient := mockHttpResponse(http.StatusOK, `true`)
+		zebedeeClient := NewClient(host, httpClient)
+
+		Convey("When PublishCollectionContext is called", func() {
+			err := zebedeeClient.PublishCollectionContext(ctx, session, collectionId)
+
+			Convey("Then the context is passed through to the HTTP client", func() {
+				So(err, ShouldBeNil)
+				So(httpClient.DoCalls(), ShouldHaveLength, 1)
+
+				call := httpClient.DoCalls()[0]
+				So(call.Ctx.Value(ctxKey{}), ShouldEqual, "trace-id")
+				So(call.Req.Context().Value(ctxKey{}), ShouldEqual, "trace-id")
+			})
+		})
+	})
+
+	Convey("Given a context that has already been cancelled", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		httpClient := &mock.HttpClientMock{
+			DoFunc: func(ctx context.Context, req *http.Request) (*http.Response, error) {
+				return nil, ctx.Err()
+			},
+		}
+		zebedeeClient := NewClient(host, httpClient)
+
+		Convey("When PublishCollectionContext is called", func() {
+			err := zebedeeClient.PublishCollectionContext(ctx, session, collectionId)
+
+			Convey("Then the context error is returned", func() {
+				So(errors.Is(err, context.Canceled), ShouldBeTrue)
+			})
+		})
+	})
+}
+
 func newSession() Session {
 	return Session{
 		Email: "testuser@zebedeesdktest.com",
@@ -1,32 +1,97 @@
 package zebedee
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
 	"io"
 	"net/http"
+	"time"
 )
 
+// ContentInfo is the metadata of content returned by GetContentStream.
+type ContentInfo struct {
+	ContentType string
+	// ContentLength is the length of the content in bytes, or -1 if it is not known.
+	ContentLength int64
+	ETag          string
+	// LastModified is the zero time if Zebedee did not return a valid Last-Modified header.
+	LastModified time.Time
+}
+
 func (z *zebedeeClient) GetContent(s Session, collectionName string, path string) ([]byte, error) {
-	uri := fmt.Sprintf("/content/%s?uri=%s", collectionName, path)
-	req, err := z.newAuthenticatedRequest(uri, s.ID, http.MethodGet, nil)
+	return z.GetContentContext(context.Background(), s, collectionName, path)
+}
+
+// GetContentContext returns the content at the path within the collection, bound to the provided context
+func (z *zebedeeClient) GetContentContext(ctx context.Context, s Session, collectionName string, path string) ([]byte, error) {
+	return readStream(z.GetContentStream(ctx, s, collectionName, path))
+}
+
+// GetContentStream returns a reader for the content at the URI within the collection along with its metadata.
+// The content is streamed from Zebedee rather than held in memory. The caller must close the reader.
+func (z *zebedeeClient) GetContentStream(ctx context.Context, s Session, collectionID, uri string) (io.ReadCloser, ContentInfo, error) {
+	return z.getStream(ctx, s, newEndpoint("content", collectionID).query("uri", uri).String())
+}
+
+// GetContentJSON decodes the JSON content at the URI within the collection into v, reading it directly from the
+// response rather than holding it in memory.
+func (z *zebedeeClient) GetContentJSON(s Session, collectionID, uri string, v any) error {
+	return z.GetContentJSONContext(context.Background(), s, collectionID, uri, v)
+}
+
+// GetContentJSONContext decodes the JSON content at the URI within the collection into v, bound to the provided
+// context.
+func (z *zebedeeClient) GetContentJSONContext(ctx context.Context, s Session, collectionID, uri string, v any) error {
+	body, _, err := z.GetContentStream(ctx, s, collectionID, uri)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	defer body.Close()
+
+	return json.NewDecoder(body).Decode(v)
+}
+
+// getStream send a GET request to the endpoint, returning the response body and its metadata. The caller must close
+// the body.
+func (z *zebedeeClient) getStream(ctx context.Context, s Session, endpoint string) (io.ReadCloser, ContentInfo, error) {
+	req, err := z.newAuthenticatedRequest(ctx, endpoint, s, http.MethodGet, nil)
+	if err != nil {
+		return nil, ContentInfo{}, err
 	}
 
 	resp, err := z.do(req)
 	if err != nil {
-		return nil, err
+		return nil, ContentInfo{}, err
 	}
-	defer resp.Body.Close()
 
 	if err = checkResponseStatus(resp, http.StatusOK); err != nil {
-		return nil, err
+		resp.Body.Close()
+		return nil, ContentInfo{}, err
 	}
 
-	b, err := io.ReadAll(resp.Body)
+	return resp.Body, newContentInfo(resp), nil
+}
+
+// readStream read the whole of the body returned by getStream
+func readStream(body io.ReadCloser, _ ContentInfo, err error) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	defer body.Close()
+
+	return io.ReadAll(body)
+}
+
+func newContentInfo(resp *http.Response) ContentInfo {
+	info := ContentInfo{
+		ContentType:   resp.Header.Get("Content-Type"),
+		ContentLength: resp.ContentLength,
+		ETag:          resp.Header.Get("ETag"),
+	}
+
+	if t, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		info.LastModified = t
+	}
 
-	return b, nil
+	return info
 }
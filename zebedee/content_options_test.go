@@ -0,0 +1,98 @@
+package zebedee_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestContentOptions(t *testing.T) {
+	ctx := context.Background()
+	page := func(title string) interface{} { return newPage(title) }
+
+	Convey("Given a collection with content in a page directory", t, func() {
+		fake, cli, editor, reviewer := newFake()
+		defer fake.Close()
+
+		c, err := cli.CreateCollection(editor, zebedee.NewCollection("options"))
+		So(err, ShouldBeNil)
+		So(cli.UpdateCollectionContent(editor, c.ID, "/gdp/data.json", page("GDP")), ShouldBeNil)
+		So(cli.UpdateCollectionContent(editor, c.ID, "/gdp/chart.json", map[string]interface{}{"type": "chart"}), ShouldBeNil)
+
+		Convey("When content is created with OverwriteExisting false", func() {
+			opts := zebedee.DefaultContentOptions()
+			opts.OverwriteExisting = false
+
+			Convey("Then new content is created", func() {
+				err := cli.UpdateCollectionContentWithOptionsContext(ctx, editor, c.ID, "/cpi/data.json", page("CPI"), opts)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("Then existing content is not overwritten", func() {
+				err := cli.UpdateCollectionContentWithOptionsContext(ctx, editor, c.ID, "/gdp/data.json", page("Changed"), opts)
+				So(errors.Is(err, zebedee.ErrConflict), ShouldBeTrue)
+
+				b, err := cli.GetContent(editor, c.ID, "/gdp/data.json")
+				So(err, ShouldBeNil)
+				So(string(b), ShouldContainSubstring, `"GDP"`)
+			})
+
+			Convey("Then published content is not overwritten", func() {
+				fake.SetPublishedContent("/published/data.json", []byte(`{"type":"static_page"}`))
+				err := cli.UpdateCollectionContentWithOptionsContext(ctx, editor, c.ID, "/published/data.json", page("Changed"), opts)
+				So(errors.Is(err, zebedee.ErrConflict), ShouldBeTrue)
+			})
+		})
+
+		Convey("When content which is not page JSON is updated", func() {
+			content := json.RawMessage(`{"rows":[1,2,3]}`)
+
+			Convey("Then it is rejected when ValidateJSON is true", func() {
+				err := cli.UpdateCollectionContentWithOptions(editor, c.ID, "/gdp/rows.json", content, zebedee.DefaultContentOptions())
+				So(errors.Is(err, zebedee.ErrValidation), ShouldBeTrue)
+			})
+
+			Convey("Then it is accepted when ValidateJSON is false", func() {
+				opts := zebedee.DefaultContentOptions()
+				opts.ValidateJSON = false
+				err := cli.UpdateCollectionContentWithOptions(editor, c.ID, "/gdp/rows.json", content, opts)
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When the page is completed and reviewed without Recursive", func() {
+			So(cli.CompleteCollectionContentWithOptions(editor, c.ID, "/gdp/data.json", zebedee.DefaultContentOptions()), ShouldBeNil)
+			So(cli.ReviewCollectionContentWithOptions(reviewer, c.ID, "/gdp/data.json", zebedee.DefaultContentOptions()), ShouldBeNil)
+
+			Convey("Then only the page is reviewed", func() {
+				desc, err := cli.GetCollectionByID(editor, c.ID)
+				So(err, ShouldBeNil)
+				So(desc.ReviewedUris, ShouldResemble, []string{"/gdp/data.json"})
+				So(desc.InProgressUris, ShouldResemble, []string{"/gdp/chart.json"})
+			})
+		})
+
+		Convey("When the page is completed and reviewed with Recursive", func() {
+			opts := zebedee.DefaultContentOptions()
+			opts.Recursive = true
+			So(cli.CompleteCollectionContentWithOptionsContext(ctx, editor, c.ID, "/gdp/data.json", opts), ShouldBeNil)
+
+			desc, err := cli.GetCollectionByID(editor, c.ID)
+			So(err, ShouldBeNil)
+			So(desc.CompleteUris, ShouldResemble, []string{"/gdp/chart.json", "/gdp/data.json"})
+
+			So(cli.ReviewCollectionContentWithOptionsContext(ctx, reviewer, c.ID, "/gdp/data.json", opts), ShouldBeNil)
+
+			Convey("Then all the files in the page directory are reviewed", func() {
+				desc, err := cli.GetCollectionByID(editor, c.ID)
+				So(err, ShouldBeNil)
+				So(desc.ReviewedUris, ShouldResemble, []string{"/gdp/chart.json", "/gdp/data.json"})
+				So(desc.InProgressUris, ShouldBeEmpty)
+			})
+		})
+	})
+}
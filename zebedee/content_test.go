@@ -1,9 +1,14 @@
 package zebedee
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/ONSdigital/dp-net/v2/request"
 
@@ -66,3 +71,115 @@ func Test_GetContentError(t *testing.T) {
 		})
 	})
 }
+
+func Test_GetContentStream(t *testing.T) {
+	session := newSession()
+	ctx := context.Background()
+
+	Convey("Given a mock HTTP client that returns content with metadata headers", t, func() {
+		httpClient := mockHttpResponseSequence(mockResponse{
+			status: http.StatusOK,
+			body:   pageContent,
+			headers: http.Header{
+				"Content-Type":   {"application/json"},
+				"Content-Length": {strconv.Itoa(len(pageContent))},
+				"Etag":           {`"abc123"`},
+				"Last-Modified":  {"Wed, 21 Oct 2026 07:28:00 GMT"},
+			},
+		})
+		zebedeeClient := NewClient(host, httpClient)
+
+		Convey("When GetContentStream is called", func() {
+			body, info, err := zebedeeClient.GetContentStream(ctx, session, collectionId, uri)
+			So(err, ShouldBeNil)
+			defer body.Close()
+
+			Convey("Then the expected request is sent to the HTTP client", func() {
+				req := httpClient.DoCalls()[0].Req
+				So(req.Method, ShouldEqual, http.MethodGet)
+				So(req.URL.String(), ShouldEqual, fmt.Sprintf("%s/content/%s?uri=%s", host, collectionId, uri))
+				So(req.Header.Get(request.FlorenceHeaderKey), ShouldEqual, session.ID)
+			})
+
+			Convey("Then the content can be read from the returned reader", func() {
+				b, err := io.ReadAll(body)
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, pageContent)
+			})
+
+			Convey("Then the content metadata is returned", func() {
+				So(info.ContentType, ShouldEqual, "application/json")
+				So(info.ContentLength, ShouldEqual, len(pageContent))
+				So(info.ETag, ShouldEqual, `"abc123"`)
+				So(info.LastModified.Equal(time.Date(2026, 10, 21, 7, 28, 0, 0, time.UTC)), ShouldBeTrue)
+			})
+		})
+	})
+
+	Convey("Given a mock HTTP client that returns content without metadata headers", t, func() {
+		zebedeeClient := NewClient(host, mockHttpResponse(http.StatusOK, pageContent))
+
+		Convey("Then the unknown metadata is left empty", func() {
+			body, info, err := zebedeeClient.GetContentStream(ctx, session, collectionId, uri)
+			So(err, ShouldBeNil)
+			defer body.Close()
+
+			So(info.ETag, ShouldBeEmpty)
+			So(info.LastModified.IsZero(), ShouldBeTrue)
+		})
+	})
+
+	Convey("Given the content does not exist", t, func() {
+		zebedeeClient := NewClient(host, mockHttpResponse(http.StatusNotFound, `{"message":"Content not found"}`))
+
+		Convey("Then GetContentStream returns a not found error", func() {
+			body, _, err := zebedeeClient.GetContentStream(ctx, session, collectionId, uri)
+			So(errors.Is(err, ErrNotFound), ShouldBeTrue)
+			So(body, ShouldBeNil)
+		})
+	})
+}
+
+func Test_GetContentJSON(t *testing.T) {
+	session := newSession()
+
+	Convey("Given a mock HTTP client that returns page JSON", t, func() {
+		zebedeeClient := NewClient(host, mockHttpResponse(http.StatusOK, `{"type":"static_page","description":{"title":"About us"}}`))
+
+		Convey("When GetContentJSON is called", func() {
+			var page struct {
+				Type        string `json:"type"`
+				Description struct {
+					Title string `json:"title"`
+				} `json:"description"`
+			}
+			err := zebedeeClient.GetContentJSON(session, collectionId, uri, &page)
+
+			Convey("Then the content is decoded into the value", func() {
+				So(err, ShouldBeNil)
+				So(page.Type, ShouldEqual, "static_page")
+				So(page.Description.Title, ShouldEqual, "About us")
+			})
+		})
+	})
+
+	Convey("Given a mock HTTP client that returns content which is not JSON", t, func() {
+		zebedeeClient := NewClient(host, mockHttpResponse(http.StatusOK, "year,value"))
+
+		Convey("Then GetContentJSON returns an error", func() {
+			var v map[string]interface{}
+			err := zebedeeClient.GetContentJSON(session, collectionId, uri, &v)
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given the content does not exist", t, func() {
+		zebedeeClient := NewClient(host, mockHttpResponse(http.StatusNotFound, `{"message":"Content not found"}`))
+
+		Convey("Then GetContentJSON returns a not found error", func() {
+			var v map[string]interface{}
+			err := zebedeeClient.GetContentJSON(session, collectionId, uri, &v)
+			So(errors.Is(err, ErrNotFound), ShouldBeTrue)
+		})
+	})
+}
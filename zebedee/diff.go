@@ -0,0 +1,472 @@
+package zebedee
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// DiffStatus is the overall difference between the published and collection versions of content.
+type DiffStatus string
+
+// Possible values for DiffStatus
+const (
+	DiffUnchanged DiffStatus = "unchanged"
+	// DiffAdded is content in the collection which has not been published.
+	DiffAdded    DiffStatus = "added"
+	DiffModified DiffStatus = "modified"
+	// DiffDeleted is published content the collection will delete.
+	DiffDeleted DiffStatus = "deleted"
+)
+
+// Change operations, matching the RFC 6902 JSON patch operations.
+const (
+	OpAdd     = "add"
+	OpRemove  = "remove"
+	OpReplace = "replace"
+)
+
+// unorderedFields are the fields compared as sets, where a change in order is not a change to the content
+var unorderedFields = map[string]bool{
+	"/description/keywords": true,
+}
+
+// DiffAPI defines the endpoints used to diff collection content with the published content.
+type DiffAPI interface {
+	CollectionsAPI
+	ContentAPI
+	PublishedContentAPI
+}
+
+// Change is a single change to a field of the content.
+type Change struct {
+	Op string
+	// Path is the JSON pointer (RFC 6901) to the field, e.g. "/description/title" or "/links/2".
+	Path string
+	// Field is the readable path to the field, e.g. "description.title" or "links[2]".
+	Field string
+	// From is the published value, nil for added fields.
+	From interface{}
+	// To is the collection value, nil for removed fields.
+	To interface{}
+}
+
+// ContentDiff is the difference between the published and collection versions of the content at a URI.
+type ContentDiff struct {
+	URI     string
+	Status  DiffStatus
+	Changes []Change
+}
+
+// CollectionDiff is the difference between the published content and each item of content in a collection.
+type CollectionDiff struct {
+	CollectionID string
+	Content      []ContentDiff
+}
+
+// DiffContent compare the content at the URI in the collection with the published version.
+func DiffContent(ctx context.Context, cli DiffAPI, s Session, collectionID, uri string) (ContentDiff, error) {
+	collection, err := cli.GetContentContext(ctx, s, collectionID, uri)
+	if err != nil {
+		return ContentDiff{}, err
+	}
+
+	published, err := cli.GetPublishedContentContext(ctx, s, uri)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return ContentDiff{}, err
+	}
+
+	return NewContentDiff(uri, published, collection), nil
+}
+
+// DiffCollection compare each item of content listed in the collection details with the published version, ordered
+// by URI. Content pending deletion is included as deleted.
+func DiffCollection(ctx context.Context, cli DiffAPI, s Session, collectionID string) (CollectionDiff, error) {
+	details, err := cli.GetCollectionDetailsContext(ctx, s, collectionID)
+	if err != nil {
+		return CollectionDiff{}, err
+	}
+
+	uris := contentURIs(details)
+	diff := CollectionDiff{CollectionID: collectionID, Content: make([]ContentDiff, 0, len(uris))}
+	for _, uri := range uris {
+		d, err := DiffContent(ctx, cli, s, collectionID, uri)
+		if err != nil {
+			return CollectionDiff{}, fmt.Errorf("diff %s: %w", uri, err)
+		}
+		diff.Content = append(diff.Content, d)
+	}
+
+	for _, pd := range details.PendingDeletes {
+		diff.Content = append(diff.Content, ContentDiff{URI: pd.Root.URI, Status: DiffDeleted, Changes: []Change{{Op: OpRemove}}})
+	}
+
+	return diff, nil
+}
+
+// NewContentDiff compare the published and collection versions of the content at the URI. A nil published version is
+// content which has not been published, and a nil collection version is content being deleted. JSON content is
+// compared field by field, with markdown fields compared as a whole and description keywords compared as a set.
+// Other content, e.g. CSV files, is compared as a whole.
+func NewContentDiff(uri string, published, collection []byte) ContentDiff {
+	d := ContentDiff{URI: uri}
+
+	from, to := decodeContent(published), decodeContent(collection)
+	switch {
+	case published == nil && collection == nil:
+		d.Status = DiffUnchanged
+	case published == nil:
+		d.Status = DiffAdded
+		d.Changes = []Change{{Op: OpAdd, To: to}}
+	case collection == nil:
+		d.Status = DiffDeleted
+		d.Changes = []Change{{Op: OpRemove, From: from}}
+	default:
+		diffValues("", "", from, to, &d.Changes)
+		d.Status = DiffModified
+		if len(d.Changes) == 0 {
+			d.Status = DiffUnchanged
+		}
+	}
+
+	return d
+}
+
+// Added return the fields added by the collection.
+func (d ContentDiff) Added() []Change {
+	return d.changes(OpAdd)
+}
+
+// Removed return the fields removed by the collection.
+func (d ContentDiff) Removed() []Change {
+	return d.changes(OpRemove)
+}
+
+// Changed return the fields with a different value in the collection.
+func (d ContentDiff) Changed() []Change {
+	return d.changes(OpReplace)
+}
+
+func (d ContentDiff) changes(op string) []Change {
+	changes := make([]Change, 0)
+	for _, c := range d.Changes {
+		if c.Op == op {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+// patchOperation is a single RFC 6902 JSON patch operation
+type patchOperation struct {
+	Op    string       `json:"op"`
+	Path  string       `json:"path"`
+	Value *interface{} `json:"value,omitempty"`
+}
+
+// Patch return the diff as an RFC 6902 JSON patch, which transforms the published version into the collection version.
+// Deleted content has no patch, as a patch cannot remove the whole document, and ErrNoPatch is returned.
+func (d ContentDiff) Patch() ([]byte, error) {
+	if d.Status == DiffDeleted {
+		return nil, fmt.Errorf("%w: %s is deleted", ErrNoPatch, d.URI)
+	}
+
+	ops := make([]patchOperation, 0, len(d.Changes))
+	for _, c := range d.Changes {
+		op := patchOperation{Op: c.Op, Path: c.Path}
+		if c.Op != OpRemove {
+			value := c.To
+			op.Value = &value
+		}
+		ops = append(ops, op)
+	}
+	return json.Marshal(ops)
+}
+
+// Text return the diff as readable text, with description fields listed first. Added fields are prefixed with +,
+// removed fields with - and changed fields with ~. Changes to markdown are shown line by line.
+func (d ContentDiff) Text() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s\n", d.Status, d.URI)
+
+	if d.Status != DiffModified {
+		return b.String()
+	}
+
+	for _, c := range d.Changes {
+		switch {
+		case c.Op == OpAdd:
+			fmt.Fprintf(&b, "  + %s: %s\n", fieldLabel(c), formatValue(c.To))
+		case c.Op == OpRemove:
+			fmt.Fprintf(&b, "  - %s: %s\n", fieldLabel(c), formatValue(c.From))
+		case isTextChange(c):
+			fmt.Fprintf(&b, "  ~ %s:\n", fieldLabel(c))
+			for _, line := range lineDiff(textLines(c.From), textLines(c.To)) {
+				fmt.Fprintf(&b, "      %s\n", line)
+			}
+		default:
+			fmt.Fprintf(&b, "  ~ %s: %s -> %s\n", fieldLabel(c), formatValue(c.From), formatValue(c.To))
+		}
+	}
+
+	return b.String()
+}
+
+func (d ContentDiff) String() string {
+	return d.Text()
+}
+
+// Modified return the content which is different in the collection from the published content.
+func (d CollectionDiff) Modified() []ContentDiff {
+	modified := make([]ContentDiff, 0)
+	for _, c := range d.Content {
+		if c.Status != DiffUnchanged {
+			modified = append(modified, c)
+		}
+	}
+	return modified
+}
+
+// Text return the diff of each item of modified content as readable text. See ContentDiff.Text.
+func (d CollectionDiff) Text() string {
+	texts := make([]string, 0, len(d.Content))
+	for _, c := range d.Modified() {
+		texts = append(texts, c.Text())
+	}
+	return strings.Join(texts, "\n")
+}
+
+func (d CollectionDiff) String() string {
+	return d.Text()
+}
+
+// decodeContent return the content decoded from JSON, or as a string if it is not JSON
+func decodeContent(b []byte) interface{} {
+	if b == nil {
+		return nil
+	}
+
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil || dec.More() {
+		return string(b)
+	}
+	return v
+}
+
+// diffValues append the changes from one value to another, walking objects and arrays
+func diffValues(path, field string, from, to interface{}, changes *[]Change) {
+	replace := func() {
+		*changes = append(*changes, Change{Op: OpReplace, Path: path, Field: field, From: from, To: to})
+	}
+
+	switch f := from.(type) {
+	case map[string]interface{}:
+		t, ok := to.(map[string]interface{})
+		if !ok {
+			replace()
+			return
+		}
+
+		for _, k := range objectKeys(path, f, t) {
+			childPath := path + "/" + escapePointer(k)
+			childField := k
+			if field != "" {
+				childField = field + "." + k
+			}
+
+			fv, inFrom := f[k]
+			tv, inTo := t[k]
+			switch {
+			case !inTo:
+				*changes = append(*changes, Change{Op: OpRemove, Path: childPath, Field: childField, From: fv})
+			case !inFrom:
+				*changes = append(*changes, Change{Op: OpAdd, Path: childPath, Field: childField, To: tv})
+			default:
+				diffValues(childPath, childField, fv, tv, changes)
+			}
+		}
+
+	case []interface{}:
+		t, ok := to.([]interface{})
+		if !ok {
+			replace()
+			return
+		}
+
+		if isMarkdownPath(path) || unorderedFields[path] {
+			if !equalArrays(path, f, t) {
+				replace()
+			}
+			return
+		}
+
+		for i := 0; i < len(f) && i < len(t); i++ {
+			diffValues(path+"/"+strconv.Itoa(i), fmt.Sprintf("%s[%d]", field, i), f[i], t[i], changes)
+		}
+		for i := len(f); i < len(t); i++ {
+			*changes = append(*changes, Change{Op: OpAdd, Path: path + "/" + strconv.Itoa(i), Field: fmt.Sprintf("%s[%d]", field, i), To: t[i]})
+		}
+		// remove from the end so the indexes of the remaining items do not change as the patch is applied
+		for i := len(f) - 1; i >= len(t); i-- {
+			*changes = append(*changes, Change{Op: OpRemove, Path: path + "/" + strconv.Itoa(i), Field: fmt.Sprintf("%s[%d]", field, i), From: f[i]})
+		}
+
+	default:
+		if !reflect.DeepEqual(from, to) {
+			replace()
+		}
+	}
+}
+
+// objectKeys return the keys of both objects sorted, with the page description first
+func objectKeys(path string, from, to map[string]interface{}) []string {
+	keys := make([]string, 0, len(from)+len(to))
+	for k := range from {
+		keys = append(keys, k)
+	}
+	for k := range to {
+		if _, ok := from[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if path == "" && (keys[i] == "description") != (keys[j] == "description") {
+			return keys[i] == "description"
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
+
+// equalArrays compare the arrays, ignoring the order of the items for unordered fields
+func equalArrays(path string, from, to []interface{}) bool {
+	if !unorderedFields[path] {
+		return reflect.DeepEqual(from, to)
+	}
+
+	if len(from) != len(to) {
+		return false
+	}
+
+	counts := make(map[string]int)
+	for _, v := range from {
+		counts[formatValue(v)]++
+	}
+	for _, v := range to {
+		counts[formatValue(v)]--
+	}
+	for _, n := range counts {
+		if n != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func isMarkdownPath(path string) bool {
+	return path == "/markdown" || strings.HasSuffix(path, "/markdown")
+}
+
+// isTextChange returns true if the change is shown line by line, i.e. markdown or a text file
+func isTextChange(c Change) bool {
+	if isMarkdownPath(c.Path) {
+		return true
+	}
+	from, fromText := c.From.(string)
+	to, toText := c.To.(string)
+	return c.Path == "" && fromText && toText && utf8.ValidString(from) && utf8.ValidString(to)
+}
+
+// textLines return the lines of markdown, which is either a string or a list of strings, or of a text file
+func textLines(v interface{}) []string {
+	var text string
+	switch t := v.(type) {
+	case string:
+		text = t
+	case []interface{}:
+		parts := make([]string, 0, len(t))
+		for _, p := range t {
+			s, _ := p.(string)
+			parts = append(parts, s)
+		}
+		text = strings.Join(parts, "\n")
+	}
+
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// lineDiff return the lines removed and added between the texts prefixed with - and +, using the longest common
+// subsequence of lines
+func lineDiff(from, to []string) []string {
+	lcs := make([][]int, len(from)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(to)+1)
+	}
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+			if from[i] == to[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	lines := make([]string, 0)
+	i, j := 0, 0
+	for i < len(from) || j < len(to) {
+		switch {
+		case i < len(from) && j < len(to) && from[i] == to[j]:
+			i++
+			j++
+		case j == len(to) || (i < len(from) && lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, "- "+from[i])
+			i++
+		default:
+			lines = append(lines, "+ "+to[j])
+			j++
+		}
+	}
+	return lines
+}
+
+// fieldLabel return the readable name of the changed field, or "content" for the whole of the content
+func fieldLabel(c Change) string {
+	if c.Field == "" {
+		return "content"
+	}
+	return c.Field
+}
+
+// formatValue return the value as compact JSON, or a summary if the value is not valid text
+func formatValue(v interface{}) string {
+	if s, ok := v.(string); ok && !utf8.ValidString(s) {
+		return fmt.Sprintf("<%d bytes>", len(s))
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// escapePointer escape a key for use in a JSON pointer (RFC 6901)
+func escapePointer(key string) string {
+	return pointerEscaper.Replace(key)
+}
@@ -0,0 +1,164 @@
+package zebedee_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const (
+	publishedPage = `{
+		"type": "static_page",
+		"uri": "/about",
+		"description": {"title": "About", "keywords": ["ons", "about"], "summary": "Old summary"},
+		"markdown": ["# About\nWe are the ONS.\nWe publish statistics."],
+		"links": [{"title": "Contact", "uri": "/about/contact"}, {"title": "Jobs", "uri": "/about/jobs"}],
+		"relatedLinks": [{"uri": "/census"}]
+	}`
+	collectionPage = `{
+		"type": "static_page",
+		"uri": "/about",
+		"description": {"title": "About us", "keywords": ["about", "ons"], "metaDescription": "About the ONS"},
+		"markdown": ["# About\nWe are the Office for National Statistics.\nWe publish statistics."],
+		"links": [{"title": "Contact us", "uri": "/about/contact"}]
+	}`
+)
+
+func TestNewContentDiff(t *testing.T) {
+	Convey("Given a published page and an edited version in a collection", t, func() {
+		d := zebedee.NewContentDiff("/about/data.json", []byte(publishedPage), []byte(collectionPage))
+
+		Convey("Then the page is modified", func() {
+			So(d.Status, ShouldEqual, zebedee.DiffModified)
+		})
+
+		Convey("Then the added, removed and changed fields are listed", func() {
+			So(fields(d.Added()), ShouldResemble, []string{"description.metaDescription"})
+			So(fields(d.Removed()), ShouldResemble, []string{"description.summary", "links[1]", "relatedLinks"})
+			So(fields(d.Changed()), ShouldResemble, []string{"description.title", "links[0].title", "markdown"})
+		})
+
+		Convey("Then the order of the description keywords is ignored", func() {
+			for _, c := range d.Changes {
+				So(c.Path, ShouldNotStartWith, "/description/keywords")
+			}
+		})
+
+		Convey("Then the RFC 6902 patch has an operation for each change", func() {
+			b, err := d.Patch()
+			So(err, ShouldBeNil)
+			So(string(b), ShouldEqualJSON, `[
+				{"op": "add", "path": "/description/metaDescription", "value": "About the ONS"},
+				{"op": "remove", "path": "/description/summary"},
+				{"op": "replace", "path": "/description/title", "value": "About us"},
+				{"op": "replace", "path": "/links/0/title", "value": "Contact us"},
+				{"op": "remove", "path": "/links/1"},
+				{"op": "replace", "path": "/markdown", "value": ["# About\nWe are the Office for National Statistics.\nWe publish statistics."]},
+				{"op": "remove", "path": "/relatedLinks"}
+			]`)
+		})
+
+		Convey("Then the text lists the description first and the markdown line by line", func() {
+			So(d.Text(), ShouldEqual, `modified /about/data.json
+  + description.metaDescription: "About the ONS"
+  - description.summary: "Old summary"
+  ~ description.title: "About" -> "About us"
+  ~ links[0].title: "Contact" -> "Contact us"
+  - links[1]: {"title":"Jobs","uri":"/about/jobs"}
+  ~ markdown:
+      - We are the ONS.
+      + We are the Office for National Statistics.
+  - relatedLinks: [{"uri":"/census"}]
+`)
+		})
+	})
+
+	Convey("Given the same page in the collection as published", t, func() {
+		d := zebedee.NewContentDiff("/about/data.json", []byte(publishedPage), []byte(publishedPage))
+
+		Convey("Then the page is unchanged", func() {
+			So(d.Status, ShouldEqual, zebedee.DiffUnchanged)
+			So(d.Changes, ShouldBeEmpty)
+		})
+	})
+
+	Convey("Given a page which has not been published", t, func() {
+		d := zebedee.NewContentDiff("/about/data.json", nil, []byte(collectionPage))
+
+		Convey("Then the page is added", func() {
+			So(d.Status, ShouldEqual, zebedee.DiffAdded)
+			So(d.Added(), ShouldHaveLength, 1)
+			So(d.Text(), ShouldEqual, "added /about/data.json\n")
+		})
+	})
+
+	Convey("Given a page which the collection deletes", t, func() {
+		d := zebedee.NewContentDiff("/about/data.json", []byte(publishedPage), nil)
+
+		Convey("Then the page is deleted and has no patch", func() {
+			So(d.Status, ShouldEqual, zebedee.DiffDeleted)
+
+			b, err := d.Patch()
+			So(errors.Is(err, zebedee.ErrNoPatch), ShouldBeTrue)
+			So(b, ShouldBeNil)
+		})
+	})
+
+	Convey("Given a CSV file with a changed line", t, func() {
+		d := zebedee.NewContentDiff("/about/data.csv", []byte("year,value\n2020,1\n"), []byte("year,value\n2020,2\n"))
+
+		Convey("Then the file is changed as a whole and shown line by line", func() {
+			So(d.Status, ShouldEqual, zebedee.DiffModified)
+			So(d.Text(), ShouldEqual, "modified /about/data.csv\n  ~ content:\n      - 2020,1\n      + 2020,2\n")
+		})
+	})
+}
+
+func TestDiffCollection(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("Given a collection editing a published page and adding a new page", t, func() {
+		fake, cli, editor, _ := newFake()
+		defer fake.Close()
+
+		fake.SetPublishedContent("/about/data.json", []byte(publishedPage))
+
+		desc, err := cli.CreateCollection(editor, zebedee.NewCollection("About"))
+		So(err, ShouldBeNil)
+		So(cli.UpdateCollectionContent(editor, desc.ID, "/about/data.json", newPage("About us")), ShouldBeNil)
+		So(cli.UpdateCollectionContent(editor, desc.ID, "/about/new/data.json", newPage("New")), ShouldBeNil)
+
+		Convey("When the collection is diffed", func() {
+			diff, err := zebedee.DiffCollection(ctx, cli, editor, desc.ID)
+			So(err, ShouldBeNil)
+
+			Convey("Then each item of content is compared with the published version", func() {
+				So(diff.Content, ShouldHaveLength, 2)
+				So(diff.Content[0].URI, ShouldEqual, "/about/data.json")
+				So(diff.Content[0].Status, ShouldEqual, zebedee.DiffModified)
+				So(diff.Content[1].URI, ShouldEqual, "/about/new/data.json")
+				So(diff.Content[1].Status, ShouldEqual, zebedee.DiffAdded)
+			})
+		})
+
+		Convey("When a single page is diffed", func() {
+			d, err := zebedee.DiffContent(ctx, cli, editor, desc.ID, "/about/data.json")
+
+			Convey("Then the changed title is listed", func() {
+				So(err, ShouldBeNil)
+				So(fields(d.Changed()), ShouldContain, "description.title")
+			})
+		})
+	})
+}
+
+func fields(changes []zebedee.Change) []string {
+	f := make([]string, 0, len(changes))
+	for _, c := range changes {
+		f = append(f, c.Field)
+	}
+	return f
+}
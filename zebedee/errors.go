@@ -0,0 +1,83 @@
+package zebedee
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Sentinel errors used to classify the errors returned by the Client. Use errors.Is to check for them, e.g.
+//
+//	if errors.Is(err, zebedee.ErrNotFound) { ... }
+var (
+	// ErrValidation is returned when Zebedee rejects a request as invalid (400).
+	ErrValidation = errors.New("zebedee request validation failed")
+	// ErrUnauthorized is returned when the session is missing or has expired (401).
+	ErrUnauthorized = errors.New("zebedee request unauthorized")
+	// ErrForbidden is returned when the user does not have permission for the request (403).
+	ErrForbidden = errors.New("zebedee request forbidden")
+	// ErrNotFound is returned when the requested resource does not exist (404).
+	ErrNotFound = errors.New("zebedee resource not found")
+	// ErrConflict is returned when the request conflicts with the current state of the resource (409).
+	ErrConflict = errors.New("zebedee request conflict")
+	// ErrCollectionLocked is returned when a collection cannot be changed because it has been approved.
+	// It is a more specific ErrConflict.
+	ErrCollectionLocked = errors.New("zebedee collection locked")
+	// ErrUnsuccessful is returned when Zebedee responds with a success status but a false result.
+	ErrUnsuccessful = errors.New("zebedee request unsuccessful")
+	// ErrInvalidTransition is returned when a collection or content transition is not allowed from the current state.
+	// It is returned before any request is sent to Zebedee.
+	ErrInvalidTransition = errors.New("zebedee invalid workflow transition")
+	// ErrPublishFailed is returned when the publish result of a collection reports an error.
+	ErrPublishFailed = errors.New("zebedee publish failed")
+	// ErrInvalidPublishDate is returned when a collection publish date cannot be parsed or is not in the future.
+	ErrInvalidPublishDate = errors.New("zebedee invalid publish date")
+	// ErrInvalidRelocation is returned when content cannot be copied or moved to the URI requested, e.g. below itself.
+	ErrInvalidRelocation = errors.New("zebedee invalid content relocation")
+	// ErrNoPatch is returned by ContentDiff.Patch for deleted content, which cannot be expressed as a JSON patch.
+	ErrNoPatch = errors.New("zebedee content diff has no patch")
+	// ErrInvalidArchive is returned by ImportCollection when the archive was not written by ExportCollection.
+	ErrInvalidArchive = errors.New("zebedee invalid collection archive")
+)
+
+// UnsuccessfulError is returned by requests where Zebedee responds with a success status but a false result.
+type UnsuccessfulError struct {
+	// Operation is the name of the operation that was unsuccessful, e.g. "publish collection".
+	Operation string
+	// ID of the collection the operation was requested for.
+	ID string
+}
+
+func (err *UnsuccessfulError) Error() string {
+	return fmt.Sprintf("%s request unsuccessful: %s", err.Operation, err.ID)
+}
+
+// Is reports whether the target is ErrUnsuccessful.
+func (err *UnsuccessfulError) Is(target error) bool {
+	return target == ErrUnsuccessful
+}
+
+// serverError is the JSON error body returned by Zebedee.
+type serverError struct {
+	Message string `json:"message"`
+}
+
+// parseServerMessage return the message from a Zebedee JSON error body, or an empty string if the body is not JSON.
+func parseServerMessage(body []byte) string {
+	var e serverError
+	if err := json.Unmarshal(body, &e); err != nil {
+		return ""
+	}
+	return e.Message
+}
+
+// collectionApprovedMessage is the message of the 409 Conflict response Zebedee sends when content is changed in a
+// collection which has been approved, see the collection content handlers of github.com/ONSdigital/zebedee. Other
+// conflicts, such as publishing a collection which has not been approved, are not locked collections.
+const collectionApprovedMessage = "This collection has been approved and cannot be edited"
+
+// isLockedMessage return true if the Zebedee error message is the one sent for a collection locked for editing.
+func isLockedMessage(msg string) bool {
+	return strings.TrimSpace(msg) == collectionApprovedMessage
+}
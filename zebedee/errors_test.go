@@ -0,0 +1,130 @@
+package zebedee
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_APIError_Is(t *testing.T) {
+	session := newSession()
+
+	cases := []struct {
+		status   int
+		body     string
+		expected error
+	}{
+		{http.StatusBadRequest, `{"message":"Invalid page json"}`, ErrValidation},
+		{http.StatusUnauthorized, ``, ErrUnauthorized},
+		{http.StatusForbidden, ``, ErrForbidden},
+		{http.StatusNotFound, `{"message":"Collection not found"}`, ErrNotFound},
+		{http.StatusConflict, `{"message":"URI is already in another collection"}`, ErrConflict},
+	}
+
+	for _, c := range cases {
+		Convey("Given Zebedee returns a "+http.StatusText(c.status)+" response", t, func() {
+			httpClient := mockHttpResponse(c.status, c.body)
+			zebedeeClient := NewClient(host, httpClient)
+
+			Convey("When GetCollectionByID is called", func() {
+				_, err := zebedeeClient.GetCollectionByID(session, collectionId)
+
+				Convey("Then the error matches the expected sentinel error only", func() {
+					So(errors.Is(err, c.expected), ShouldBeTrue)
+					for _, other := range cases {
+						if other.expected != c.expected {
+							So(errors.Is(err, other.expected), ShouldBeFalse)
+						}
+					}
+					So(errors.Is(err, ErrCollectionLocked), ShouldBeFalse)
+				})
+			})
+		})
+	}
+
+	Convey("Given Zebedee returns a conflict because the collection is approved", t, func() {
+		httpClient := mockHttpResponse(http.StatusConflict, `{"message":"This collection has been approved and cannot be edited","statusCode":409}`)
+		zebedeeClient := NewClient(host, httpClient)
+
+		Convey("When UpdateCollectionContent is called", func() {
+			err := zebedeeClient.UpdateCollectionContent(session, collectionId, uri, getContent())
+
+			Convey("Then the error is classified as a locked collection and a conflict", func() {
+				So(errors.Is(err, ErrCollectionLocked), ShouldBeTrue)
+				So(errors.Is(err, ErrConflict), ShouldBeTrue)
+			})
+
+			Convey("Then the Zebedee error body is parsed into the APIError", func() {
+				var apiErr *APIError
+				So(errors.As(err, &apiErr), ShouldBeTrue)
+				So(apiErr.ServerMessage, ShouldEqual, "This collection has been approved and cannot be edited")
+				So(apiErr.Method, ShouldEqual, http.MethodPost)
+				So(apiErr.URI, ShouldStartWith, "/content/"+collectionId)
+			})
+		})
+	})
+
+	lockedCases := []struct {
+		message string
+		locked  bool
+	}{
+		{collectionApprovedMessage, true},
+		{"This collection has not been approved", false},
+	}
+
+	for _, c := range lockedCases {
+		Convey("Given Zebedee returns a conflict with the message "+c.message, t, func() {
+			httpClient := mockHttpResponse(http.StatusConflict, `{"message":"`+c.message+`","statusCode":409}`)
+			zebedeeClient := NewClient(host, httpClient)
+
+			Convey("When PublishCollection is called", func() {
+				err := zebedeeClient.PublishCollection(session, collectionId)
+
+				Convey("Then the error is a conflict and only a locked collection for the locked message", func() {
+					So(errors.Is(err, ErrConflict), ShouldBeTrue)
+					So(errors.Is(err, ErrCollectionLocked), ShouldEqual, c.locked)
+				})
+			})
+		})
+	}
+
+	Convey("Given Zebedee returns an error body that is not JSON", t, func() {
+		httpClient := mockHttpResponse(http.StatusInternalServerError, "<html>error</html>")
+		zebedeeClient := NewClient(host, httpClient)
+
+		Convey("When GetCollectionByID is called", func() {
+			_, err := zebedeeClient.GetCollectionByID(session, collectionId)
+
+			Convey("Then the raw body is kept and no server message is set", func() {
+				var apiErr *APIError
+				So(errors.As(err, &apiErr), ShouldBeTrue)
+				So(apiErr.Body, ShouldEqual, "<html>error</html>")
+				So(apiErr.ServerMessage, ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+func Test_UnsuccessfulError(t *testing.T) {
+	session := newSession()
+
+	Convey("Given Zebedee returns a false result", t, func() {
+		httpClient := mockHttpResponse(http.StatusOK, `false`)
+		zebedeeClient := NewClient(host, httpClient)
+
+		Convey("When PublishCollection is called", func() {
+			err := zebedeeClient.PublishCollection(session, collectionId)
+
+			Convey("Then an UnsuccessfulError is returned", func() {
+				So(errors.Is(err, ErrUnsuccessful), ShouldBeTrue)
+
+				var unsuccessful *UnsuccessfulError
+				So(errors.As(err, &unsuccessful), ShouldBeTrue)
+				So(unsuccessful.Operation, ShouldEqual, "publish collection")
+				So(unsuccessful.ID, ShouldEqual, collectionId)
+			})
+		})
+	})
+}
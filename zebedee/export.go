@@ -0,0 +1,209 @@
+package zebedee
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+// Entries of a collection archive, in the order they are written. Content is stored below the content directory by
+// its URI, e.g. content/about/data.json.
+const (
+	archiveDescription = "collection.json"
+	archiveDetails     = "details.json"
+	archiveContentDir  = "content"
+)
+
+// ImportOptions are the options for ImportCollection.
+type ImportOptions struct {
+	// Name of the new collection, defaulting to the name of the exported collection.
+	Name string
+	// PublishDate of the new collection, which must be in the future. A zero time keeps the publish date of the
+	// exported collection, which must also be in the future if the collection is scheduled.
+	PublishDate time.Time
+	// ReplayStates completes the content which was complete or reviewed when the collection was exported.
+	ReplayStates bool
+	// Reviewer is the session used to review the content which was reviewed when the collection was exported, if
+	// ReplayStates is set. Zebedee requires the reviewer to be a different user to the one who completed the content.
+	// If not set the reviewed content is left complete.
+	Reviewer Session
+}
+
+// ExportCollection write the collection description, collection details and every item of content in the collection
+// to a tar archive, which can be imported with ImportCollection.
+func ExportCollection(ctx context.Context, cli Client, s Session, id string, w io.Writer) error {
+	desc, err := cli.GetCollectionByIDContext(ctx, s, id)
+	if err != nil {
+		return err
+	}
+
+	details, err := cli.GetCollectionDetailsContext(ctx, s, id)
+	if err != nil {
+		return err
+	}
+
+	tw := tar.NewWriter(w)
+	modTime := time.Now()
+
+	if err := writeArchiveJSON(tw, archiveDescription, desc, modTime); err != nil {
+		return err
+	}
+	if err := writeArchiveJSON(tw, archiveDetails, details, modTime); err != nil {
+		return err
+	}
+
+	for _, uri := range contentURIs(details) {
+		b, err := cli.GetContentContext(ctx, s, id, uri)
+		if err != nil {
+			return fmt.Errorf("export %s: %w", uri, err)
+		}
+		if err := writeArchiveFile(tw, path.Join(archiveContentDir, uri), b, modTime); err != nil {
+			return err
+		}
+	}
+
+	return tw.Close()
+}
+
+// ImportCollection create a new collection from an archive written by ExportCollection, adding each item of content.
+// The collection is created with the name, type, publish date and teams of the exported collection. If an error is
+// returned after the collection is created, the description of the partly imported collection is returned with it.
+func ImportCollection(ctx context.Context, cli Client, s Session, r io.Reader, opts ImportOptions) (CollectionDescription, error) {
+	tr := tar.NewReader(r)
+
+	var exported CollectionDescription
+	if err := readArchiveJSON(tr, archiveDescription, &exported); err != nil {
+		return CollectionDescription{}, err
+	}
+
+	var details CollectionDetails
+	if err := readArchiveJSON(tr, archiveDetails, &details); err != nil {
+		return CollectionDescription{}, err
+	}
+
+	spec := NewCollection(exported.Name)
+	if opts.Name != "" {
+		spec.Name = opts.Name
+	}
+	spec.Type = exported.Type
+	spec.PublishDate = exported.PublishDate
+	spec.ReleaseURI = exported.ReleaseURI
+	if exported.Teams != nil {
+		spec.Teams = exported.Teams
+	}
+
+	if opts.PublishDate.IsZero() {
+		if err := validateCopiedSchedule(spec); err != nil {
+			return CollectionDescription{}, err
+		}
+	} else {
+		if err := validateSchedule(opts.PublishDate); err != nil {
+			return CollectionDescription{}, err
+		}
+		spec.PublishDate = FormatPublishDate(opts.PublishDate)
+	}
+
+	desc, err := cli.CreateCollectionContext(ctx, s, spec)
+	if err != nil {
+		return CollectionDescription{}, err
+	}
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return desc, err
+		}
+
+		uri, ok := strings.CutPrefix(path.Clean(hdr.Name), archiveContentDir+"/")
+		if !ok || hdr.Typeflag != tar.TypeReg {
+			return desc, fmt.Errorf("%w: unexpected entry %s", ErrInvalidArchive, hdr.Name)
+		}
+
+		if err := importContent(ctx, cli, s, desc.ID, "/"+uri, tr, ""); err != nil {
+			return desc, fmt.Errorf("import /%s: %w", uri, err)
+		}
+	}
+
+	if !opts.ReplayStates {
+		return desc, nil
+	}
+
+	state := NewCollectionState(details)
+	for _, uri := range append(state.URIs(ContentComplete), state.URIs(ContentReviewed)...) {
+		if err := cli.CompleteCollectionContentContext(ctx, s, desc.ID, uri); err != nil {
+			return desc, fmt.Errorf("complete %s: %w", uri, err)
+		}
+	}
+
+	if opts.Reviewer.isZero() {
+		return desc, nil
+	}
+
+	for _, uri := range state.URIs(ContentReviewed) {
+		if err := cli.ReviewCollectionContentContext(ctx, opts.Reviewer, desc.ID, uri); err != nil {
+			return desc, fmt.Errorf("review %s: %w", uri, err)
+		}
+	}
+
+	return desc, nil
+}
+
+// importContent add the content to the collection, as page JSON for .json files and as a file upload otherwise
+func importContent(ctx context.Context, cli Client, s Session, id, uri string, r io.Reader, contentType string) error {
+	if path.Ext(uri) != ".json" {
+		return cli.UploadFileContext(ctx, s, id, uri, r, contentType)
+	}
+
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return cli.UpdateCollectionContentContext(ctx, s, id, uri, json.RawMessage(b))
+}
+
+func writeArchiveJSON(tw *tar.Writer, name string, v interface{}, modTime time.Time) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeArchiveFile(tw, name, b, modTime)
+}
+
+func writeArchiveFile(tw *tar.Writer, name string, b []byte, modTime time.Time) error {
+	hdr := &tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(b)),
+		ModTime: modTime,
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+
+	_, err := tw.Write(b)
+	return err
+}
+
+// readArchiveJSON read the next entry of the archive, which must have the name provided, into v
+func readArchiveJSON(tr *tar.Reader, name string, v interface{}) error {
+	hdr, err := tr.Next()
+	if err != nil {
+		return fmt.Errorf("%w: %s not found: %v", ErrInvalidArchive, name, err)
+	}
+	if hdr.Name != name {
+		return fmt.Errorf("%w: expected %s but found %s", ErrInvalidArchive, name, hdr.Name)
+	}
+
+	if err := json.NewDecoder(tr).Decode(v); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidArchive, name, err)
+	}
+	return nil
+}
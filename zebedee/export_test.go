@@ -0,0 +1,183 @@
+package zebedee_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestExportImportCollection(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("Given a collection with pages in each state and a file", t, func() {
+		fake, cli, editor, reviewer := newFake()
+		defer fake.Close()
+
+		spec := zebedee.NewCollection("Census")
+		spec.Teams = []string{"census-team"}
+		desc, err := cli.CreateCollection(editor, spec)
+		So(err, ShouldBeNil)
+
+		So(cli.UpdateCollectionContent(editor, desc.ID, "/census/data.json", newPage("Census")), ShouldBeNil)
+		So(cli.UpdateCollectionContent(editor, desc.ID, "/census/results/data.json", newPage("Results")), ShouldBeNil)
+		So(cli.UpdateCollectionContent(editor, desc.ID, "/census/maps/data.json", newPage("Maps")), ShouldBeNil)
+		So(cli.UploadFile(editor, desc.ID, "/census/results/results.csv", strings.NewReader("area,count\n"), "text/csv"), ShouldBeNil)
+		So(cli.CompleteCollectionContent(editor, desc.ID, "/census/results/data.json"), ShouldBeNil)
+		So(cli.CompleteCollectionContent(editor, desc.ID, "/census/maps/data.json"), ShouldBeNil)
+		So(cli.ReviewCollectionContent(reviewer, desc.ID, "/census/maps/data.json"), ShouldBeNil)
+
+		Convey("When the collection is exported", func() {
+			var archive bytes.Buffer
+			So(zebedee.ExportCollection(ctx, cli, editor, desc.ID, &archive), ShouldBeNil)
+
+			Convey("Then the archive holds the description, details and each item of content", func() {
+				var names []string
+				tr := tar.NewReader(bytes.NewReader(archive.Bytes()))
+				for {
+					hdr, err := tr.Next()
+					if err == io.EOF {
+						break
+					}
+					So(err, ShouldBeNil)
+					names = append(names, hdr.Name)
+				}
+
+				So(names, ShouldResemble, []string{
+					"collection.json",
+					"details.json",
+					"content/census/data.json",
+					"content/census/maps/data.json",
+					"content/census/results/data.json",
+					"content/census/results/results.csv",
+				})
+			})
+
+			Convey("And it is imported into another environment with its states replayed", func() {
+				other, otherCli, otherEditor, otherReviewer := newFake()
+				defer other.Close()
+
+				imported, err := zebedee.ImportCollection(ctx, otherCli, otherEditor, &archive, zebedee.ImportOptions{
+					ReplayStates: true,
+					Reviewer:     otherReviewer,
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then the collection is recreated", func() {
+					So(imported.ID, ShouldNotBeEmpty)
+					So(imported.Name, ShouldEqual, "Census")
+					So(imported.Teams, ShouldResemble, []string{"census-team"})
+				})
+
+				Convey("Then the content and its states are recreated", func() {
+					details, err := otherCli.GetCollectionDetails(otherEditor, imported.ID)
+					So(err, ShouldBeNil)
+
+					state := zebedee.NewCollectionState(details)
+					So(state.URIs(zebedee.ContentInProgress), ShouldResemble, []string{"/census/data.json", "/census/results/results.csv"})
+					So(state.URIs(zebedee.ContentComplete), ShouldResemble, []string{"/census/results/data.json"})
+					So(state.URIs(zebedee.ContentReviewed), ShouldResemble, []string{"/census/maps/data.json"})
+
+					b, err := otherCli.GetContent(otherEditor, imported.ID, "/census/results/results.csv")
+					So(err, ShouldBeNil)
+					So(string(b), ShouldEqual, "area,count\n")
+				})
+			})
+
+			Convey("And it is imported with states replayed by a reviewer from a session provider", func() {
+				other, otherCli, otherEditor, _ := newFake()
+				defer other.Close()
+
+				provider := zebedee.NewSessionProvider(otherCli, zebedee.Credentials{Email: "reviewer@ons.gov.uk", Password: "password"})
+				imported, err := zebedee.ImportCollection(ctx, otherCli, otherEditor, &archive, zebedee.ImportOptions{
+					ReplayStates: true,
+					Reviewer:     provider.Session(),
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then the reviewed content is reviewed", func() {
+					details, err := otherCli.GetCollectionDetails(otherEditor, imported.ID)
+					So(err, ShouldBeNil)
+
+					state := zebedee.NewCollectionState(details)
+					So(state.URIs(zebedee.ContentReviewed), ShouldResemble, []string{"/census/maps/data.json"})
+				})
+			})
+
+			Convey("And it is imported with a new name without replaying states", func() {
+				other, otherCli, otherEditor, _ := newFake()
+				defer other.Close()
+
+				imported, err := zebedee.ImportCollection(ctx, otherCli, otherEditor, &archive, zebedee.ImportOptions{Name: "Census copy"})
+				So(err, ShouldBeNil)
+				So(imported.Name, ShouldEqual, "Census copy")
+
+				details, err := otherCli.GetCollectionDetails(otherEditor, imported.ID)
+				So(err, ShouldBeNil)
+				So(details.InProgress, ShouldHaveLength, 4)
+			})
+		})
+	})
+
+	Convey("Given an exported scheduled collection with a publish date in the past", t, func() {
+		fake, cli, editor, _ := newFake()
+		defer fake.Close()
+
+		spec := zebedee.NewCollection("GDP January")
+		spec.Type = zebedee.Scheduled
+		spec.PublishDate = zebedee.FormatPublishDate(time.Now().Add(-30 * 24 * time.Hour))
+		desc, err := cli.CreateCollection(editor, spec)
+		So(err, ShouldBeNil)
+
+		var archive bytes.Buffer
+		So(zebedee.ExportCollection(ctx, cli, editor, desc.ID, &archive), ShouldBeNil)
+
+		other, otherCli, otherEditor, _ := newFake()
+		defer other.Close()
+
+		Convey("When it is imported without a new publish date", func() {
+			_, err := zebedee.ImportCollection(ctx, otherCli, otherEditor, &archive, zebedee.ImportOptions{})
+
+			Convey("Then an invalid publish date error is returned", func() {
+				So(errors.Is(err, zebedee.ErrInvalidPublishDate), ShouldBeTrue)
+			})
+		})
+
+		Convey("When it is imported with a new publish date", func() {
+			next := time.Now().Add(24 * time.Hour).Truncate(time.Millisecond)
+			imported, err := zebedee.ImportCollection(ctx, otherCli, otherEditor, &archive, zebedee.ImportOptions{PublishDate: next})
+			So(err, ShouldBeNil)
+
+			Convey("Then the collection is scheduled at the new date", func() {
+				publishTime, err := imported.PublishTime()
+				So(err, ShouldBeNil)
+				So(publishTime.Equal(next), ShouldBeTrue)
+			})
+		})
+	})
+
+	Convey("Given an archive which was not written by ExportCollection", t, func() {
+		fake, cli, editor, _ := newFake()
+		defer fake.Close()
+
+		var archive bytes.Buffer
+		tw := tar.NewWriter(&archive)
+		So(tw.WriteHeader(&tar.Header{Name: "other.txt", Mode: 0644, Size: 2}), ShouldBeNil)
+		_, err := tw.Write([]byte("hi"))
+		So(err, ShouldBeNil)
+		So(tw.Close(), ShouldBeNil)
+
+		Convey("Then ImportCollection returns an invalid archive error without creating a collection", func() {
+			desc, err := zebedee.ImportCollection(ctx, cli, editor, &archive, zebedee.ImportOptions{})
+			So(errors.Is(err, zebedee.ErrInvalidArchive), ShouldBeTrue)
+			So(desc.ID, ShouldBeEmpty)
+		})
+	})
+}
@@ -0,0 +1,221 @@
+package zebedee
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"path"
+	"strings"
+)
+
+// errUploadNotRewindable is returned when an upload needs to be sent again but the reader is not an io.Seeker
+var errUploadNotRewindable = errors.New("upload cannot be retried as the reader is not an io.Seeker")
+
+// UploadFile uploads the file to the collection at the URI, e.g. a CSV or PDF alongside a data.json page.
+// The file is streamed from the reader rather than held in memory. The content length is set when the size of the
+// reader can be found, e.g. for *os.File, *bytes.Reader and *strings.Reader. Readers which cannot seek, including an
+// *os.File for a pipe or os.Stdin, are sent with an unknown length and cannot be sent again to retry or re-authenticate. If contentType is empty it is
+// detected from the extension of the URI.
+func (z *zebedeeClient) UploadFile(s Session, collectionID, uri string, r io.Reader, contentType string) error {
+	return z.UploadFileContext(context.Background(), s, collectionID, uri, r, contentType)
+}
+
+// UploadFileContext uploads the file to the collection at the URI, bound to the provided context.
+// See UploadFile for details.
+func (z *zebedeeClient) UploadFileContext(ctx context.Context, s Session, collectionID, uri string, r io.Reader, contentType string) error {
+	return z.UploadFileWithOptionsContext(ctx, s, collectionID, uri, r, contentType, uploadOptions())
+}
+
+// UploadFileWithOptions uploads the file to the collection at the URI using the provided content options, e.g. to
+// fail with a conflict rather than overwrite an existing file. See UploadFile for details.
+func (z *zebedeeClient) UploadFileWithOptions(s Session, collectionID, uri string, r io.Reader, contentType string, opts ContentOptions) error {
+	return z.UploadFileWithOptionsContext(context.Background(), s, collectionID, uri, r, contentType, opts)
+}
+
+// UploadFileWithOptionsContext uploads the file to the collection at the URI using the provided content options,
+// bound to the provided context. See UploadFile for details.
+func (z *zebedeeClient) UploadFileWithOptionsContext(ctx context.Context, s Session, collectionID, uri string, r io.Reader, contentType string, opts ContentOptions) error {
+	if contentType == "" {
+		contentType = contentTypeFor(uri)
+	}
+
+	body, err := newUploadBody(r, path.Base(uri), contentType)
+	if err != nil {
+		return err
+	}
+
+	endpoint := newEndpoint("content", collectionID).
+		query("uri", uri).
+		queryBool("overwriteExisting", opts.OverwriteExisting).
+		queryBool("recursive", opts.Recursive).
+		queryBool("validateJson", opts.ValidateJSON).
+		String()
+
+	req, err := z.newRequest(ctx, endpoint, http.MethodPost, nil)
+	if err != nil {
+		return err
+	}
+
+	if req, err = authenticate(req, s); err != nil {
+		return err
+	}
+
+	req.Body, err = body.open()
+	if err != nil {
+		return err
+	}
+	req.GetBody = body.open
+	req.ContentLength = body.size()
+	req.Header.Set("content-type", body.contentType)
+
+	// an upload overwriting existing content is safe to repeat if the file can be read again, whereas a repeated
+	// create only upload fails
+	if opts.OverwriteExisting && body.seeker != nil {
+		req = markIdempotent(req)
+	}
+
+	var success bool
+	err = z.requestObject(req, http.StatusOK, &success)
+	if err != nil {
+		return err
+	}
+
+	if !success {
+		return &UnsuccessfulError{Operation: "upload file", ID: collectionID}
+	}
+
+	return nil
+}
+
+// uploadOptions return the content options used by UploadFile, which overwrite existing content and apply to a single
+// file. Files are not page JSON so are not validated.
+func uploadOptions() ContentOptions {
+	return ContentOptions{OverwriteExisting: true}
+}
+
+// DownloadFile returns a reader for the file at the URI within the collection, streamed from Zebedee rather than held
+// in memory. The caller must close the reader.
+func (z *zebedeeClient) DownloadFile(s Session, collectionID, uri string) (io.ReadCloser, error) {
+	return z.DownloadFileContext(context.Background(), s, collectionID, uri)
+}
+
+// DownloadFileContext returns a reader for the file at the URI within the collection, bound to the provided context.
+// The caller must close the reader.
+func (z *zebedeeClient) DownloadFileContext(ctx context.Context, s Session, collectionID, uri string) (io.ReadCloser, error) {
+	body, _, err := z.GetContentStream(ctx, s, collectionID, uri)
+	return body, err
+}
+
+// uploadBody is a multipart request body streaming the file from a reader. It can be opened again if nothing has
+// been read from the reader, or if the reader is an io.Seeker.
+type uploadBody struct {
+	r           io.Reader
+	seeker      io.Seeker
+	start       int64
+	length      int64
+	read        int64
+	head, tail  []byte
+	contentType string
+}
+
+func newUploadBody(r io.Reader, filename, contentType string) (*uploadBody, error) {
+	b := &uploadBody{r: r, length: -1}
+
+	if seeker, ok := r.(io.Seeker); ok && canSeek(seeker) {
+		start, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, err
+		}
+		end, err := seeker.Seek(0, io.SeekEnd)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := seeker.Seek(start, io.SeekStart); err != nil {
+			return nil, err
+		}
+		b.seeker, b.start, b.length = seeker, start, end-start
+	} else if l, ok := r.(interface{ Len() int }); ok {
+		b.length = int64(l.Len())
+	}
+
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, escapeQuotes(filename)))
+	header.Set("Content-Type", contentType)
+	if _, err := w.CreatePart(header); err != nil {
+		return nil, err
+	}
+	b.head = append([]byte{}, buf.Bytes()...)
+
+	buf.Reset()
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	b.tail = append([]byte{}, buf.Bytes()...)
+	b.contentType = w.FormDataContentType()
+
+	return b, nil
+}
+
+// canSeek return true if the seeker can seek. An *os.File for a pipe or os.Stdin is an io.Seeker which cannot, so is
+// streamed as any other reader.
+func canSeek(seeker io.Seeker) bool {
+	_, err := seeker.Seek(0, io.SeekCurrent)
+	return err == nil
+}
+
+// size return the length of the request body, or -1 if it is not known
+func (b *uploadBody) size() int64 {
+	if b.length < 0 {
+		return -1
+	}
+	return int64(len(b.head)) + b.length + int64(len(b.tail))
+}
+
+// open return a new reader for the request body, rewinding the file reader if it has been read
+func (b *uploadBody) open() (io.ReadCloser, error) {
+	if b.read > 0 {
+		if b.seeker == nil {
+			return nil, errUploadNotRewindable
+		}
+		if _, err := b.seeker.Seek(b.start, io.SeekStart); err != nil {
+			return nil, err
+		}
+		b.read = 0
+	}
+
+	return io.NopCloser(io.MultiReader(bytes.NewReader(b.head), readCounter{b}, bytes.NewReader(b.tail))), nil
+}
+
+// readCounter read from the upload body reader, counting the bytes read
+type readCounter struct {
+	b *uploadBody
+}
+
+func (rc readCounter) Read(p []byte) (int, error) {
+	n, err := rc.b.r.Read(p)
+	rc.b.read += int64(n)
+	return n, err
+}
+
+// contentTypeFor return the MIME type for the extension of the URI, defaulting to application/octet-stream
+func contentTypeFor(uri string) string {
+	if t := mime.TypeByExtension(path.Ext(uri)); t != "" {
+		return t
+	}
+	return "application/octet-stream"
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
@@ -0,0 +1,196 @@
+package zebedee
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/dp-net/v2/request"
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee/mock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const csvContent = "year,value\n2020,1.5\n2021,2.5\n"
+
+// uploadedFile is the file read from a multipart upload request
+type uploadedFile struct {
+	filename    string
+	contentType string
+	content     string
+}
+
+// mockUploadResponses return a mock HTTP client which reads the uploaded file from each request before returning
+// the next response
+func mockUploadResponses(files *[]uploadedFile, statuses ...int) *mock.HttpClientMock {
+	return &mock.HttpClientMock{
+		DoFunc: func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			_, params, err := mime.ParseMediaType(req.Header.Get("content-type"))
+			So(err, ShouldBeNil)
+
+			part, err := multipart.NewReader(req.Body, params["boundary"]).NextPart()
+			So(err, ShouldBeNil)
+			b, err := io.ReadAll(part)
+			So(err, ShouldBeNil)
+
+			*files = append(*files, uploadedFile{filename: part.FileName(), contentType: part.Header.Get("Content-Type"), content: string(b)})
+
+			status := statuses[len(*files)-1]
+			recorder := httptest.NewRecorder()
+			recorder.Code = status
+			recorder.Body = bytes.NewBufferString("true")
+			res := recorder.Result()
+			res.Request = req
+			return res, nil
+		},
+	}
+}
+
+func Test_UploadFile(t *testing.T) {
+	session := newSession()
+
+	Convey("Given a file to upload from a reader with a known size", t, func() {
+		var files []uploadedFile
+		httpClient := mockUploadResponses(&files, http.StatusOK)
+		zebedeeClient := NewClient(host, httpClient)
+
+		Convey("When UploadFile is called", func() {
+			err := zebedeeClient.UploadFile(session, collectionId, "/economy/gdp/data.csv", strings.NewReader(csvContent), "")
+
+			Convey("Then the file is sent as a multipart upload", func() {
+				So(err, ShouldBeNil)
+				So(httpClient.DoCalls(), ShouldHaveLength, 1)
+
+				req := httpClient.DoCalls()[0].Req
+				So(req.Method, ShouldEqual, http.MethodPost)
+				So(req.URL.String(), ShouldEqual, host+"/content/collectionID?uri=/economy/gdp/data.csv&overwriteExisting=true&recursive=false&validateJson=false")
+				So(req.Header.Get(request.FlorenceHeaderKey), ShouldEqual, session.ID)
+				So(req.Header.Get("content-type"), ShouldStartWith, "multipart/form-data; boundary=")
+
+				So(files, ShouldResemble, []uploadedFile{{filename: "data.csv", contentType: "text/csv; charset=utf-8", content: csvContent}})
+			})
+
+			Convey("Then the content length is set", func() {
+				req := httpClient.DoCalls()[0].Req
+				So(req.ContentLength, ShouldBeGreaterThan, len(csvContent))
+			})
+		})
+	})
+
+	Convey("Given a file to upload from a reader with an unknown size", t, func() {
+		var files []uploadedFile
+		httpClient := mockUploadResponses(&files, http.StatusOK)
+		zebedeeClient := NewClient(host, httpClient)
+		r := io.MultiReader(strings.NewReader(csvContent))
+
+		Convey("When UploadFile is called with a content type", func() {
+			err := zebedeeClient.UploadFile(session, collectionId, "/economy/gdp/data", r, "text/plain")
+
+			Convey("Then the file is streamed with an unknown content length", func() {
+				So(err, ShouldBeNil)
+				So(httpClient.DoCalls()[0].Req.ContentLength, ShouldEqual, -1)
+				So(files, ShouldResemble, []uploadedFile{{filename: "data", contentType: "text/plain", content: csvContent}})
+			})
+		})
+	})
+
+	Convey("Given a file to upload from a pipe, which is an io.Seeker that cannot seek", t, func() {
+		var files []uploadedFile
+		httpClient := mockUploadResponses(&files, http.StatusServiceUnavailable, http.StatusOK)
+		zebedeeClient := NewClientWithOptions(host, WithHttpClient(httpClient), WithRetryPolicy(RetryPolicy{InitialBackoff: time.Millisecond}))
+
+		r, w, err := os.Pipe()
+		So(err, ShouldBeNil)
+		defer r.Close()
+		go func() {
+			defer w.Close()
+			io.WriteString(w, csvContent)
+		}()
+
+		Convey("When UploadFile is called and the first attempt fails", func() {
+			err := zebedeeClient.UploadFile(session, collectionId, "/a/data.csv", r, "text/csv")
+
+			Convey("Then the file is streamed with an unknown content length and is not sent again", func() {
+				So(err, ShouldNotBeNil)
+				So(httpClient.DoCalls(), ShouldHaveLength, 1)
+				So(httpClient.DoCalls()[0].Req.ContentLength, ShouldEqual, -1)
+				So(files, ShouldResemble, []uploadedFile{{filename: "data.csv", contentType: "text/csv", content: csvContent}})
+			})
+		})
+	})
+
+	Convey("Given a file to upload which must not already exist", t, func() {
+		var files []uploadedFile
+		httpClient := mockUploadResponses(&files, http.StatusServiceUnavailable, http.StatusOK)
+		zebedeeClient := NewClientWithOptions(host, WithHttpClient(httpClient), WithRetryPolicy(RetryPolicy{InitialBackoff: time.Millisecond}))
+		opts := ContentOptions{OverwriteExisting: false}
+
+		Convey("When UploadFileWithOptions is called and the first attempt fails", func() {
+			err := zebedeeClient.UploadFileWithOptions(session, collectionId, "/a/data.csv", bytes.NewReader([]byte(csvContent)), "text/csv", opts)
+
+			Convey("Then the file is uploaded without overwriting existing content and is not sent again", func() {
+				So(err, ShouldNotBeNil)
+				So(httpClient.DoCalls(), ShouldHaveLength, 1)
+				So(httpClient.DoCalls()[0].Req.URL.String(), ShouldEqual, host+"/content/collectionID?uri=/a/data.csv&overwriteExisting=false&recursive=false&validateJson=false")
+			})
+		})
+	})
+
+	Convey("Given a retry policy and an upload that fails the first time", t, func() {
+		var files []uploadedFile
+		httpClient := mockUploadResponses(&files, http.StatusServiceUnavailable, http.StatusOK)
+		zebedeeClient := NewClientWithOptions(host, WithHttpClient(httpClient), WithRetryPolicy(RetryPolicy{InitialBackoff: time.Millisecond}))
+
+		Convey("When a seekable file is uploaded", func() {
+			err := zebedeeClient.UploadFile(session, collectionId, "/a/data.csv", bytes.NewReader([]byte(csvContent)), "text/csv")
+
+			Convey("Then the whole file is sent again", func() {
+				So(err, ShouldBeNil)
+				So(files, ShouldHaveLength, 2)
+				So(files[1].content, ShouldEqual, csvContent)
+			})
+		})
+	})
+}
+
+func Test_DownloadFile(t *testing.T) {
+	session := newSession()
+
+	Convey("Given a file in a collection", t, func() {
+		httpClient := mockHttpResponse(http.StatusOK, csvContent)
+		zebedeeClient := NewClient(host, httpClient)
+
+		Convey("When DownloadFile is called", func() {
+			body, err := zebedeeClient.DownloadFile(session, collectionId, "/a/data.csv")
+			So(err, ShouldBeNil)
+			defer body.Close()
+
+			Convey("Then the file can be read from the returned reader", func() {
+				b, err := io.ReadAll(body)
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, csvContent)
+
+				req := httpClient.DoCalls()[0].Req
+				So(req.Method, ShouldEqual, http.MethodGet)
+				So(req.URL.String(), ShouldEqual, host+"/content/collectionID?uri=/a/data.csv")
+			})
+		})
+	})
+
+	Convey("Given the file does not exist", t, func() {
+		zebedeeClient := NewClient(host, mockHttpResponse(http.StatusNotFound, `{"message":"Content not found"}`))
+
+		Convey("Then DownloadFile returns a not found error", func() {
+			_, err := zebedeeClient.DownloadFile(session, collectionId, "/a/data.csv")
+			So(errors.Is(err, ErrNotFound), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,26 @@
+package zebedee_test
+
+import (
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee/zebedeetest"
+)
+
+// newFake return a fake Zebedee with an editor and a reviewer, a client for it and a session for each user. The caller
+// must close the fake.
+func newFake() (*zebedeetest.Server, zebedee.Client, zebedee.Session, zebedee.Session) {
+	fake := zebedeetest.NewServer()
+	fake.AddUser(zebedee.User{Name: "Editor", Email: "editor@ons.gov.uk"}, "password", zebedee.Permissions{Editor: true})
+	fake.AddUser(zebedee.User{Name: "Reviewer", Email: "reviewer@ons.gov.uk"}, "password", zebedee.Permissions{Editor: true})
+
+	editor, _ := fake.NewSession("editor@ons.gov.uk")
+	reviewer, _ := fake.NewSession("reviewer@ons.gov.uk")
+	return fake, zebedee.NewClient(fake.URL, fake.HttpClient()), editor, reviewer
+}
+
+// newPage return the JSON of a static page with the title provided
+func newPage(title string) interface{} {
+	return map[string]interface{}{
+		"type":        "static_page",
+		"description": map[string]interface{}{"title": title},
+	}
+}
@@ -24,17 +24,43 @@ type HttpClient interface {
 }
 
 // APIError represent an error returned from the Zebedee CMS API.
+// Use errors.Is with the sentinel errors, e.g. ErrNotFound, to classify the error.
 type APIError struct {
 	ActualStatus   int
 	ExpectedStatus int
 	Message        string
 	Body           string
+	// Method and URI of the request that failed.
+	Method string
+	URI    string
+	// ServerMessage is the message field parsed from a Zebedee JSON error body, if present.
+	ServerMessage string
 }
 
 func (err *APIError) Error() string {
 	return err.Message
 }
 
+// Is reports whether the error matches one of the sentinel errors, allowing errors.Is to classify API errors.
+func (err *APIError) Is(target error) bool {
+	switch target {
+	case ErrValidation:
+		return err.ActualStatus == http.StatusBadRequest
+	case ErrUnauthorized:
+		return err.ActualStatus == http.StatusUnauthorized
+	case ErrForbidden:
+		return err.ActualStatus == http.StatusForbidden
+	case ErrNotFound:
+		return err.ActualStatus == http.StatusNotFound
+	case ErrConflict:
+		return err.ActualStatus == http.StatusConflict
+	case ErrCollectionLocked:
+		return err.ActualStatus == http.StatusConflict && isLockedMessage(err.ServerMessage)
+	default:
+		return false
+	}
+}
+
 // NewHttpClient Construct a new HttpClient
 func NewHttpClient(timeout time.Duration) HttpClient {
 	return dphttp.ClientWithTimeout(nil, timeout)
@@ -44,29 +70,28 @@ func NewHttpClient(timeout time.Duration) HttpClient {
 func checkResponseStatus(resp *http.Response, expected int) error {
 	req := resp.Request
 	if resp.StatusCode != expected {
+		apiErr := &APIError{
+			ActualStatus:   resp.StatusCode,
+			ExpectedStatus: expected,
+			Method:         req.Method,
+			URI:            req.URL.RequestURI(),
+		}
+
 		body, err := io.ReadAll(resp.Body)
 		if err != nil {
-			return &APIError{
-				ActualStatus:   resp.StatusCode,
-				ExpectedStatus: expected,
-				Message:        fmt.Sprintf(readResponseBodyErrFmt, err.Error(), req.Method, req.URL.RequestURI(), expected, resp.StatusCode),
-			}
+			apiErr.Message = fmt.Sprintf(readResponseBodyErrFmt, err.Error(), req.Method, req.URL.RequestURI(), expected, resp.StatusCode)
+			return apiErr
 		}
 
 		if len(body) > 0 {
-			return &APIError{
-				ActualStatus:   resp.StatusCode,
-				ExpectedStatus: expected,
-				Body:           string(body),
-				Message:        fmt.Sprintf(incorrectStatusWithBodyErrFmt, req.Method, req.URL.RequestURI(), expected, resp.StatusCode),
-			}
+			apiErr.Body = string(body)
+			apiErr.ServerMessage = parseServerMessage(body)
+			apiErr.Message = fmt.Sprintf(incorrectStatusWithBodyErrFmt, req.Method, req.URL.RequestURI(), expected, resp.StatusCode)
+			return apiErr
 		}
 
-		return &APIError{
-			ActualStatus:   resp.StatusCode,
-			ExpectedStatus: expected,
-			Message:        fmt.Sprintf(incorrectStatusErrFmt, req.Method, req.URL.RequestURI(), expected, resp.StatusCode),
-		}
+		apiErr.Message = fmt.Sprintf(incorrectStatusErrFmt, req.Method, req.URL.RequestURI(), expected, resp.StatusCode)
+		return apiErr
 	}
 	return nil
 }
@@ -1,13 +1,19 @@
 package zebedee
 
 import (
+	"context"
 	"net/http"
 )
 
 // ListUserKeyring returns a list of collection ID's for the keys the user has access to.
 func (z *zebedeeClient) ListUserKeyring(s Session) ([]string, error) {
+	return z.ListUserKeyringContext(context.Background(), s)
+}
+
+// ListUserKeyringContext returns a list of collection ID's for the keys the user has access to, bound to the provided context.
+func (z *zebedeeClient) ListUserKeyringContext(ctx context.Context, s Session) ([]string, error) {
 	uri := "/ListKeyring"
-	req, err := z.newAuthenticatedRequest(uri, s.ID, http.MethodGet, nil)
+	req, err := z.newAuthenticatedRequest(ctx, uri, s, http.MethodGet, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,223 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package clientmock
+
+import (
+	"context"
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	"sync"
+)
+
+var (
+	lockAuthAPIMockOpenSession           sync.RWMutex
+	lockAuthAPIMockOpenSessionContext    sync.RWMutex
+	lockAuthAPIMockOpenSessionJWT        sync.RWMutex
+	lockAuthAPIMockOpenSessionJWTContext sync.RWMutex
+)
+
+// Ensure, that AuthAPIMock does implement AuthAPI.
+// If this is not the case, regenerate this file with moq.
+var _ zebedee.AuthAPI = &AuthAPIMock{}
+
+// AuthAPIMock is a mock implementation of AuthAPI.
+//
+//	    func TestSomethingThatUsesAuthAPI(t *testing.T) {
+//
+//	        // make and configure a mocked AuthAPI
+//	        mockedAuthAPI := &AuthAPIMock{
+//	            OpenSessionFunc: func(c zebedee.Credentials) (zebedee.Session, error) {
+//		               panic("mock out the OpenSession method")
+//	            },
+//	            OpenSessionContextFunc: func(ctx context.Context, c zebedee.Credentials) (zebedee.Session, error) {
+//		               panic("mock out the OpenSessionContext method")
+//	            },
+//	            OpenSessionJWTFunc: func(authToken string) (zebedee.Session, error) {
+//		               panic("mock out the OpenSessionJWT method")
+//	            },
+//	            OpenSessionJWTContextFunc: func(ctx context.Context, authToken string) (zebedee.Session, error) {
+//		               panic("mock out the OpenSessionJWTContext method")
+//	            },
+//	        }
+//
+//	        // use mockedAuthAPI in code that requires AuthAPI
+//	        // and then make assertions.
+//
+//	    }
+type AuthAPIMock struct {
+	// OpenSessionFunc mocks the OpenSession method.
+	OpenSessionFunc func(c zebedee.Credentials) (zebedee.Session, error)
+
+	// OpenSessionContextFunc mocks the OpenSessionContext method.
+	OpenSessionContextFunc func(ctx context.Context, c zebedee.Credentials) (zebedee.Session, error)
+
+	// OpenSessionJWTFunc mocks the OpenSessionJWT method.
+	OpenSessionJWTFunc func(authToken string) (zebedee.Session, error)
+
+	// OpenSessionJWTContextFunc mocks the OpenSessionJWTContext method.
+	OpenSessionJWTContextFunc func(ctx context.Context, authToken string) (zebedee.Session, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// OpenSession holds details about calls to the OpenSession method.
+		OpenSession []struct {
+			// C is the c argument value.
+			C zebedee.Credentials
+		}
+		// OpenSessionContext holds details about calls to the OpenSessionContext method.
+		OpenSessionContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// C is the c argument value.
+			C zebedee.Credentials
+		}
+		// OpenSessionJWT holds details about calls to the OpenSessionJWT method.
+		OpenSessionJWT []struct {
+			// AuthToken is the authToken argument value.
+			AuthToken string
+		}
+		// OpenSessionJWTContext holds details about calls to the OpenSessionJWTContext method.
+		OpenSessionJWTContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AuthToken is the authToken argument value.
+			AuthToken string
+		}
+	}
+}
+
+// OpenSession calls OpenSessionFunc.
+func (mock *AuthAPIMock) OpenSession(c zebedee.Credentials) (zebedee.Session, error) {
+	if mock.OpenSessionFunc == nil {
+		panic("AuthAPIMock.OpenSessionFunc: method is nil but AuthAPI.OpenSession was just called")
+	}
+	callInfo := struct {
+		C zebedee.Credentials
+	}{
+		C: c,
+	}
+	lockAuthAPIMockOpenSession.Lock()
+	mock.calls.OpenSession = append(mock.calls.OpenSession, callInfo)
+	lockAuthAPIMockOpenSession.Unlock()
+	return mock.OpenSessionFunc(c)
+}
+
+// OpenSessionCalls gets all the calls that were made to OpenSession.
+// Check the length with:
+//
+//	len(mockedAuthAPI.OpenSessionCalls())
+func (mock *AuthAPIMock) OpenSessionCalls() []struct {
+	C zebedee.Credentials
+} {
+	var calls []struct {
+		C zebedee.Credentials
+	}
+	lockAuthAPIMockOpenSession.RLock()
+	calls = mock.calls.OpenSession
+	lockAuthAPIMockOpenSession.RUnlock()
+	return calls
+}
+
+// OpenSessionContext calls OpenSessionContextFunc.
+func (mock *AuthAPIMock) OpenSessionContext(ctx context.Context, c zebedee.Credentials) (zebedee.Session, error) {
+	if mock.OpenSessionContextFunc == nil {
+		panic("AuthAPIMock.OpenSessionContextFunc: method is nil but AuthAPI.OpenSessionContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		C   zebedee.Credentials
+	}{
+		Ctx: ctx,
+		C:   c,
+	}
+	lockAuthAPIMockOpenSessionContext.Lock()
+	mock.calls.OpenSessionContext = append(mock.calls.OpenSessionContext, callInfo)
+	lockAuthAPIMockOpenSessionContext.Unlock()
+	return mock.OpenSessionContextFunc(ctx, c)
+}
+
+// OpenSessionContextCalls gets all the calls that were made to OpenSessionContext.
+// Check the length with:
+//
+//	len(mockedAuthAPI.OpenSessionContextCalls())
+func (mock *AuthAPIMock) OpenSessionContextCalls() []struct {
+	Ctx context.Context
+	C   zebedee.Credentials
+} {
+	var calls []struct {
+		Ctx context.Context
+		C   zebedee.Credentials
+	}
+	lockAuthAPIMockOpenSessionContext.RLock()
+	calls = mock.calls.OpenSessionContext
+	lockAuthAPIMockOpenSessionContext.RUnlock()
+	return calls
+}
+
+// OpenSessionJWT calls OpenSessionJWTFunc.
+func (mock *AuthAPIMock) OpenSessionJWT(authToken string) (zebedee.Session, error) {
+	if mock.OpenSessionJWTFunc == nil {
+		panic("AuthAPIMock.OpenSessionJWTFunc: method is nil but AuthAPI.OpenSessionJWT was just called")
+	}
+	callInfo := struct {
+		AuthToken string
+	}{
+		AuthToken: authToken,
+	}
+	lockAuthAPIMockOpenSessionJWT.Lock()
+	mock.calls.OpenSessionJWT = append(mock.calls.OpenSessionJWT, callInfo)
+	lockAuthAPIMockOpenSessionJWT.Unlock()
+	return mock.OpenSessionJWTFunc(authToken)
+}
+
+// OpenSessionJWTCalls gets all the calls that were made to OpenSessionJWT.
+// Check the length with:
+//
+//	len(mockedAuthAPI.OpenSessionJWTCalls())
+func (mock *AuthAPIMock) OpenSessionJWTCalls() []struct {
+	AuthToken string
+} {
+	var calls []struct {
+		AuthToken string
+	}
+	lockAuthAPIMockOpenSessionJWT.RLock()
+	calls = mock.calls.OpenSessionJWT
+	lockAuthAPIMockOpenSessionJWT.RUnlock()
+	return calls
+}
+
+// OpenSessionJWTContext calls OpenSessionJWTContextFunc.
+func (mock *AuthAPIMock) OpenSessionJWTContext(ctx context.Context, authToken string) (zebedee.Session, error) {
+	if mock.OpenSessionJWTContextFunc == nil {
+		panic("AuthAPIMock.OpenSessionJWTContextFunc: method is nil but AuthAPI.OpenSessionJWTContext was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		AuthToken string
+	}{
+		Ctx:       ctx,
+		AuthToken: authToken,
+	}
+	lockAuthAPIMockOpenSessionJWTContext.Lock()
+	mock.calls.OpenSessionJWTContext = append(mock.calls.OpenSessionJWTContext, callInfo)
+	lockAuthAPIMockOpenSessionJWTContext.Unlock()
+	return mock.OpenSessionJWTContextFunc(ctx, authToken)
+}
+
+// OpenSessionJWTContextCalls gets all the calls that were made to OpenSessionJWTContext.
+// Check the length with:
+//
+//	len(mockedAuthAPI.OpenSessionJWTContextCalls())
+func (mock *AuthAPIMock) OpenSessionJWTContextCalls() []struct {
+	Ctx       context.Context
+	AuthToken string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		AuthToken string
+	}
+	lockAuthAPIMockOpenSessionJWTContext.RLock()
+	calls = mock.calls.OpenSessionJWTContext
+	lockAuthAPIMockOpenSessionJWTContext.RUnlock()
+	return calls
+}
@@ -0,0 +1,4622 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package clientmock
+
+import (
+	"context"
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	"io"
+	"sync"
+)
+
+var (
+	lockClientMockAddTeamMember                               sync.RWMutex
+	lockClientMockAddTeamMemberContext                        sync.RWMutex
+	lockClientMockApproveCollection                           sync.RWMutex
+	lockClientMockApproveCollectionContext                    sync.RWMutex
+	lockClientMockCompleteCollectionContent                   sync.RWMutex
+	lockClientMockCompleteCollectionContentContext            sync.RWMutex
+	lockClientMockCompleteCollectionContentWithOptions        sync.RWMutex
+	lockClientMockCompleteCollectionContentWithOptionsContext sync.RWMutex
+	lockClientMockCreateCollection                            sync.RWMutex
+	lockClientMockCreateCollectionContext                     sync.RWMutex
+	lockClientMockCreateTeam                                  sync.RWMutex
+	lockClientMockCreateTeamContext                           sync.RWMutex
+	lockClientMockCreateUser                                  sync.RWMutex
+	lockClientMockCreateUserContext                           sync.RWMutex
+	lockClientMockDeleteCollection                            sync.RWMutex
+	lockClientMockDeleteCollectionContent                     sync.RWMutex
+	lockClientMockDeleteCollectionContentContext              sync.RWMutex
+	lockClientMockDeleteCollectionContext                     sync.RWMutex
+	lockClientMockDeleteTeam                                  sync.RWMutex
+	lockClientMockDeleteTeamContext                           sync.RWMutex
+	lockClientMockDeleteUser                                  sync.RWMutex
+	lockClientMockDeleteUserContext                           sync.RWMutex
+	lockClientMockDownloadFile                                sync.RWMutex
+	lockClientMockDownloadFileContext                         sync.RWMutex
+	lockClientMockGetCollectionByID                           sync.RWMutex
+	lockClientMockGetCollectionByIDContext                    sync.RWMutex
+	lockClientMockGetCollectionDetails                        sync.RWMutex
+	lockClientMockGetCollectionDetailsContext                 sync.RWMutex
+	lockClientMockGetCollections                              sync.RWMutex
+	lockClientMockGetCollectionsContext                       sync.RWMutex
+	lockClientMockGetContent                                  sync.RWMutex
+	lockClientMockGetContentContext                           sync.RWMutex
+	lockClientMockGetContentJSON                              sync.RWMutex
+	lockClientMockGetContentJSONContext                       sync.RWMutex
+	lockClientMockGetContentStream                            sync.RWMutex
+	lockClientMockGetPermissions                              sync.RWMutex
+	lockClientMockGetPermissionsContext                       sync.RWMutex
+	lockClientMockGetPublishedContent                         sync.RWMutex
+	lockClientMockGetPublishedContentContext                  sync.RWMutex
+	lockClientMockGetPublishedContentStream                   sync.RWMutex
+	lockClientMockGetPublishedData                            sync.RWMutex
+	lockClientMockGetPublishedDataContext                     sync.RWMutex
+	lockClientMockGetTeam                                     sync.RWMutex
+	lockClientMockGetTeamContext                              sync.RWMutex
+	lockClientMockGetUser                                     sync.RWMutex
+	lockClientMockGetUserContext                              sync.RWMutex
+	lockClientMockGetUsers                                    sync.RWMutex
+	lockClientMockGetUsersContext                             sync.RWMutex
+	lockClientMockListPublishedChildren                       sync.RWMutex
+	lockClientMockListPublishedChildrenContext                sync.RWMutex
+	lockClientMockListTeams                                   sync.RWMutex
+	lockClientMockListTeamsContext                            sync.RWMutex
+	lockClientMockListUserKeyring                             sync.RWMutex
+	lockClientMockListUserKeyringContext                      sync.RWMutex
+	lockClientMockOpenSession                                 sync.RWMutex
+	lockClientMockOpenSessionContext                          sync.RWMutex
+	lockClientMockOpenSessionJWT                              sync.RWMutex
+	lockClientMockOpenSessionJWTContext                       sync.RWMutex
+	lockClientMockPublishCollection                           sync.RWMutex
+	lockClientMockPublishCollectionContext                    sync.RWMutex
+	lockClientMockRemoveTeamMember                            sync.RWMutex
+	lockClientMockRemoveTeamMemberContext                     sync.RWMutex
+	lockClientMockReviewCollectionContent                     sync.RWMutex
+	lockClientMockReviewCollectionContentContext              sync.RWMutex
+	lockClientMockReviewCollectionContentWithOptions          sync.RWMutex
+	lockClientMockReviewCollectionContentWithOptionsContext   sync.RWMutex
+	lockClientMockSetPassword                                 sync.RWMutex
+	lockClientMockSetPasswordContext                          sync.RWMutex
+	lockClientMockSetPermissions                              sync.RWMutex
+	lockClientMockSetPermissionsContext                       sync.RWMutex
+	lockClientMockUnlockCollection                            sync.RWMutex
+	lockClientMockUnlockCollectionContext                     sync.RWMutex
+	lockClientMockUpdateCollection                            sync.RWMutex
+	lockClientMockUpdateCollectionContent                     sync.RWMutex
+	lockClientMockUpdateCollectionContentContext              sync.RWMutex
+	lockClientMockUpdateCollectionContentWithOptions          sync.RWMutex
+	lockClientMockUpdateCollectionContentWithOptionsContext   sync.RWMutex
+	lockClientMockUpdateCollectionContext                     sync.RWMutex
+	lockClientMockUploadFile                                  sync.RWMutex
+	lockClientMockUploadFileContext                           sync.RWMutex
+	lockClientMockUploadFileWithOptions                       sync.RWMutex
+	lockClientMockUploadFileWithOptionsContext                sync.RWMutex
+)
+
+// Ensure, that ClientMock does implement Client.
+// If this is not the case, regenerate this file with moq.
+var _ zebedee.Client = &ClientMock{}
+
+// ClientMock is a mock implementation of Client.
+//
+//	    func TestSomethingThatUsesClient(t *testing.T) {
+//
+//	        // make and configure a mocked Client
+//	        mockedClient := &ClientMock{
+//	            AddTeamMemberFunc: func(s zebedee.Session, teamName string, email string) error {
+//		               panic("mock out the AddTeamMember method")
+//	            },
+//	            AddTeamMemberContextFunc: func(ctx context.Context, s zebedee.Session, teamName string, email string) error {
+//		               panic("mock out the AddTeamMemberContext method")
+//	            },
+//	            ApproveCollectionFunc: func(s zebedee.Session, id string) error {
+//		               panic("mock out the ApproveCollection method")
+//	            },
+//	            ApproveCollectionContextFunc: func(ctx context.Context, s zebedee.Session, id string) error {
+//		               panic("mock out the ApproveCollectionContext method")
+//	            },
+//	            CompleteCollectionContentFunc: func(s zebedee.Session, id string, contentUri string) error {
+//		               panic("mock out the CompleteCollectionContent method")
+//	            },
+//	            CompleteCollectionContentContextFunc: func(ctx context.Context, s zebedee.Session, id string, contentUri string) error {
+//		               panic("mock out the CompleteCollectionContentContext method")
+//	            },
+//	            CompleteCollectionContentWithOptionsFunc: func(s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error {
+//		               panic("mock out the CompleteCollectionContentWithOptions method")
+//	            },
+//	            CompleteCollectionContentWithOptionsContextFunc: func(ctx context.Context, s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error {
+//		               panic("mock out the CompleteCollectionContentWithOptionsContext method")
+//	            },
+//	            CreateCollectionFunc: func(s zebedee.Session, desc zebedee.CollectionDescription) (zebedee.CollectionDescription, error) {
+//		               panic("mock out the CreateCollection method")
+//	            },
+//	            CreateCollectionContextFunc: func(ctx context.Context, s zebedee.Session, desc zebedee.CollectionDescription) (zebedee.CollectionDescription, error) {
+//		               panic("mock out the CreateCollectionContext method")
+//	            },
+//	            CreateTeamFunc: func(s zebedee.Session, teamName string) (bool, error) {
+//		               panic("mock out the CreateTeam method")
+//	            },
+//	            CreateTeamContextFunc: func(ctx context.Context, s zebedee.Session, teamName string) (bool, error) {
+//		               panic("mock out the CreateTeamContext method")
+//	            },
+//	            CreateUserFunc: func(s zebedee.Session, u zebedee.User) (zebedee.User, error) {
+//		               panic("mock out the CreateUser method")
+//	            },
+//	            CreateUserContextFunc: func(ctx context.Context, s zebedee.Session, u zebedee.User) (zebedee.User, error) {
+//		               panic("mock out the CreateUserContext method")
+//	            },
+//	            DeleteCollectionFunc: func(s zebedee.Session, id string) error {
+//		               panic("mock out the DeleteCollection method")
+//	            },
+//	            DeleteCollectionContentFunc: func(s zebedee.Session, id string, contentUri string) error {
+//		               panic("mock out the DeleteCollectionContent method")
+//	            },
+//	            DeleteCollectionContentContextFunc: func(ctx context.Context, s zebedee.Session, id string, contentUri string) error {
+//		               panic("mock out the DeleteCollectionContentContext method")
+//	            },
+//	            DeleteCollectionContextFunc: func(ctx context.Context, s zebedee.Session, id string) error {
+//		               panic("mock out the DeleteCollectionContext method")
+//	            },
+//	            DeleteTeamFunc: func(s zebedee.Session, teamName string) error {
+//		               panic("mock out the DeleteTeam method")
+//	            },
+//	            DeleteTeamContextFunc: func(ctx context.Context, s zebedee.Session, teamName string) error {
+//		               panic("mock out the DeleteTeamContext method")
+//	            },
+//	            DeleteUserFunc: func(s zebedee.Session, email string) error {
+//		               panic("mock out the DeleteUser method")
+//	            },
+//	            DeleteUserContextFunc: func(ctx context.Context, s zebedee.Session, email string) error {
+//		               panic("mock out the DeleteUserContext method")
+//	            },
+//	            DownloadFileFunc: func(s zebedee.Session, collectionID string, uri string) (io.ReadCloser, error) {
+//		               panic("mock out the DownloadFile method")
+//	            },
+//	            DownloadFileContextFunc: func(ctx context.Context, s zebedee.Session, collectionID string, uri string) (io.ReadCloser, error) {
+//		               panic("mock out the DownloadFileContext method")
+//	            },
+//	            GetCollectionByIDFunc: func(s zebedee.Session, id string) (zebedee.CollectionDescription, error) {
+//		               panic("mock out the GetCollectionByID method")
+//	            },
+//	            GetCollectionByIDContextFunc: func(ctx context.Context, s zebedee.Session, id string) (zebedee.CollectionDescription, error) {
+//		               panic("mock out the GetCollectionByIDContext method")
+//	            },
+//	            GetCollectionDetailsFunc: func(s zebedee.Session, id string) (zebedee.CollectionDetails, error) {
+//		               panic("mock out the GetCollectionDetails method")
+//	            },
+//	            GetCollectionDetailsContextFunc: func(ctx context.Context, s zebedee.Session, id string) (zebedee.CollectionDetails, error) {
+//		               panic("mock out the GetCollectionDetailsContext method")
+//	            },
+//	            GetCollectionsFunc: func(s zebedee.Session) ([]zebedee.CollectionDescription, error) {
+//		               panic("mock out the GetCollections method")
+//	            },
+//	            GetCollectionsContextFunc: func(ctx context.Context, s zebedee.Session) ([]zebedee.CollectionDescription, error) {
+//		               panic("mock out the GetCollectionsContext method")
+//	            },
+//	            GetContentFunc: func(s zebedee.Session, collectionName string, uri string) ([]byte, error) {
+//		               panic("mock out the GetContent method")
+//	            },
+//	            GetContentContextFunc: func(ctx context.Context, s zebedee.Session, collectionName string, uri string) ([]byte, error) {
+//		               panic("mock out the GetContentContext method")
+//	            },
+//	            GetContentJSONFunc: func(s zebedee.Session, collectionID string, uri string, v any) error {
+//		               panic("mock out the GetContentJSON method")
+//	            },
+//	            GetContentJSONContextFunc: func(ctx context.Context, s zebedee.Session, collectionID string, uri string, v any) error {
+//		               panic("mock out the GetContentJSONContext method")
+//	            },
+//	            GetContentStreamFunc: func(ctx context.Context, s zebedee.Session, collectionID string, uri string) (io.ReadCloser, zebedee.ContentInfo, error) {
+//		               panic("mock out the GetContentStream method")
+//	            },
+//	            GetPermissionsFunc: func(s zebedee.Session, email string) (zebedee.Permissions, error) {
+//		               panic("mock out the GetPermissions method")
+//	            },
+//	            GetPermissionsContextFunc: func(ctx context.Context, s zebedee.Session, email string) (zebedee.Permissions, error) {
+//		               panic("mock out the GetPermissionsContext method")
+//	            },
+//	            GetPublishedContentFunc: func(s zebedee.Session, uri string) ([]byte, error) {
+//		               panic("mock out the GetPublishedContent method")
+//	            },
+//	            GetPublishedContentContextFunc: func(ctx context.Context, s zebedee.Session, uri string) ([]byte, error) {
+//		               panic("mock out the GetPublishedContentContext method")
+//	            },
+//	            GetPublishedContentStreamFunc: func(ctx context.Context, s zebedee.Session, uri string) (io.ReadCloser, zebedee.ContentInfo, error) {
+//		               panic("mock out the GetPublishedContentStream method")
+//	            },
+//	            GetPublishedDataFunc: func(s zebedee.Session, uri string) ([]byte, error) {
+//		               panic("mock out the GetPublishedData method")
+//	            },
+//	            GetPublishedDataContextFunc: func(ctx context.Context, s zebedee.Session, uri string) ([]byte, error) {
+//		               panic("mock out the GetPublishedDataContext method")
+//	            },
+//	            GetTeamFunc: func(s zebedee.Session, teamName string) (zebedee.Team, error) {
+//		               panic("mock out the GetTeam method")
+//	            },
+//	            GetTeamContextFunc: func(ctx context.Context, s zebedee.Session, teamName string) (zebedee.Team, error) {
+//		               panic("mock out the GetTeamContext method")
+//	            },
+//	            GetUserFunc: func(s zebedee.Session, email string) (zebedee.User, error) {
+//		               panic("mock out the GetUser method")
+//	            },
+//	            GetUserContextFunc: func(ctx context.Context, s zebedee.Session, email string) (zebedee.User, error) {
+//		               panic("mock out the GetUserContext method")
+//	            },
+//	            GetUsersFunc: func(s zebedee.Session) ([]zebedee.User, error) {
+//		               panic("mock out the GetUsers method")
+//	            },
+//	            GetUsersContextFunc: func(ctx context.Context, s zebedee.Session) ([]zebedee.User, error) {
+//		               panic("mock out the GetUsersContext method")
+//	            },
+//	            ListPublishedChildrenFunc: func(s zebedee.Session, uri string) ([]zebedee.ContentNode, error) {
+//		               panic("mock out the ListPublishedChildren method")
+//	            },
+//	            ListPublishedChildrenContextFunc: func(ctx context.Context, s zebedee.Session, uri string) ([]zebedee.ContentNode, error) {
+//		               panic("mock out the ListPublishedChildrenContext method")
+//	            },
+//	            ListTeamsFunc: func(s zebedee.Session) (zebedee.TeamsList, error) {
+//		               panic("mock out the ListTeams method")
+//	            },
+//	            ListTeamsContextFunc: func(ctx context.Context, s zebedee.Session) (zebedee.TeamsList, error) {
+//		               panic("mock out the ListTeamsContext method")
+//	            },
+//	            ListUserKeyringFunc: func(s zebedee.Session) ([]string, error) {
+//		               panic("mock out the ListUserKeyring method")
+//	            },
+//	            ListUserKeyringContextFunc: func(ctx context.Context, s zebedee.Session) ([]string, error) {
+//		               panic("mock out the ListUserKeyringContext method")
+//	            },
+//	            OpenSessionFunc: func(c zebedee.Credentials) (zebedee.Session, error) {
+//		               panic("mock out the OpenSession method")
+//	            },
+//	            OpenSessionContextFunc: func(ctx context.Context, c zebedee.Credentials) (zebedee.Session, error) {
+//		               panic("mock out the OpenSessionContext method")
+//	            },
+//	            OpenSessionJWTFunc: func(authToken string) (zebedee.Session, error) {
+//		               panic("mock out the OpenSessionJWT method")
+//	            },
+//	            OpenSessionJWTContextFunc: func(ctx context.Context, authToken string) (zebedee.Session, error) {
+//		               panic("mock out the OpenSessionJWTContext method")
+//	            },
+//	            PublishCollectionFunc: func(s zebedee.Session, id string) error {
+//		               panic("mock out the PublishCollection method")
+//	            },
+//	            PublishCollectionContextFunc: func(ctx context.Context, s zebedee.Session, id string) error {
+//		               panic("mock out the PublishCollectionContext method")
+//	            },
+//	            RemoveTeamMemberFunc: func(s zebedee.Session, teamName string, email string) error {
+//		               panic("mock out the RemoveTeamMember method")
+//	            },
+//	            RemoveTeamMemberContextFunc: func(ctx context.Context, s zebedee.Session, teamName string, email string) error {
+//		               panic("mock out the RemoveTeamMemberContext method")
+//	            },
+//	            ReviewCollectionContentFunc: func(s zebedee.Session, id string, contentUri string) error {
+//		               panic("mock out the ReviewCollectionContent method")
+//	            },
+//	            ReviewCollectionContentContextFunc: func(ctx context.Context, s zebedee.Session, id string, contentUri string) error {
+//		               panic("mock out the ReviewCollectionContentContext method")
+//	            },
+//	            ReviewCollectionContentWithOptionsFunc: func(s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error {
+//		               panic("mock out the ReviewCollectionContentWithOptions method")
+//	            },
+//	            ReviewCollectionContentWithOptionsContextFunc: func(ctx context.Context, s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error {
+//		               panic("mock out the ReviewCollectionContentWithOptionsContext method")
+//	            },
+//	            SetPasswordFunc: func(s zebedee.Session, c zebedee.Credentials) error {
+//		               panic("mock out the SetPassword method")
+//	            },
+//	            SetPasswordContextFunc: func(ctx context.Context, s zebedee.Session, c zebedee.Credentials) error {
+//		               panic("mock out the SetPasswordContext method")
+//	            },
+//	            SetPermissionsFunc: func(s zebedee.Session, p zebedee.Permissions) error {
+//		               panic("mock out the SetPermissions method")
+//	            },
+//	            SetPermissionsContextFunc: func(ctx context.Context, s zebedee.Session, p zebedee.Permissions) error {
+//		               panic("mock out the SetPermissionsContext method")
+//	            },
+//	            UnlockCollectionFunc: func(s zebedee.Session, id string) error {
+//		               panic("mock out the UnlockCollection method")
+//	            },
+//	            UnlockCollectionContextFunc: func(ctx context.Context, s zebedee.Session, id string) error {
+//		               panic("mock out the UnlockCollectionContext method")
+//	            },
+//	            UpdateCollectionFunc: func(s zebedee.Session, desc zebedee.CollectionDescription) error {
+//		               panic("mock out the UpdateCollection method")
+//	            },
+//	            UpdateCollectionContentFunc: func(s zebedee.Session, id string, contentUri string, content interface{}) error {
+//		               panic("mock out the UpdateCollectionContent method")
+//	            },
+//	            UpdateCollectionContentContextFunc: func(ctx context.Context, s zebedee.Session, id string, contentUri string, content interface{}) error {
+//		               panic("mock out the UpdateCollectionContentContext method")
+//	            },
+//	            UpdateCollectionContentWithOptionsFunc: func(s zebedee.Session, id string, contentUri string, content interface{}, opts zebedee.ContentOptions) error {
+//		               panic("mock out the UpdateCollectionContentWithOptions method")
+//	            },
+//	            UpdateCollectionContentWithOptionsContextFunc: func(ctx context.Context, s zebedee.Session, id string, contentUri string, content interface{}, opts zebedee.ContentOptions) error {
+//		               panic("mock out the UpdateCollectionContentWithOptionsContext method")
+//	            },
+//	            UpdateCollectionContextFunc: func(ctx context.Context, s zebedee.Session, desc zebedee.CollectionDescription) error {
+//		               panic("mock out the UpdateCollectionContext method")
+//	            },
+//	            UploadFileFunc: func(s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string) error {
+//		               panic("mock out the UploadFile method")
+//	            },
+//	            UploadFileContextFunc: func(ctx context.Context, s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string) error {
+//		               panic("mock out the UploadFileContext method")
+//	            },
+//	            UploadFileWithOptionsFunc: func(s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string, opts zebedee.ContentOptions) error {
+//		               panic("mock out the UploadFileWithOptions method")
+//	            },
+//	            UploadFileWithOptionsContextFunc: func(ctx context.Context, s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string, opts zebedee.ContentOptions) error {
+//		               panic("mock out the UploadFileWithOptionsContext method")
+//	            },
+//	        }
+//
+//	        // use mockedClient in code that requires Client
+//	        // and then make assertions.
+//
+//	    }
+type ClientMock struct {
+	// AddTeamMemberFunc mocks the AddTeamMember method.
+	AddTeamMemberFunc func(s zebedee.Session, teamName string, email string) error
+
+	// AddTeamMemberContextFunc mocks the AddTeamMemberContext method.
+	AddTeamMemberContextFunc func(ctx context.Context, s zebedee.Session, teamName string, email string) error
+
+	// ApproveCollectionFunc mocks the ApproveCollection method.
+	ApproveCollectionFunc func(s zebedee.Session, id string) error
+
+	// ApproveCollectionContextFunc mocks the ApproveCollectionContext method.
+	ApproveCollectionContextFunc func(ctx context.Context, s zebedee.Session, id string) error
+
+	// CompleteCollectionContentFunc mocks the CompleteCollectionContent method.
+	CompleteCollectionContentFunc func(s zebedee.Session, id string, contentUri string) error
+
+	// CompleteCollectionContentContextFunc mocks the CompleteCollectionContentContext method.
+	CompleteCollectionContentContextFunc func(ctx context.Context, s zebedee.Session, id string, contentUri string) error
+
+	// CompleteCollectionContentWithOptionsFunc mocks the CompleteCollectionContentWithOptions method.
+	CompleteCollectionContentWithOptionsFunc func(s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error
+
+	// CompleteCollectionContentWithOptionsContextFunc mocks the CompleteCollectionContentWithOptionsContext method.
+	CompleteCollectionContentWithOptionsContextFunc func(ctx context.Context, s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error
+
+	// CreateCollectionFunc mocks the CreateCollection method.
+	CreateCollectionFunc func(s zebedee.Session, desc zebedee.CollectionDescription) (zebedee.CollectionDescription, error)
+
+	// CreateCollectionContextFunc mocks the CreateCollectionContext method.
+	CreateCollectionContextFunc func(ctx context.Context, s zebedee.Session, desc zebedee.CollectionDescription) (zebedee.CollectionDescription, error)
+
+	// CreateTeamFunc mocks the CreateTeam method.
+	CreateTeamFunc func(s zebedee.Session, teamName string) (bool, error)
+
+	// CreateTeamContextFunc mocks the CreateTeamContext method.
+	CreateTeamContextFunc func(ctx context.Context, s zebedee.Session, teamName string) (bool, error)
+
+	// CreateUserFunc mocks the CreateUser method.
+	CreateUserFunc func(s zebedee.Session, u zebedee.User) (zebedee.User, error)
+
+	// CreateUserContextFunc mocks the CreateUserContext method.
+	CreateUserContextFunc func(ctx context.Context, s zebedee.Session, u zebedee.User) (zebedee.User, error)
+
+	// DeleteCollectionFunc mocks the DeleteCollection method.
+	DeleteCollectionFunc func(s zebedee.Session, id string) error
+
+	// DeleteCollectionContentFunc mocks the DeleteCollectionContent method.
+	DeleteCollectionContentFunc func(s zebedee.Session, id string, contentUri string) error
+
+	// DeleteCollectionContentContextFunc mocks the DeleteCollectionContentContext method.
+	DeleteCollectionContentContextFunc func(ctx context.Context, s zebedee.Session, id string, contentUri string) error
+
+	// DeleteCollectionContextFunc mocks the DeleteCollectionContext method.
+	DeleteCollectionContextFunc func(ctx context.Context, s zebedee.Session, id string) error
+
+	// DeleteTeamFunc mocks the DeleteTeam method.
+	DeleteTeamFunc func(s zebedee.Session, teamName string) error
+
+	// DeleteTeamContextFunc mocks the DeleteTeamContext method.
+	DeleteTeamContextFunc func(ctx context.Context, s zebedee.Session, teamName string) error
+
+	// DeleteUserFunc mocks the DeleteUser method.
+	DeleteUserFunc func(s zebedee.Session, email string) error
+
+	// DeleteUserContextFunc mocks the DeleteUserContext method.
+	DeleteUserContextFunc func(ctx context.Context, s zebedee.Session, email string) error
+
+	// DownloadFileFunc mocks the DownloadFile method.
+	DownloadFileFunc func(s zebedee.Session, collectionID string, uri string) (io.ReadCloser, error)
+
+	// DownloadFileContextFunc mocks the DownloadFileContext method.
+	DownloadFileContextFunc func(ctx context.Context, s zebedee.Session, collectionID string, uri string) (io.ReadCloser, error)
+
+	// GetCollectionByIDFunc mocks the GetCollectionByID method.
+	GetCollectionByIDFunc func(s zebedee.Session, id string) (zebedee.CollectionDescription, error)
+
+	// GetCollectionByIDContextFunc mocks the GetCollectionByIDContext method.
+	GetCollectionByIDContextFunc func(ctx context.Context, s zebedee.Session, id string) (zebedee.CollectionDescription, error)
+
+	// GetCollectionDetailsFunc mocks the GetCollectionDetails method.
+	GetCollectionDetailsFunc func(s zebedee.Session, id string) (zebedee.CollectionDetails, error)
+
+	// GetCollectionDetailsContextFunc mocks the GetCollectionDetailsContext method.
+	GetCollectionDetailsContextFunc func(ctx context.Context, s zebedee.Session, id string) (zebedee.CollectionDetails, error)
+
+	// GetCollectionsFunc mocks the GetCollections method.
+	GetCollectionsFunc func(s zebedee.Session) ([]zebedee.CollectionDescription, error)
+
+	// GetCollectionsContextFunc mocks the GetCollectionsContext method.
+	GetCollectionsContextFunc func(ctx context.Context, s zebedee.Session) ([]zebedee.CollectionDescription, error)
+
+	// GetContentFunc mocks the GetContent method.
+	GetContentFunc func(s zebedee.Session, collectionName string, uri string) ([]byte, error)
+
+	// GetContentContextFunc mocks the GetContentContext method.
+	GetContentContextFunc func(ctx context.Context, s zebedee.Session, collectionName string, uri string) ([]byte, error)
+
+	// GetContentJSONFunc mocks the GetContentJSON method.
+	GetContentJSONFunc func(s zebedee.Session, collectionID string, uri string, v any) error
+
+	// GetContentJSONContextFunc mocks the GetContentJSONContext method.
+	GetContentJSONContextFunc func(ctx context.Context, s zebedee.Session, collectionID string, uri string, v any) error
+
+	// GetContentStreamFunc mocks the GetContentStream method.
+	GetContentStreamFunc func(ctx context.Context, s zebedee.Session, collectionID string, uri string) (io.ReadCloser, zebedee.ContentInfo, error)
+
+	// GetPermissionsFunc mocks the GetPermissions method.
+	GetPermissionsFunc func(s zebedee.Session, email string) (zebedee.Permissions, error)
+
+	// GetPermissionsContextFunc mocks the GetPermissionsContext method.
+	GetPermissionsContextFunc func(ctx context.Context, s zebedee.Session, email string) (zebedee.Permissions, error)
+
+	// GetPublishedContentFunc mocks the GetPublishedContent method.
+	GetPublishedContentFunc func(s zebedee.Session, uri string) ([]byte, error)
+
+	// GetPublishedContentContextFunc mocks the GetPublishedContentContext method.
+	GetPublishedContentContextFunc func(ctx context.Context, s zebedee.Session, uri string) ([]byte, error)
+
+	// GetPublishedContentStreamFunc mocks the GetPublishedContentStream method.
+	GetPublishedContentStreamFunc func(ctx context.Context, s zebedee.Session, uri string) (io.ReadCloser, zebedee.ContentInfo, error)
+
+	// GetPublishedDataFunc mocks the GetPublishedData method.
+	GetPublishedDataFunc func(s zebedee.Session, uri string) ([]byte, error)
+
+	// GetPublishedDataContextFunc mocks the GetPublishedDataContext method.
+	GetPublishedDataContextFunc func(ctx context.Context, s zebedee.Session, uri string) ([]byte, error)
+
+	// GetTeamFunc mocks the GetTeam method.
+	GetTeamFunc func(s zebedee.Session, teamName string) (zebedee.Team, error)
+
+	// GetTeamContextFunc mocks the GetTeamContext method.
+	GetTeamContextFunc func(ctx context.Context, s zebedee.Session, teamName string) (zebedee.Team, error)
+
+	// GetUserFunc mocks the GetUser method.
+	GetUserFunc func(s zebedee.Session, email string) (zebedee.User, error)
+
+	// GetUserContextFunc mocks the GetUserContext method.
+	GetUserContextFunc func(ctx context.Context, s zebedee.Session, email string) (zebedee.User, error)
+
+	// GetUsersFunc mocks the GetUsers method.
+	GetUsersFunc func(s zebedee.Session) ([]zebedee.User, error)
+
+	// GetUsersContextFunc mocks the GetUsersContext method.
+	GetUsersContextFunc func(ctx context.Context, s zebedee.Session) ([]zebedee.User, error)
+
+	// ListPublishedChildrenFunc mocks the ListPublishedChildren method.
+	ListPublishedChildrenFunc func(s zebedee.Session, uri string) ([]zebedee.ContentNode, error)
+
+	// ListPublishedChildrenContextFunc mocks the ListPublishedChildrenContext method.
+	ListPublishedChildrenContextFunc func(ctx context.Context, s zebedee.Session, uri string) ([]zebedee.ContentNode, error)
+
+	// ListTeamsFunc mocks the ListTeams method.
+	ListTeamsFunc func(s zebedee.Session) (zebedee.TeamsList, error)
+
+	// ListTeamsContextFunc mocks the ListTeamsContext method.
+	ListTeamsContextFunc func(ctx context.Context, s zebedee.Session) (zebedee.TeamsList, error)
+
+	// ListUserKeyringFunc mocks the ListUserKeyring method.
+	ListUserKeyringFunc func(s zebedee.Session) ([]string, error)
+
+	// ListUserKeyringContextFunc mocks the ListUserKeyringContext method.
+	ListUserKeyringContextFunc func(ctx context.Context, s zebedee.Session) ([]string, error)
+
+	// OpenSessionFunc mocks the OpenSession method.
+	OpenSessionFunc func(c zebedee.Credentials) (zebedee.Session, error)
+
+	// OpenSessionContextFunc mocks the OpenSessionContext method.
+	OpenSessionContextFunc func(ctx context.Context, c zebedee.Credentials) (zebedee.Session, error)
+
+	// OpenSessionJWTFunc mocks the OpenSessionJWT method.
+	OpenSessionJWTFunc func(authToken string) (zebedee.Session, error)
+
+	// OpenSessionJWTContextFunc mocks the OpenSessionJWTContext method.
+	OpenSessionJWTContextFunc func(ctx context.Context, authToken string) (zebedee.Session, error)
+
+	// PublishCollectionFunc mocks the PublishCollection method.
+	PublishCollectionFunc func(s zebedee.Session, id string) error
+
+	// PublishCollectionContextFunc mocks the PublishCollectionContext method.
+	PublishCollectionContextFunc func(ctx context.Context, s zebedee.Session, id string) error
+
+	// RemoveTeamMemberFunc mocks the RemoveTeamMember method.
+	RemoveTeamMemberFunc func(s zebedee.Session, teamName string, email string) error
+
+	// RemoveTeamMemberContextFunc mocks the RemoveTeamMemberContext method.
+	RemoveTeamMemberContextFunc func(ctx context.Context, s zebedee.Session, teamName string, email string) error
+
+	// ReviewCollectionContentFunc mocks the ReviewCollectionContent method.
+	ReviewCollectionContentFunc func(s zebedee.Session, id string, contentUri string) error
+
+	// ReviewCollectionContentContextFunc mocks the ReviewCollectionContentContext method.
+	ReviewCollectionContentContextFunc func(ctx context.Context, s zebedee.Session, id string, contentUri string) error
+
+	// ReviewCollectionContentWithOptionsFunc mocks the ReviewCollectionContentWithOptions method.
+	ReviewCollectionContentWithOptionsFunc func(s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error
+
+	// ReviewCollectionContentWithOptionsContextFunc mocks the ReviewCollectionContentWithOptionsContext method.
+	ReviewCollectionContentWithOptionsContextFunc func(ctx context.Context, s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error
+
+	// SetPasswordFunc mocks the SetPassword method.
+	SetPasswordFunc func(s zebedee.Session, c zebedee.Credentials) error
+
+	// SetPasswordContextFunc mocks the SetPasswordContext method.
+	SetPasswordContextFunc func(ctx context.Context, s zebedee.Session, c zebedee.Credentials) error
+
+	// SetPermissionsFunc mocks the SetPermissions method.
+	SetPermissionsFunc func(s zebedee.Session, p zebedee.Permissions) error
+
+	// SetPermissionsContextFunc mocks the SetPermissionsContext method.
+	SetPermissionsContextFunc func(ctx context.Context, s zebedee.Session, p zebedee.Permissions) error
+
+	// UnlockCollectionFunc mocks the UnlockCollection method.
+	UnlockCollectionFunc func(s zebedee.Session, id string) error
+
+	// UnlockCollectionContextFunc mocks the UnlockCollectionContext method.
+	UnlockCollectionContextFunc func(ctx context.Context, s zebedee.Session, id string) error
+
+	// UpdateCollectionFunc mocks the UpdateCollection method.
+	UpdateCollectionFunc func(s zebedee.Session, desc zebedee.CollectionDescription) error
+
+	// UpdateCollectionContentFunc mocks the UpdateCollectionContent method.
+	UpdateCollectionContentFunc func(s zebedee.Session, id string, contentUri string, content interface{}) error
+
+	// UpdateCollectionContentContextFunc mocks the UpdateCollectionContentContext method.
+	UpdateCollectionContentContextFunc func(ctx context.Context, s zebedee.Session, id string, contentUri string, content interface{}) error
+
+	// UpdateCollectionContentWithOptionsFunc mocks the UpdateCollectionContentWithOptions method.
+	UpdateCollectionContentWithOptionsFunc func(s zebedee.Session, id string, contentUri string, content interface{}, opts zebedee.ContentOptions) error
+
+	// UpdateCollectionContentWithOptionsContextFunc mocks the UpdateCollectionContentWithOptionsContext method.
+	UpdateCollectionContentWithOptionsContextFunc func(ctx context.Context, s zebedee.Session, id string, contentUri string, content interface{}, opts zebedee.ContentOptions) error
+
+	// UpdateCollectionContextFunc mocks the UpdateCollectionContext method.
+	UpdateCollectionContextFunc func(ctx context.Context, s zebedee.Session, desc zebedee.CollectionDescription) error
+
+	// UploadFileFunc mocks the UploadFile method.
+	UploadFileFunc func(s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string) error
+
+	// UploadFileContextFunc mocks the UploadFileContext method.
+	UploadFileContextFunc func(ctx context.Context, s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string) error
+
+	// UploadFileWithOptionsFunc mocks the UploadFileWithOptions method.
+	UploadFileWithOptionsFunc func(s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string, opts zebedee.ContentOptions) error
+
+	// UploadFileWithOptionsContextFunc mocks the UploadFileWithOptionsContext method.
+	UploadFileWithOptionsContextFunc func(ctx context.Context, s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string, opts zebedee.ContentOptions) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddTeamMember holds details about calls to the AddTeamMember method.
+		AddTeamMember []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// TeamName is the teamName argument value.
+			TeamName string
+			// Email is the email argument value.
+			Email string
+		}
+		// AddTeamMemberContext holds details about calls to the AddTeamMemberContext method.
+		AddTeamMemberContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// TeamName is the teamName argument value.
+			TeamName string
+			// Email is the email argument value.
+			Email string
+		}
+		// ApproveCollection holds details about calls to the ApproveCollection method.
+		ApproveCollection []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// ApproveCollectionContext holds details about calls to the ApproveCollectionContext method.
+		ApproveCollectionContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// CompleteCollectionContent holds details about calls to the CompleteCollectionContent method.
+		CompleteCollectionContent []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+		}
+		// CompleteCollectionContentContext holds details about calls to the CompleteCollectionContentContext method.
+		CompleteCollectionContentContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+		}
+		// CompleteCollectionContentWithOptions holds details about calls to the CompleteCollectionContentWithOptions method.
+		CompleteCollectionContentWithOptions []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+			// Opts is the opts argument value.
+			Opts zebedee.ContentOptions
+		}
+		// CompleteCollectionContentWithOptionsContext holds details about calls to the CompleteCollectionContentWithOptionsContext method.
+		CompleteCollectionContentWithOptionsContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+			// Opts is the opts argument value.
+			Opts zebedee.ContentOptions
+		}
+		// CreateCollection holds details about calls to the CreateCollection method.
+		CreateCollection []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// Desc is the desc argument value.
+			Desc zebedee.CollectionDescription
+		}
+		// CreateCollectionContext holds details about calls to the CreateCollectionContext method.
+		CreateCollectionContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// Desc is the desc argument value.
+			Desc zebedee.CollectionDescription
+		}
+		// CreateTeam holds details about calls to the CreateTeam method.
+		CreateTeam []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// TeamName is the teamName argument value.
+			TeamName string
+		}
+		// CreateTeamContext holds details about calls to the CreateTeamContext method.
+		CreateTeamContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// TeamName is the teamName argument value.
+			TeamName string
+		}
+		// CreateUser holds details about calls to the CreateUser method.
+		CreateUser []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// U is the u argument value.
+			U zebedee.User
+		}
+		// CreateUserContext holds details about calls to the CreateUserContext method.
+		CreateUserContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// U is the u argument value.
+			U zebedee.User
+		}
+		// DeleteCollection holds details about calls to the DeleteCollection method.
+		DeleteCollection []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// DeleteCollectionContent holds details about calls to the DeleteCollectionContent method.
+		DeleteCollectionContent []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+		}
+		// DeleteCollectionContentContext holds details about calls to the DeleteCollectionContentContext method.
+		DeleteCollectionContentContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+		}
+		// DeleteCollectionContext holds details about calls to the DeleteCollectionContext method.
+		DeleteCollectionContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// DeleteTeam holds details about calls to the DeleteTeam method.
+		DeleteTeam []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// TeamName is the teamName argument value.
+			TeamName string
+		}
+		// DeleteTeamContext holds details about calls to the DeleteTeamContext method.
+		DeleteTeamContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// TeamName is the teamName argument value.
+			TeamName string
+		}
+		// DeleteUser holds details about calls to the DeleteUser method.
+		DeleteUser []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// Email is the email argument value.
+			Email string
+		}
+		// DeleteUserContext holds details about calls to the DeleteUserContext method.
+		DeleteUserContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// Email is the email argument value.
+			Email string
+		}
+		// DownloadFile holds details about calls to the DownloadFile method.
+		DownloadFile []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// CollectionID is the collectionID argument value.
+			CollectionID string
+			// URI is the uri argument value.
+			URI string
+		}
+		// DownloadFileContext holds details about calls to the DownloadFileContext method.
+		DownloadFileContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// CollectionID is the collectionID argument value.
+			CollectionID string
+			// URI is the uri argument value.
+			URI string
+		}
+		// GetCollectionByID holds details about calls to the GetCollectionByID method.
+		GetCollectionByID []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// GetCollectionByIDContext holds details about calls to the GetCollectionByIDContext method.
+		GetCollectionByIDContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// GetCollectionDetails holds details about calls to the GetCollectionDetails method.
+		GetCollectionDetails []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// GetCollectionDetailsContext holds details about calls to the GetCollectionDetailsContext method.
+		GetCollectionDetailsContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// GetCollections holds details about calls to the GetCollections method.
+		GetCollections []struct {
+			// S is the s argument value.
+			S zebedee.Session
+		}
+		// GetCollectionsContext holds details about calls to the GetCollectionsContext method.
+		GetCollectionsContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+		}
+		// GetContent holds details about calls to the GetContent method.
+		GetContent []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// CollectionName is the collectionName argument value.
+			CollectionName string
+			// URI is the uri argument value.
+			URI string
+		}
+		// GetContentContext holds details about calls to the GetContentContext method.
+		GetContentContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// CollectionName is the collectionName argument value.
+			CollectionName string
+			// URI is the uri argument value.
+			URI string
+		}
+		// GetContentJSON holds details about calls to the GetContentJSON method.
+		GetContentJSON []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// CollectionID is the collectionID argument value.
+			CollectionID string
+			// URI is the uri argument value.
+			URI string
+			// V is the v argument value.
+			V any
+		}
+		// GetContentJSONContext holds details about calls to the GetContentJSONContext method.
+		GetContentJSONContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// CollectionID is the collectionID argument value.
+			CollectionID string
+			// URI is the uri argument value.
+			URI string
+			// V is the v argument value.
+			V any
+		}
+		// GetContentStream holds details about calls to the GetContentStream method.
+		GetContentStream []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// CollectionID is the collectionID argument value.
+			CollectionID string
+			// URI is the uri argument value.
+			URI string
+		}
+		// GetPermissions holds details about calls to the GetPermissions method.
+		GetPermissions []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// Email is the email argument value.
+			Email string
+		}
+		// GetPermissionsContext holds details about calls to the GetPermissionsContext method.
+		GetPermissionsContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// Email is the email argument value.
+			Email string
+		}
+		// GetPublishedContent holds details about calls to the GetPublishedContent method.
+		GetPublishedContent []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// URI is the uri argument value.
+			URI string
+		}
+		// GetPublishedContentContext holds details about calls to the GetPublishedContentContext method.
+		GetPublishedContentContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// URI is the uri argument value.
+			URI string
+		}
+		// GetPublishedContentStream holds details about calls to the GetPublishedContentStream method.
+		GetPublishedContentStream []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// URI is the uri argument value.
+			URI string
+		}
+		// GetPublishedData holds details about calls to the GetPublishedData method.
+		GetPublishedData []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// URI is the uri argument value.
+			URI string
+		}
+		// GetPublishedDataContext holds details about calls to the GetPublishedDataContext method.
+		GetPublishedDataContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// URI is the uri argument value.
+			URI string
+		}
+		// GetTeam holds details about calls to the GetTeam method.
+		GetTeam []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// TeamName is the teamName argument value.
+			TeamName string
+		}
+		// GetTeamContext holds details about calls to the GetTeamContext method.
+		GetTeamContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// TeamName is the teamName argument value.
+			TeamName string
+		}
+		// GetUser holds details about calls to the GetUser method.
+		GetUser []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// Email is the email argument value.
+			Email string
+		}
+		// GetUserContext holds details about calls to the GetUserContext method.
+		GetUserContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// Email is the email argument value.
+			Email string
+		}
+		// GetUsers holds details about calls to the GetUsers method.
+		GetUsers []struct {
+			// S is the s argument value.
+			S zebedee.Session
+		}
+		// GetUsersContext holds details about calls to the GetUsersContext method.
+		GetUsersContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+		}
+		// ListPublishedChildren holds details about calls to the ListPublishedChildren method.
+		ListPublishedChildren []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// URI is the uri argument value.
+			URI string
+		}
+		// ListPublishedChildrenContext holds details about calls to the ListPublishedChildrenContext method.
+		ListPublishedChildrenContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// URI is the uri argument value.
+			URI string
+		}
+		// ListTeams holds details about calls to the ListTeams method.
+		ListTeams []struct {
+			// S is the s argument value.
+			S zebedee.Session
+		}
+		// ListTeamsContext holds details about calls to the ListTeamsContext method.
+		ListTeamsContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+		}
+		// ListUserKeyring holds details about calls to the ListUserKeyring method.
+		ListUserKeyring []struct {
+			// S is the s argument value.
+			S zebedee.Session
+		}
+		// ListUserKeyringContext holds details about calls to the ListUserKeyringContext method.
+		ListUserKeyringContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+		}
+		// OpenSession holds details about calls to the OpenSession method.
+		OpenSession []struct {
+			// C is the c argument value.
+			C zebedee.Credentials
+		}
+		// OpenSessionContext holds details about calls to the OpenSessionContext method.
+		OpenSessionContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// C is the c argument value.
+			C zebedee.Credentials
+		}
+		// OpenSessionJWT holds details about calls to the OpenSessionJWT method.
+		OpenSessionJWT []struct {
+			// AuthToken is the authToken argument value.
+			AuthToken string
+		}
+		// OpenSessionJWTContext holds details about calls to the OpenSessionJWTContext method.
+		OpenSessionJWTContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AuthToken is the authToken argument value.
+			AuthToken string
+		}
+		// PublishCollection holds details about calls to the PublishCollection method.
+		PublishCollection []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// PublishCollectionContext holds details about calls to the PublishCollectionContext method.
+		PublishCollectionContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// RemoveTeamMember holds details about calls to the RemoveTeamMember method.
+		RemoveTeamMember []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// TeamName is the teamName argument value.
+			TeamName string
+			// Email is the email argument value.
+			Email string
+		}
+		// RemoveTeamMemberContext holds details about calls to the RemoveTeamMemberContext method.
+		RemoveTeamMemberContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// TeamName is the teamName argument value.
+			TeamName string
+			// Email is the email argument value.
+			Email string
+		}
+		// ReviewCollectionContent holds details about calls to the ReviewCollectionContent method.
+		ReviewCollectionContent []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+		}
+		// ReviewCollectionContentContext holds details about calls to the ReviewCollectionContentContext method.
+		ReviewCollectionContentContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+		}
+		// ReviewCollectionContentWithOptions holds details about calls to the ReviewCollectionContentWithOptions method.
+		ReviewCollectionContentWithOptions []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+			// Opts is the opts argument value.
+			Opts zebedee.ContentOptions
+		}
+		// ReviewCollectionContentWithOptionsContext holds details about calls to the ReviewCollectionContentWithOptionsContext method.
+		ReviewCollectionContentWithOptionsContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+			// Opts is the opts argument value.
+			Opts zebedee.ContentOptions
+		}
+		// SetPassword holds details about calls to the SetPassword method.
+		SetPassword []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// C is the c argument value.
+			C zebedee.Credentials
+		}
+		// SetPasswordContext holds details about calls to the SetPasswordContext method.
+		SetPasswordContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// C is the c argument value.
+			C zebedee.Credentials
+		}
+		// SetPermissions holds details about calls to the SetPermissions method.
+		SetPermissions []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// P is the p argument value.
+			P zebedee.Permissions
+		}
+		// SetPermissionsContext holds details about calls to the SetPermissionsContext method.
+		SetPermissionsContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// P is the p argument value.
+			P zebedee.Permissions
+		}
+		// UnlockCollection holds details about calls to the UnlockCollection method.
+		UnlockCollection []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// UnlockCollectionContext holds details about calls to the UnlockCollectionContext method.
+		UnlockCollectionContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// UpdateCollection holds details about calls to the UpdateCollection method.
+		UpdateCollection []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// Desc is the desc argument value.
+			Desc zebedee.CollectionDescription
+		}
+		// UpdateCollectionContent holds details about calls to the UpdateCollectionContent method.
+		UpdateCollectionContent []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+			// Content is the content argument value.
+			Content interface{}
+		}
+		// UpdateCollectionContentContext holds details about calls to the UpdateCollectionContentContext method.
+		UpdateCollectionContentContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+			// Content is the content argument value.
+			Content interface{}
+		}
+		// UpdateCollectionContentWithOptions holds details about calls to the UpdateCollectionContentWithOptions method.
+		UpdateCollectionContentWithOptions []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+			// Content is the content argument value.
+			Content interface{}
+			// Opts is the opts argument value.
+			Opts zebedee.ContentOptions
+		}
+		// UpdateCollectionContentWithOptionsContext holds details about calls to the UpdateCollectionContentWithOptionsContext method.
+		UpdateCollectionContentWithOptionsContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+			// Content is the content argument value.
+			Content interface{}
+			// Opts is the opts argument value.
+			Opts zebedee.ContentOptions
+		}
+		// UpdateCollectionContext holds details about calls to the UpdateCollectionContext method.
+		UpdateCollectionContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// Desc is the desc argument value.
+			Desc zebedee.CollectionDescription
+		}
+		// UploadFile holds details about calls to the UploadFile method.
+		UploadFile []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// CollectionID is the collectionID argument value.
+			CollectionID string
+			// URI is the uri argument value.
+			URI string
+			// R is the r argument value.
+			R io.Reader
+			// ContentType is the contentType argument value.
+			ContentType string
+		}
+		// UploadFileContext holds details about calls to the UploadFileContext method.
+		UploadFileContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// CollectionID is the collectionID argument value.
+			CollectionID string
+			// URI is the uri argument value.
+			URI string
+			// R is the r argument value.
+			R io.Reader
+			// ContentType is the contentType argument value.
+			ContentType string
+		}
+		// UploadFileWithOptions holds details about calls to the UploadFileWithOptions method.
+		UploadFileWithOptions []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// CollectionID is the collectionID argument value.
+			CollectionID string
+			// URI is the uri argument value.
+			URI string
+			// R is the r argument value.
+			R io.Reader
+			// ContentType is the contentType argument value.
+			ContentType string
+			// Opts is the opts argument value.
+			Opts zebedee.ContentOptions
+		}
+		// UploadFileWithOptionsContext holds details about calls to the UploadFileWithOptionsContext method.
+		UploadFileWithOptionsContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// CollectionID is the collectionID argument value.
+			CollectionID string
+			// URI is the uri argument value.
+			URI string
+			// R is the r argument value.
+			R io.Reader
+			// ContentType is the contentType argument value.
+			ContentType string
+			// Opts is the opts argument value.
+			Opts zebedee.ContentOptions
+		}
+	}
+}
+
+// AddTeamMember calls AddTeamMemberFunc.
+func (mock *ClientMock) AddTeamMember(s zebedee.Session, teamName string, email string) error {
+	if mock.AddTeamMemberFunc == nil {
+		panic("ClientMock.AddTeamMemberFunc: method is nil but Client.AddTeamMember was just called")
+	}
+	callInfo := struct {
+		S        zebedee.Session
+		TeamName string
+		Email    string
+	}{
+		S:        s,
+		TeamName: teamName,
+		Email:    email,
+	}
+	lockClientMockAddTeamMember.Lock()
+	mock.calls.AddTeamMember = append(mock.calls.AddTeamMember, callInfo)
+	lockClientMockAddTeamMember.Unlock()
+	return mock.AddTeamMemberFunc(s, teamName, email)
+}
+
+// AddTeamMemberCalls gets all the calls that were made to AddTeamMember.
+// Check the length with:
+//
+//	len(mockedClient.AddTeamMemberCalls())
+func (mock *ClientMock) AddTeamMemberCalls() []struct {
+	S        zebedee.Session
+	TeamName string
+	Email    string
+} {
+	var calls []struct {
+		S        zebedee.Session
+		TeamName string
+		Email    string
+	}
+	lockClientMockAddTeamMember.RLock()
+	calls = mock.calls.AddTeamMember
+	lockClientMockAddTeamMember.RUnlock()
+	return calls
+}
+
+// AddTeamMemberContext calls AddTeamMemberContextFunc.
+func (mock *ClientMock) AddTeamMemberContext(ctx context.Context, s zebedee.Session, teamName string, email string) error {
+	if mock.AddTeamMemberContextFunc == nil {
+		panic("ClientMock.AddTeamMemberContextFunc: method is nil but Client.AddTeamMemberContext was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		S        zebedee.Session
+		TeamName string
+		Email    string
+	}{
+		Ctx:      ctx,
+		S:        s,
+		TeamName: teamName,
+		Email:    email,
+	}
+	lockClientMockAddTeamMemberContext.Lock()
+	mock.calls.AddTeamMemberContext = append(mock.calls.AddTeamMemberContext, callInfo)
+	lockClientMockAddTeamMemberContext.Unlock()
+	return mock.AddTeamMemberContextFunc(ctx, s, teamName, email)
+}
+
+// AddTeamMemberContextCalls gets all the calls that were made to AddTeamMemberContext.
+// Check the length with:
+//
+//	len(mockedClient.AddTeamMemberContextCalls())
+func (mock *ClientMock) AddTeamMemberContextCalls() []struct {
+	Ctx      context.Context
+	S        zebedee.Session
+	TeamName string
+	Email    string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		S        zebedee.Session
+		TeamName string
+		Email    string
+	}
+	lockClientMockAddTeamMemberContext.RLock()
+	calls = mock.calls.AddTeamMemberContext
+	lockClientMockAddTeamMemberContext.RUnlock()
+	return calls
+}
+
+// ApproveCollection calls ApproveCollectionFunc.
+func (mock *ClientMock) ApproveCollection(s zebedee.Session, id string) error {
+	if mock.ApproveCollectionFunc == nil {
+		panic("ClientMock.ApproveCollectionFunc: method is nil but Client.ApproveCollection was just called")
+	}
+	callInfo := struct {
+		S  zebedee.Session
+		ID string
+	}{
+		S:  s,
+		ID: id,
+	}
+	lockClientMockApproveCollection.Lock()
+	mock.calls.ApproveCollection = append(mock.calls.ApproveCollection, callInfo)
+	lockClientMockApproveCollection.Unlock()
+	return mock.ApproveCollectionFunc(s, id)
+}
+
+// ApproveCollectionCalls gets all the calls that were made to ApproveCollection.
+// Check the length with:
+//
+//	len(mockedClient.ApproveCollectionCalls())
+func (mock *ClientMock) ApproveCollectionCalls() []struct {
+	S  zebedee.Session
+	ID string
+} {
+	var calls []struct {
+		S  zebedee.Session
+		ID string
+	}
+	lockClientMockApproveCollection.RLock()
+	calls = mock.calls.ApproveCollection
+	lockClientMockApproveCollection.RUnlock()
+	return calls
+}
+
+// ApproveCollectionContext calls ApproveCollectionContextFunc.
+func (mock *ClientMock) ApproveCollectionContext(ctx context.Context, s zebedee.Session, id string) error {
+	if mock.ApproveCollectionContextFunc == nil {
+		panic("ClientMock.ApproveCollectionContextFunc: method is nil but Client.ApproveCollectionContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}{
+		Ctx: ctx,
+		S:   s,
+		ID:  id,
+	}
+	lockClientMockApproveCollectionContext.Lock()
+	mock.calls.ApproveCollectionContext = append(mock.calls.ApproveCollectionContext, callInfo)
+	lockClientMockApproveCollectionContext.Unlock()
+	return mock.ApproveCollectionContextFunc(ctx, s, id)
+}
+
+// ApproveCollectionContextCalls gets all the calls that were made to ApproveCollectionContext.
+// Check the length with:
+//
+//	len(mockedClient.ApproveCollectionContextCalls())
+func (mock *ClientMock) ApproveCollectionContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}
+	lockClientMockApproveCollectionContext.RLock()
+	calls = mock.calls.ApproveCollectionContext
+	lockClientMockApproveCollectionContext.RUnlock()
+	return calls
+}
+
+// CompleteCollectionContent calls CompleteCollectionContentFunc.
+func (mock *ClientMock) CompleteCollectionContent(s zebedee.Session, id string, contentUri string) error {
+	if mock.CompleteCollectionContentFunc == nil {
+		panic("ClientMock.CompleteCollectionContentFunc: method is nil but Client.CompleteCollectionContent was just called")
+	}
+	callInfo := struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}{
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+	}
+	lockClientMockCompleteCollectionContent.Lock()
+	mock.calls.CompleteCollectionContent = append(mock.calls.CompleteCollectionContent, callInfo)
+	lockClientMockCompleteCollectionContent.Unlock()
+	return mock.CompleteCollectionContentFunc(s, id, contentUri)
+}
+
+// CompleteCollectionContentCalls gets all the calls that were made to CompleteCollectionContent.
+// Check the length with:
+//
+//	len(mockedClient.CompleteCollectionContentCalls())
+func (mock *ClientMock) CompleteCollectionContentCalls() []struct {
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+} {
+	var calls []struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}
+	lockClientMockCompleteCollectionContent.RLock()
+	calls = mock.calls.CompleteCollectionContent
+	lockClientMockCompleteCollectionContent.RUnlock()
+	return calls
+}
+
+// CompleteCollectionContentContext calls CompleteCollectionContentContextFunc.
+func (mock *ClientMock) CompleteCollectionContentContext(ctx context.Context, s zebedee.Session, id string, contentUri string) error {
+	if mock.CompleteCollectionContentContextFunc == nil {
+		panic("ClientMock.CompleteCollectionContentContextFunc: method is nil but Client.CompleteCollectionContentContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}{
+		Ctx:        ctx,
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+	}
+	lockClientMockCompleteCollectionContentContext.Lock()
+	mock.calls.CompleteCollectionContentContext = append(mock.calls.CompleteCollectionContentContext, callInfo)
+	lockClientMockCompleteCollectionContentContext.Unlock()
+	return mock.CompleteCollectionContentContextFunc(ctx, s, id, contentUri)
+}
+
+// CompleteCollectionContentContextCalls gets all the calls that were made to CompleteCollectionContentContext.
+// Check the length with:
+//
+//	len(mockedClient.CompleteCollectionContentContextCalls())
+func (mock *ClientMock) CompleteCollectionContentContextCalls() []struct {
+	Ctx        context.Context
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}
+	lockClientMockCompleteCollectionContentContext.RLock()
+	calls = mock.calls.CompleteCollectionContentContext
+	lockClientMockCompleteCollectionContentContext.RUnlock()
+	return calls
+}
+
+// CompleteCollectionContentWithOptions calls CompleteCollectionContentWithOptionsFunc.
+func (mock *ClientMock) CompleteCollectionContentWithOptions(s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error {
+	if mock.CompleteCollectionContentWithOptionsFunc == nil {
+		panic("ClientMock.CompleteCollectionContentWithOptionsFunc: method is nil but Client.CompleteCollectionContentWithOptions was just called")
+	}
+	callInfo := struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Opts       zebedee.ContentOptions
+	}{
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+		Opts:       opts,
+	}
+	lockClientMockCompleteCollectionContentWithOptions.Lock()
+	mock.calls.CompleteCollectionContentWithOptions = append(mock.calls.CompleteCollectionContentWithOptions, callInfo)
+	lockClientMockCompleteCollectionContentWithOptions.Unlock()
+	return mock.CompleteCollectionContentWithOptionsFunc(s, id, contentUri, opts)
+}
+
+// CompleteCollectionContentWithOptionsCalls gets all the calls that were made to CompleteCollectionContentWithOptions.
+// Check the length with:
+//
+//	len(mockedClient.CompleteCollectionContentWithOptionsCalls())
+func (mock *ClientMock) CompleteCollectionContentWithOptionsCalls() []struct {
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+	Opts       zebedee.ContentOptions
+} {
+	var calls []struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Opts       zebedee.ContentOptions
+	}
+	lockClientMockCompleteCollectionContentWithOptions.RLock()
+	calls = mock.calls.CompleteCollectionContentWithOptions
+	lockClientMockCompleteCollectionContentWithOptions.RUnlock()
+	return calls
+}
+
+// CompleteCollectionContentWithOptionsContext calls CompleteCollectionContentWithOptionsContextFunc.
+func (mock *ClientMock) CompleteCollectionContentWithOptionsContext(ctx context.Context, s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error {
+	if mock.CompleteCollectionContentWithOptionsContextFunc == nil {
+		panic("ClientMock.CompleteCollectionContentWithOptionsContextFunc: method is nil but Client.CompleteCollectionContentWithOptionsContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Opts       zebedee.ContentOptions
+	}{
+		Ctx:        ctx,
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+		Opts:       opts,
+	}
+	lockClientMockCompleteCollectionContentWithOptionsContext.Lock()
+	mock.calls.CompleteCollectionContentWithOptionsContext = append(mock.calls.CompleteCollectionContentWithOptionsContext, callInfo)
+	lockClientMockCompleteCollectionContentWithOptionsContext.Unlock()
+	return mock.CompleteCollectionContentWithOptionsContextFunc(ctx, s, id, contentUri, opts)
+}
+
+// CompleteCollectionContentWithOptionsContextCalls gets all the calls that were made to CompleteCollectionContentWithOptionsContext.
+// Check the length with:
+//
+//	len(mockedClient.CompleteCollectionContentWithOptionsContextCalls())
+func (mock *ClientMock) CompleteCollectionContentWithOptionsContextCalls() []struct {
+	Ctx        context.Context
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+	Opts       zebedee.ContentOptions
+} {
+	var calls []struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Opts       zebedee.ContentOptions
+	}
+	lockClientMockCompleteCollectionContentWithOptionsContext.RLock()
+	calls = mock.calls.CompleteCollectionContentWithOptionsContext
+	lockClientMockCompleteCollectionContentWithOptionsContext.RUnlock()
+	return calls
+}
+
+// CreateCollection calls CreateCollectionFunc.
+func (mock *ClientMock) CreateCollection(s zebedee.Session, desc zebedee.CollectionDescription) (zebedee.CollectionDescription, error) {
+	if mock.CreateCollectionFunc == nil {
+		panic("ClientMock.CreateCollectionFunc: method is nil but Client.CreateCollection was just called")
+	}
+	callInfo := struct {
+		S    zebedee.Session
+		Desc zebedee.CollectionDescription
+	}{
+		S:    s,
+		Desc: desc,
+	}
+	lockClientMockCreateCollection.Lock()
+	mock.calls.CreateCollection = append(mock.calls.CreateCollection, callInfo)
+	lockClientMockCreateCollection.Unlock()
+	return mock.CreateCollectionFunc(s, desc)
+}
+
+// CreateCollectionCalls gets all the calls that were made to CreateCollection.
+// Check the length with:
+//
+//	len(mockedClient.CreateCollectionCalls())
+func (mock *ClientMock) CreateCollectionCalls() []struct {
+	S    zebedee.Session
+	Desc zebedee.CollectionDescription
+} {
+	var calls []struct {
+		S    zebedee.Session
+		Desc zebedee.CollectionDescription
+	}
+	lockClientMockCreateCollection.RLock()
+	calls = mock.calls.CreateCollection
+	lockClientMockCreateCollection.RUnlock()
+	return calls
+}
+
+// CreateCollectionContext calls CreateCollectionContextFunc.
+func (mock *ClientMock) CreateCollectionContext(ctx context.Context, s zebedee.Session, desc zebedee.CollectionDescription) (zebedee.CollectionDescription, error) {
+	if mock.CreateCollectionContextFunc == nil {
+		panic("ClientMock.CreateCollectionContextFunc: method is nil but Client.CreateCollectionContext was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		S    zebedee.Session
+		Desc zebedee.CollectionDescription
+	}{
+		Ctx:  ctx,
+		S:    s,
+		Desc: desc,
+	}
+	lockClientMockCreateCollectionContext.Lock()
+	mock.calls.CreateCollectionContext = append(mock.calls.CreateCollectionContext, callInfo)
+	lockClientMockCreateCollectionContext.Unlock()
+	return mock.CreateCollectionContextFunc(ctx, s, desc)
+}
+
+// CreateCollectionContextCalls gets all the calls that were made to CreateCollectionContext.
+// Check the length with:
+//
+//	len(mockedClient.CreateCollectionContextCalls())
+func (mock *ClientMock) CreateCollectionContextCalls() []struct {
+	Ctx  context.Context
+	S    zebedee.Session
+	Desc zebedee.CollectionDescription
+} {
+	var calls []struct {
+		Ctx  context.Context
+		S    zebedee.Session
+		Desc zebedee.CollectionDescription
+	}
+	lockClientMockCreateCollectionContext.RLock()
+	calls = mock.calls.CreateCollectionContext
+	lockClientMockCreateCollectionContext.RUnlock()
+	return calls
+}
+
+// CreateTeam calls CreateTeamFunc.
+func (mock *ClientMock) CreateTeam(s zebedee.Session, teamName string) (bool, error) {
+	if mock.CreateTeamFunc == nil {
+		panic("ClientMock.CreateTeamFunc: method is nil but Client.CreateTeam was just called")
+	}
+	callInfo := struct {
+		S        zebedee.Session
+		TeamName string
+	}{
+		S:        s,
+		TeamName: teamName,
+	}
+	lockClientMockCreateTeam.Lock()
+	mock.calls.CreateTeam = append(mock.calls.CreateTeam, callInfo)
+	lockClientMockCreateTeam.Unlock()
+	return mock.CreateTeamFunc(s, teamName)
+}
+
+// CreateTeamCalls gets all the calls that were made to CreateTeam.
+// Check the length with:
+//
+//	len(mockedClient.CreateTeamCalls())
+func (mock *ClientMock) CreateTeamCalls() []struct {
+	S        zebedee.Session
+	TeamName string
+} {
+	var calls []struct {
+		S        zebedee.Session
+		TeamName string
+	}
+	lockClientMockCreateTeam.RLock()
+	calls = mock.calls.CreateTeam
+	lockClientMockCreateTeam.RUnlock()
+	return calls
+}
+
+// CreateTeamContext calls CreateTeamContextFunc.
+func (mock *ClientMock) CreateTeamContext(ctx context.Context, s zebedee.Session, teamName string) (bool, error) {
+	if mock.CreateTeamContextFunc == nil {
+		panic("ClientMock.CreateTeamContextFunc: method is nil but Client.CreateTeamContext was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		S        zebedee.Session
+		TeamName string
+	}{
+		Ctx:      ctx,
+		S:        s,
+		TeamName: teamName,
+	}
+	lockClientMockCreateTeamContext.Lock()
+	mock.calls.CreateTeamContext = append(mock.calls.CreateTeamContext, callInfo)
+	lockClientMockCreateTeamContext.Unlock()
+	return mock.CreateTeamContextFunc(ctx, s, teamName)
+}
+
+// CreateTeamContextCalls gets all the calls that were made to CreateTeamContext.
+// Check the length with:
+//
+//	len(mockedClient.CreateTeamContextCalls())
+func (mock *ClientMock) CreateTeamContextCalls() []struct {
+	Ctx      context.Context
+	S        zebedee.Session
+	TeamName string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		S        zebedee.Session
+		TeamName string
+	}
+	lockClientMockCreateTeamContext.RLock()
+	calls = mock.calls.CreateTeamContext
+	lockClientMockCreateTeamContext.RUnlock()
+	return calls
+}
+
+// CreateUser calls CreateUserFunc.
+func (mock *ClientMock) CreateUser(s zebedee.Session, u zebedee.User) (zebedee.User, error) {
+	if mock.CreateUserFunc == nil {
+		panic("ClientMock.CreateUserFunc: method is nil but Client.CreateUser was just called")
+	}
+	callInfo := struct {
+		S zebedee.Session
+		U zebedee.User
+	}{
+		S: s,
+		U: u,
+	}
+	lockClientMockCreateUser.Lock()
+	mock.calls.CreateUser = append(mock.calls.CreateUser, callInfo)
+	lockClientMockCreateUser.Unlock()
+	return mock.CreateUserFunc(s, u)
+}
+
+// CreateUserCalls gets all the calls that were made to CreateUser.
+// Check the length with:
+//
+//	len(mockedClient.CreateUserCalls())
+func (mock *ClientMock) CreateUserCalls() []struct {
+	S zebedee.Session
+	U zebedee.User
+} {
+	var calls []struct {
+		S zebedee.Session
+		U zebedee.User
+	}
+	lockClientMockCreateUser.RLock()
+	calls = mock.calls.CreateUser
+	lockClientMockCreateUser.RUnlock()
+	return calls
+}
+
+// CreateUserContext calls CreateUserContextFunc.
+func (mock *ClientMock) CreateUserContext(ctx context.Context, s zebedee.Session, u zebedee.User) (zebedee.User, error) {
+	if mock.CreateUserContextFunc == nil {
+		panic("ClientMock.CreateUserContextFunc: method is nil but Client.CreateUserContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		U   zebedee.User
+	}{
+		Ctx: ctx,
+		S:   s,
+		U:   u,
+	}
+	lockClientMockCreateUserContext.Lock()
+	mock.calls.CreateUserContext = append(mock.calls.CreateUserContext, callInfo)
+	lockClientMockCreateUserContext.Unlock()
+	return mock.CreateUserContextFunc(ctx, s, u)
+}
+
+// CreateUserContextCalls gets all the calls that were made to CreateUserContext.
+// Check the length with:
+//
+//	len(mockedClient.CreateUserContextCalls())
+func (mock *ClientMock) CreateUserContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	U   zebedee.User
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		U   zebedee.User
+	}
+	lockClientMockCreateUserContext.RLock()
+	calls = mock.calls.CreateUserContext
+	lockClientMockCreateUserContext.RUnlock()
+	return calls
+}
+
+// DeleteCollection calls DeleteCollectionFunc.
+func (mock *ClientMock) DeleteCollection(s zebedee.Session, id string) error {
+	if mock.DeleteCollectionFunc == nil {
+		panic("ClientMock.DeleteCollectionFunc: method is nil but Client.DeleteCollection was just called")
+	}
+	callInfo := struct {
+		S  zebedee.Session
+		ID string
+	}{
+		S:  s,
+		ID: id,
+	}
+	lockClientMockDeleteCollection.Lock()
+	mock.calls.DeleteCollection = append(mock.calls.DeleteCollection, callInfo)
+	lockClientMockDeleteCollection.Unlock()
+	return mock.DeleteCollectionFunc(s, id)
+}
+
+// DeleteCollectionCalls gets all the calls that were made to DeleteCollection.
+// Check the length with:
+//
+//	len(mockedClient.DeleteCollectionCalls())
+func (mock *ClientMock) DeleteCollectionCalls() []struct {
+	S  zebedee.Session
+	ID string
+} {
+	var calls []struct {
+		S  zebedee.Session
+		ID string
+	}
+	lockClientMockDeleteCollection.RLock()
+	calls = mock.calls.DeleteCollection
+	lockClientMockDeleteCollection.RUnlock()
+	return calls
+}
+
+// DeleteCollectionContent calls DeleteCollectionContentFunc.
+func (mock *ClientMock) DeleteCollectionContent(s zebedee.Session, id string, contentUri string) error {
+	if mock.DeleteCollectionContentFunc == nil {
+		panic("ClientMock.DeleteCollectionContentFunc: method is nil but Client.DeleteCollectionContent was just called")
+	}
+	callInfo := struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}{
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+	}
+	lockClientMockDeleteCollectionContent.Lock()
+	mock.calls.DeleteCollectionContent = append(mock.calls.DeleteCollectionContent, callInfo)
+	lockClientMockDeleteCollectionContent.Unlock()
+	return mock.DeleteCollectionContentFunc(s, id, contentUri)
+}
+
+// DeleteCollectionContentCalls gets all the calls that were made to DeleteCollectionContent.
+// Check the length with:
+//
+//	len(mockedClient.DeleteCollectionContentCalls())
+func (mock *ClientMock) DeleteCollectionContentCalls() []struct {
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+} {
+	var calls []struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}
+	lockClientMockDeleteCollectionContent.RLock()
+	calls = mock.calls.DeleteCollectionContent
+	lockClientMockDeleteCollectionContent.RUnlock()
+	return calls
+}
+
+// DeleteCollectionContentContext calls DeleteCollectionContentContextFunc.
+func (mock *ClientMock) DeleteCollectionContentContext(ctx context.Context, s zebedee.Session, id string, contentUri string) error {
+	if mock.DeleteCollectionContentContextFunc == nil {
+		panic("ClientMock.DeleteCollectionContentContextFunc: method is nil but Client.DeleteCollectionContentContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}{
+		Ctx:        ctx,
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+	}
+	lockClientMockDeleteCollectionContentContext.Lock()
+	mock.calls.DeleteCollectionContentContext = append(mock.calls.DeleteCollectionContentContext, callInfo)
+	lockClientMockDeleteCollectionContentContext.Unlock()
+	return mock.DeleteCollectionContentContextFunc(ctx, s, id, contentUri)
+}
+
+// DeleteCollectionContentContextCalls gets all the calls that were made to DeleteCollectionContentContext.
+// Check the length with:
+//
+//	len(mockedClient.DeleteCollectionContentContextCalls())
+func (mock *ClientMock) DeleteCollectionContentContextCalls() []struct {
+	Ctx        context.Context
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}
+	lockClientMockDeleteCollectionContentContext.RLock()
+	calls = mock.calls.DeleteCollectionContentContext
+	lockClientMockDeleteCollectionContentContext.RUnlock()
+	return calls
+}
+
+// DeleteCollectionContext calls DeleteCollectionContextFunc.
+func (mock *ClientMock) DeleteCollectionContext(ctx context.Context, s zebedee.Session, id string) error {
+	if mock.DeleteCollectionContextFunc == nil {
+		panic("ClientMock.DeleteCollectionContextFunc: method is nil but Client.DeleteCollectionContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}{
+		Ctx: ctx,
+		S:   s,
+		ID:  id,
+	}
+	lockClientMockDeleteCollectionContext.Lock()
+	mock.calls.DeleteCollectionContext = append(mock.calls.DeleteCollectionContext, callInfo)
+	lockClientMockDeleteCollectionContext.Unlock()
+	return mock.DeleteCollectionContextFunc(ctx, s, id)
+}
+
+// DeleteCollectionContextCalls gets all the calls that were made to DeleteCollectionContext.
+// Check the length with:
+//
+//	len(mockedClient.DeleteCollectionContextCalls())
+func (mock *ClientMock) DeleteCollectionContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}
+	lockClientMockDeleteCollectionContext.RLock()
+	calls = mock.calls.DeleteCollectionContext
+	lockClientMockDeleteCollectionContext.RUnlock()
+	return calls
+}
+
+// DeleteTeam calls DeleteTeamFunc.
+func (mock *ClientMock) DeleteTeam(s zebedee.Session, teamName string) error {
+	if mock.DeleteTeamFunc == nil {
+		panic("ClientMock.DeleteTeamFunc: method is nil but Client.DeleteTeam was just called")
+	}
+	callInfo := struct {
+		S        zebedee.Session
+		TeamName string
+	}{
+		S:        s,
+		TeamName: teamName,
+	}
+	lockClientMockDeleteTeam.Lock()
+	mock.calls.DeleteTeam = append(mock.calls.DeleteTeam, callInfo)
+	lockClientMockDeleteTeam.Unlock()
+	return mock.DeleteTeamFunc(s, teamName)
+}
+
+// DeleteTeamCalls gets all the calls that were made to DeleteTeam.
+// Check the length with:
+//
+//	len(mockedClient.DeleteTeamCalls())
+func (mock *ClientMock) DeleteTeamCalls() []struct {
+	S        zebedee.Session
+	TeamName string
+} {
+	var calls []struct {
+		S        zebedee.Session
+		TeamName string
+	}
+	lockClientMockDeleteTeam.RLock()
+	calls = mock.calls.DeleteTeam
+	lockClientMockDeleteTeam.RUnlock()
+	return calls
+}
+
+// DeleteTeamContext calls DeleteTeamContextFunc.
+func (mock *ClientMock) DeleteTeamContext(ctx context.Context, s zebedee.Session, teamName string) error {
+	if mock.DeleteTeamContextFunc == nil {
+		panic("ClientMock.DeleteTeamContextFunc: method is nil but Client.DeleteTeamContext was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		S        zebedee.Session
+		TeamName string
+	}{
+		Ctx:      ctx,
+		S:        s,
+		TeamName: teamName,
+	}
+	lockClientMockDeleteTeamContext.Lock()
+	mock.calls.DeleteTeamContext = append(mock.calls.DeleteTeamContext, callInfo)
+	lockClientMockDeleteTeamContext.Unlock()
+	return mock.DeleteTeamContextFunc(ctx, s, teamName)
+}
+
+// DeleteTeamContextCalls gets all the calls that were made to DeleteTeamContext.
+// Check the length with:
+//
+//	len(mockedClient.DeleteTeamContextCalls())
+func (mock *ClientMock) DeleteTeamContextCalls() []struct {
+	Ctx      context.Context
+	S        zebedee.Session
+	TeamName string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		S        zebedee.Session
+		TeamName string
+	}
+	lockClientMockDeleteTeamContext.RLock()
+	calls = mock.calls.DeleteTeamContext
+	lockClientMockDeleteTeamContext.RUnlock()
+	return calls
+}
+
+// DeleteUser calls DeleteUserFunc.
+func (mock *ClientMock) DeleteUser(s zebedee.Session, email string) error {
+	if mock.DeleteUserFunc == nil {
+		panic("ClientMock.DeleteUserFunc: method is nil but Client.DeleteUser was just called")
+	}
+	callInfo := struct {
+		S     zebedee.Session
+		Email string
+	}{
+		S:     s,
+		Email: email,
+	}
+	lockClientMockDeleteUser.Lock()
+	mock.calls.DeleteUser = append(mock.calls.DeleteUser, callInfo)
+	lockClientMockDeleteUser.Unlock()
+	return mock.DeleteUserFunc(s, email)
+}
+
+// DeleteUserCalls gets all the calls that were made to DeleteUser.
+// Check the length with:
+//
+//	len(mockedClient.DeleteUserCalls())
+func (mock *ClientMock) DeleteUserCalls() []struct {
+	S     zebedee.Session
+	Email string
+} {
+	var calls []struct {
+		S     zebedee.Session
+		Email string
+	}
+	lockClientMockDeleteUser.RLock()
+	calls = mock.calls.DeleteUser
+	lockClientMockDeleteUser.RUnlock()
+	return calls
+}
+
+// DeleteUserContext calls DeleteUserContextFunc.
+func (mock *ClientMock) DeleteUserContext(ctx context.Context, s zebedee.Session, email string) error {
+	if mock.DeleteUserContextFunc == nil {
+		panic("ClientMock.DeleteUserContextFunc: method is nil but Client.DeleteUserContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		S     zebedee.Session
+		Email string
+	}{
+		Ctx:   ctx,
+		S:     s,
+		Email: email,
+	}
+	lockClientMockDeleteUserContext.Lock()
+	mock.calls.DeleteUserContext = append(mock.calls.DeleteUserContext, callInfo)
+	lockClientMockDeleteUserContext.Unlock()
+	return mock.DeleteUserContextFunc(ctx, s, email)
+}
+
+// DeleteUserContextCalls gets all the calls that were made to DeleteUserContext.
+// Check the length with:
+//
+//	len(mockedClient.DeleteUserContextCalls())
+func (mock *ClientMock) DeleteUserContextCalls() []struct {
+	Ctx   context.Context
+	S     zebedee.Session
+	Email string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		S     zebedee.Session
+		Email string
+	}
+	lockClientMockDeleteUserContext.RLock()
+	calls = mock.calls.DeleteUserContext
+	lockClientMockDeleteUserContext.RUnlock()
+	return calls
+}
+
+// DownloadFile calls DownloadFileFunc.
+func (mock *ClientMock) DownloadFile(s zebedee.Session, collectionID string, uri string) (io.ReadCloser, error) {
+	if mock.DownloadFileFunc == nil {
+		panic("ClientMock.DownloadFileFunc: method is nil but Client.DownloadFile was just called")
+	}
+	callInfo := struct {
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+	}{
+		S:            s,
+		CollectionID: collectionID,
+		URI:          uri,
+	}
+	lockClientMockDownloadFile.Lock()
+	mock.calls.DownloadFile = append(mock.calls.DownloadFile, callInfo)
+	lockClientMockDownloadFile.Unlock()
+	return mock.DownloadFileFunc(s, collectionID, uri)
+}
+
+// DownloadFileCalls gets all the calls that were made to DownloadFile.
+// Check the length with:
+//
+//	len(mockedClient.DownloadFileCalls())
+func (mock *ClientMock) DownloadFileCalls() []struct {
+	S            zebedee.Session
+	CollectionID string
+	URI          string
+} {
+	var calls []struct {
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+	}
+	lockClientMockDownloadFile.RLock()
+	calls = mock.calls.DownloadFile
+	lockClientMockDownloadFile.RUnlock()
+	return calls
+}
+
+// DownloadFileContext calls DownloadFileContextFunc.
+func (mock *ClientMock) DownloadFileContext(ctx context.Context, s zebedee.Session, collectionID string, uri string) (io.ReadCloser, error) {
+	if mock.DownloadFileContextFunc == nil {
+		panic("ClientMock.DownloadFileContextFunc: method is nil but Client.DownloadFileContext was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+	}{
+		Ctx:          ctx,
+		S:            s,
+		CollectionID: collectionID,
+		URI:          uri,
+	}
+	lockClientMockDownloadFileContext.Lock()
+	mock.calls.DownloadFileContext = append(mock.calls.DownloadFileContext, callInfo)
+	lockClientMockDownloadFileContext.Unlock()
+	return mock.DownloadFileContextFunc(ctx, s, collectionID, uri)
+}
+
+// DownloadFileContextCalls gets all the calls that were made to DownloadFileContext.
+// Check the length with:
+//
+//	len(mockedClient.DownloadFileContextCalls())
+func (mock *ClientMock) DownloadFileContextCalls() []struct {
+	Ctx          context.Context
+	S            zebedee.Session
+	CollectionID string
+	URI          string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+	}
+	lockClientMockDownloadFileContext.RLock()
+	calls = mock.calls.DownloadFileContext
+	lockClientMockDownloadFileContext.RUnlock()
+	return calls
+}
+
+// GetCollectionByID calls GetCollectionByIDFunc.
+func (mock *ClientMock) GetCollectionByID(s zebedee.Session, id string) (zebedee.CollectionDescription, error) {
+	if mock.GetCollectionByIDFunc == nil {
+		panic("ClientMock.GetCollectionByIDFunc: method is nil but Client.GetCollectionByID was just called")
+	}
+	callInfo := struct {
+		S  zebedee.Session
+		ID string
+	}{
+		S:  s,
+		ID: id,
+	}
+	lockClientMockGetCollectionByID.Lock()
+	mock.calls.GetCollectionByID = append(mock.calls.GetCollectionByID, callInfo)
+	lockClientMockGetCollectionByID.Unlock()
+	return mock.GetCollectionByIDFunc(s, id)
+}
+
+// GetCollectionByIDCalls gets all the calls that were made to GetCollectionByID.
+// Check the length with:
+//
+//	len(mockedClient.GetCollectionByIDCalls())
+func (mock *ClientMock) GetCollectionByIDCalls() []struct {
+	S  zebedee.Session
+	ID string
+} {
+	var calls []struct {
+		S  zebedee.Session
+		ID string
+	}
+	lockClientMockGetCollectionByID.RLock()
+	calls = mock.calls.GetCollectionByID
+	lockClientMockGetCollectionByID.RUnlock()
+	return calls
+}
+
+// GetCollectionByIDContext calls GetCollectionByIDContextFunc.
+func (mock *ClientMock) GetCollectionByIDContext(ctx context.Context, s zebedee.Session, id string) (zebedee.CollectionDescription, error) {
+	if mock.GetCollectionByIDContextFunc == nil {
+		panic("ClientMock.GetCollectionByIDContextFunc: method is nil but Client.GetCollectionByIDContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}{
+		Ctx: ctx,
+		S:   s,
+		ID:  id,
+	}
+	lockClientMockGetCollectionByIDContext.Lock()
+	mock.calls.GetCollectionByIDContext = append(mock.calls.GetCollectionByIDContext, callInfo)
+	lockClientMockGetCollectionByIDContext.Unlock()
+	return mock.GetCollectionByIDContextFunc(ctx, s, id)
+}
+
+// GetCollectionByIDContextCalls gets all the calls that were made to GetCollectionByIDContext.
+// Check the length with:
+//
+//	len(mockedClient.GetCollectionByIDContextCalls())
+func (mock *ClientMock) GetCollectionByIDContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}
+	lockClientMockGetCollectionByIDContext.RLock()
+	calls = mock.calls.GetCollectionByIDContext
+	lockClientMockGetCollectionByIDContext.RUnlock()
+	return calls
+}
+
+// GetCollectionDetails calls GetCollectionDetailsFunc.
+func (mock *ClientMock) GetCollectionDetails(s zebedee.Session, id string) (zebedee.CollectionDetails, error) {
+	if mock.GetCollectionDetailsFunc == nil {
+		panic("ClientMock.GetCollectionDetailsFunc: method is nil but Client.GetCollectionDetails was just called")
+	}
+	callInfo := struct {
+		S  zebedee.Session
+		ID string
+	}{
+		S:  s,
+		ID: id,
+	}
+	lockClientMockGetCollectionDetails.Lock()
+	mock.calls.GetCollectionDetails = append(mock.calls.GetCollectionDetails, callInfo)
+	lockClientMockGetCollectionDetails.Unlock()
+	return mock.GetCollectionDetailsFunc(s, id)
+}
+
+// GetCollectionDetailsCalls gets all the calls that were made to GetCollectionDetails.
+// Check the length with:
+//
+//	len(mockedClient.GetCollectionDetailsCalls())
+func (mock *ClientMock) GetCollectionDetailsCalls() []struct {
+	S  zebedee.Session
+	ID string
+} {
+	var calls []struct {
+		S  zebedee.Session
+		ID string
+	}
+	lockClientMockGetCollectionDetails.RLock()
+	calls = mock.calls.GetCollectionDetails
+	lockClientMockGetCollectionDetails.RUnlock()
+	return calls
+}
+
+// GetCollectionDetailsContext calls GetCollectionDetailsContextFunc.
+func (mock *ClientMock) GetCollectionDetailsContext(ctx context.Context, s zebedee.Session, id string) (zebedee.CollectionDetails, error) {
+	if mock.GetCollectionDetailsContextFunc == nil {
+		panic("ClientMock.GetCollectionDetailsContextFunc: method is nil but Client.GetCollectionDetailsContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}{
+		Ctx: ctx,
+		S:   s,
+		ID:  id,
+	}
+	lockClientMockGetCollectionDetailsContext.Lock()
+	mock.calls.GetCollectionDetailsContext = append(mock.calls.GetCollectionDetailsContext, callInfo)
+	lockClientMockGetCollectionDetailsContext.Unlock()
+	return mock.GetCollectionDetailsContextFunc(ctx, s, id)
+}
+
+// GetCollectionDetailsContextCalls gets all the calls that were made to GetCollectionDetailsContext.
+// Check the length with:
+//
+//	len(mockedClient.GetCollectionDetailsContextCalls())
+func (mock *ClientMock) GetCollectionDetailsContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}
+	lockClientMockGetCollectionDetailsContext.RLock()
+	calls = mock.calls.GetCollectionDetailsContext
+	lockClientMockGetCollectionDetailsContext.RUnlock()
+	return calls
+}
+
+// GetCollections calls GetCollectionsFunc.
+func (mock *ClientMock) GetCollections(s zebedee.Session) ([]zebedee.CollectionDescription, error) {
+	if mock.GetCollectionsFunc == nil {
+		panic("ClientMock.GetCollectionsFunc: method is nil but Client.GetCollections was just called")
+	}
+	callInfo := struct {
+		S zebedee.Session
+	}{
+		S: s,
+	}
+	lockClientMockGetCollections.Lock()
+	mock.calls.GetCollections = append(mock.calls.GetCollections, callInfo)
+	lockClientMockGetCollections.Unlock()
+	return mock.GetCollectionsFunc(s)
+}
+
+// GetCollectionsCalls gets all the calls that were made to GetCollections.
+// Check the length with:
+//
+//	len(mockedClient.GetCollectionsCalls())
+func (mock *ClientMock) GetCollectionsCalls() []struct {
+	S zebedee.Session
+} {
+	var calls []struct {
+		S zebedee.Session
+	}
+	lockClientMockGetCollections.RLock()
+	calls = mock.calls.GetCollections
+	lockClientMockGetCollections.RUnlock()
+	return calls
+}
+
+// GetCollectionsContext calls GetCollectionsContextFunc.
+func (mock *ClientMock) GetCollectionsContext(ctx context.Context, s zebedee.Session) ([]zebedee.CollectionDescription, error) {
+	if mock.GetCollectionsContextFunc == nil {
+		panic("ClientMock.GetCollectionsContextFunc: method is nil but Client.GetCollectionsContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+	}{
+		Ctx: ctx,
+		S:   s,
+	}
+	lockClientMockGetCollectionsContext.Lock()
+	mock.calls.GetCollectionsContext = append(mock.calls.GetCollectionsContext, callInfo)
+	lockClientMockGetCollectionsContext.Unlock()
+	return mock.GetCollectionsContextFunc(ctx, s)
+}
+
+// GetCollectionsContextCalls gets all the calls that were made to GetCollectionsContext.
+// Check the length with:
+//
+//	len(mockedClient.GetCollectionsContextCalls())
+func (mock *ClientMock) GetCollectionsContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+	}
+	lockClientMockGetCollectionsContext.RLock()
+	calls = mock.calls.GetCollectionsContext
+	lockClientMockGetCollectionsContext.RUnlock()
+	return calls
+}
+
+// GetContent calls GetContentFunc.
+func (mock *ClientMock) GetContent(s zebedee.Session, collectionName string, uri string) ([]byte, error) {
+	if mock.GetContentFunc == nil {
+		panic("ClientMock.GetContentFunc: method is nil but Client.GetContent was just called")
+	}
+	callInfo := struct {
+		S              zebedee.Session
+		CollectionName string
+		URI            string
+	}{
+		S:              s,
+		CollectionName: collectionName,
+		URI:            uri,
+	}
+	lockClientMockGetContent.Lock()
+	mock.calls.GetContent = append(mock.calls.GetContent, callInfo)
+	lockClientMockGetContent.Unlock()
+	return mock.GetContentFunc(s, collectionName, uri)
+}
+
+// GetContentCalls gets all the calls that were made to GetContent.
+// Check the length with:
+//
+//	len(mockedClient.GetContentCalls())
+func (mock *ClientMock) GetContentCalls() []struct {
+	S              zebedee.Session
+	CollectionName string
+	URI            string
+} {
+	var calls []struct {
+		S              zebedee.Session
+		CollectionName string
+		URI            string
+	}
+	lockClientMockGetContent.RLock()
+	calls = mock.calls.GetContent
+	lockClientMockGetContent.RUnlock()
+	return calls
+}
+
+// GetContentContext calls GetContentContextFunc.
+func (mock *ClientMock) GetContentContext(ctx context.Context, s zebedee.Session, collectionName string, uri string) ([]byte, error) {
+	if mock.GetContentContextFunc == nil {
+		panic("ClientMock.GetContentContextFunc: method is nil but Client.GetContentContext was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		S              zebedee.Session
+		CollectionName string
+		URI            string
+	}{
+		Ctx:            ctx,
+		S:              s,
+		CollectionName: collectionName,
+		URI:            uri,
+	}
+	lockClientMockGetContentContext.Lock()
+	mock.calls.GetContentContext = append(mock.calls.GetContentContext, callInfo)
+	lockClientMockGetContentContext.Unlock()
+	return mock.GetContentContextFunc(ctx, s, collectionName, uri)
+}
+
+// GetContentContextCalls gets all the calls that were made to GetContentContext.
+// Check the length with:
+//
+//	len(mockedClient.GetContentContextCalls())
+func (mock *ClientMock) GetContentContextCalls() []struct {
+	Ctx            context.Context
+	S              zebedee.Session
+	CollectionName string
+	URI            string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		S              zebedee.Session
+		CollectionName string
+		URI            string
+	}
+	lockClientMockGetContentContext.RLock()
+	calls = mock.calls.GetContentContext
+	lockClientMockGetContentContext.RUnlock()
+	return calls
+}
+
+// GetContentJSON calls GetContentJSONFunc.
+func (mock *ClientMock) GetContentJSON(s zebedee.Session, collectionID string, uri string, v any) error {
+	if mock.GetContentJSONFunc == nil {
+		panic("ClientMock.GetContentJSONFunc: method is nil but Client.GetContentJSON was just called")
+	}
+	callInfo := struct {
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		V            any
+	}{
+		S:            s,
+		CollectionID: collectionID,
+		URI:          uri,
+		V:            v,
+	}
+	lockClientMockGetContentJSON.Lock()
+	mock.calls.GetContentJSON = append(mock.calls.GetContentJSON, callInfo)
+	lockClientMockGetContentJSON.Unlock()
+	return mock.GetContentJSONFunc(s, collectionID, uri, v)
+}
+
+// GetContentJSONCalls gets all the calls that were made to GetContentJSON.
+// Check the length with:
+//
+//	len(mockedClient.GetContentJSONCalls())
+func (mock *ClientMock) GetContentJSONCalls() []struct {
+	S            zebedee.Session
+	CollectionID string
+	URI          string
+	V            any
+} {
+	var calls []struct {
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		V            any
+	}
+	lockClientMockGetContentJSON.RLock()
+	calls = mock.calls.GetContentJSON
+	lockClientMockGetContentJSON.RUnlock()
+	return calls
+}
+
+// GetContentJSONContext calls GetContentJSONContextFunc.
+func (mock *ClientMock) GetContentJSONContext(ctx context.Context, s zebedee.Session, collectionID string, uri string, v any) error {
+	if mock.GetContentJSONContextFunc == nil {
+		panic("ClientMock.GetContentJSONContextFunc: method is nil but Client.GetContentJSONContext was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		V            any
+	}{
+		Ctx:          ctx,
+		S:            s,
+		CollectionID: collectionID,
+		URI:          uri,
+		V:            v,
+	}
+	lockClientMockGetContentJSONContext.Lock()
+	mock.calls.GetContentJSONContext = append(mock.calls.GetContentJSONContext, callInfo)
+	lockClientMockGetContentJSONContext.Unlock()
+	return mock.GetContentJSONContextFunc(ctx, s, collectionID, uri, v)
+}
+
+// GetContentJSONContextCalls gets all the calls that were made to GetContentJSONContext.
+// Check the length with:
+//
+//	len(mockedClient.GetContentJSONContextCalls())
+func (mock *ClientMock) GetContentJSONContextCalls() []struct {
+	Ctx          context.Context
+	S            zebedee.Session
+	CollectionID string
+	URI          string
+	V            any
+} {
+	var calls []struct {
+		Ctx          context.Context
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		V            any
+	}
+	lockClientMockGetContentJSONContext.RLock()
+	calls = mock.calls.GetContentJSONContext
+	lockClientMockGetContentJSONContext.RUnlock()
+	return calls
+}
+
+// GetContentStream calls GetContentStreamFunc.
+func (mock *ClientMock) GetContentStream(ctx context.Context, s zebedee.Session, collectionID string, uri string) (io.ReadCloser, zebedee.ContentInfo, error) {
+	if mock.GetContentStreamFunc == nil {
+		panic("ClientMock.GetContentStreamFunc: method is nil but Client.GetContentStream was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+	}{
+		Ctx:          ctx,
+		S:            s,
+		CollectionID: collectionID,
+		URI:          uri,
+	}
+	lockClientMockGetContentStream.Lock()
+	mock.calls.GetContentStream = append(mock.calls.GetContentStream, callInfo)
+	lockClientMockGetContentStream.Unlock()
+	return mock.GetContentStreamFunc(ctx, s, collectionID, uri)
+}
+
+// GetContentStreamCalls gets all the calls that were made to GetContentStream.
+// Check the length with:
+//
+//	len(mockedClient.GetContentStreamCalls())
+func (mock *ClientMock) GetContentStreamCalls() []struct {
+	Ctx          context.Context
+	S            zebedee.Session
+	CollectionID string
+	URI          string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+	}
+	lockClientMockGetContentStream.RLock()
+	calls = mock.calls.GetContentStream
+	lockClientMockGetContentStream.RUnlock()
+	return calls
+}
+
+// GetPermissions calls GetPermissionsFunc.
+func (mock *ClientMock) GetPermissions(s zebedee.Session, email string) (zebedee.Permissions, error) {
+	if mock.GetPermissionsFunc == nil {
+		panic("ClientMock.GetPermissionsFunc: method is nil but Client.GetPermissions was just called")
+	}
+	callInfo := struct {
+		S     zebedee.Session
+		Email string
+	}{
+		S:     s,
+		Email: email,
+	}
+	lockClientMockGetPermissions.Lock()
+	mock.calls.GetPermissions = append(mock.calls.GetPermissions, callInfo)
+	lockClientMockGetPermissions.Unlock()
+	return mock.GetPermissionsFunc(s, email)
+}
+
+// GetPermissionsCalls gets all the calls that were made to GetPermissions.
+// Check the length with:
+//
+//	len(mockedClient.GetPermissionsCalls())
+func (mock *ClientMock) GetPermissionsCalls() []struct {
+	S     zebedee.Session
+	Email string
+} {
+	var calls []struct {
+		S     zebedee.Session
+		Email string
+	}
+	lockClientMockGetPermissions.RLock()
+	calls = mock.calls.GetPermissions
+	lockClientMockGetPermissions.RUnlock()
+	return calls
+}
+
+// GetPermissionsContext calls GetPermissionsContextFunc.
+func (mock *ClientMock) GetPermissionsContext(ctx context.Context, s zebedee.Session, email string) (zebedee.Permissions, error) {
+	if mock.GetPermissionsContextFunc == nil {
+		panic("ClientMock.GetPermissionsContextFunc: method is nil but Client.GetPermissionsContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		S     zebedee.Session
+		Email string
+	}{
+		Ctx:   ctx,
+		S:     s,
+		Email: email,
+	}
+	lockClientMockGetPermissionsContext.Lock()
+	mock.calls.GetPermissionsContext = append(mock.calls.GetPermissionsContext, callInfo)
+	lockClientMockGetPermissionsContext.Unlock()
+	return mock.GetPermissionsContextFunc(ctx, s, email)
+}
+
+// GetPermissionsContextCalls gets all the calls that were made to GetPermissionsContext.
+// Check the length with:
+//
+//	len(mockedClient.GetPermissionsContextCalls())
+func (mock *ClientMock) GetPermissionsContextCalls() []struct {
+	Ctx   context.Context
+	S     zebedee.Session
+	Email string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		S     zebedee.Session
+		Email string
+	}
+	lockClientMockGetPermissionsContext.RLock()
+	calls = mock.calls.GetPermissionsContext
+	lockClientMockGetPermissionsContext.RUnlock()
+	return calls
+}
+
+// GetPublishedContent calls GetPublishedContentFunc.
+func (mock *ClientMock) GetPublishedContent(s zebedee.Session, uri string) ([]byte, error) {
+	if mock.GetPublishedContentFunc == nil {
+		panic("ClientMock.GetPublishedContentFunc: method is nil but Client.GetPublishedContent was just called")
+	}
+	callInfo := struct {
+		S   zebedee.Session
+		URI string
+	}{
+		S:   s,
+		URI: uri,
+	}
+	lockClientMockGetPublishedContent.Lock()
+	mock.calls.GetPublishedContent = append(mock.calls.GetPublishedContent, callInfo)
+	lockClientMockGetPublishedContent.Unlock()
+	return mock.GetPublishedContentFunc(s, uri)
+}
+
+// GetPublishedContentCalls gets all the calls that were made to GetPublishedContent.
+// Check the length with:
+//
+//	len(mockedClient.GetPublishedContentCalls())
+func (mock *ClientMock) GetPublishedContentCalls() []struct {
+	S   zebedee.Session
+	URI string
+} {
+	var calls []struct {
+		S   zebedee.Session
+		URI string
+	}
+	lockClientMockGetPublishedContent.RLock()
+	calls = mock.calls.GetPublishedContent
+	lockClientMockGetPublishedContent.RUnlock()
+	return calls
+}
+
+// GetPublishedContentContext calls GetPublishedContentContextFunc.
+func (mock *ClientMock) GetPublishedContentContext(ctx context.Context, s zebedee.Session, uri string) ([]byte, error) {
+	if mock.GetPublishedContentContextFunc == nil {
+		panic("ClientMock.GetPublishedContentContextFunc: method is nil but Client.GetPublishedContentContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		URI string
+	}{
+		Ctx: ctx,
+		S:   s,
+		URI: uri,
+	}
+	lockClientMockGetPublishedContentContext.Lock()
+	mock.calls.GetPublishedContentContext = append(mock.calls.GetPublishedContentContext, callInfo)
+	lockClientMockGetPublishedContentContext.Unlock()
+	return mock.GetPublishedContentContextFunc(ctx, s, uri)
+}
+
+// GetPublishedContentContextCalls gets all the calls that were made to GetPublishedContentContext.
+// Check the length with:
+//
+//	len(mockedClient.GetPublishedContentContextCalls())
+func (mock *ClientMock) GetPublishedContentContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	URI string
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		URI string
+	}
+	lockClientMockGetPublishedContentContext.RLock()
+	calls = mock.calls.GetPublishedContentContext
+	lockClientMockGetPublishedContentContext.RUnlock()
+	return calls
+}
+
+// GetPublishedContentStream calls GetPublishedContentStreamFunc.
+func (mock *ClientMock) GetPublishedContentStream(ctx context.Context, s zebedee.Session, uri string) (io.ReadCloser, zebedee.ContentInfo, error) {
+	if mock.GetPublishedContentStreamFunc == nil {
+		panic("ClientMock.GetPublishedContentStreamFunc: method is nil but Client.GetPublishedContentStream was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		URI string
+	}{
+		Ctx: ctx,
+		S:   s,
+		URI: uri,
+	}
+	lockClientMockGetPublishedContentStream.Lock()
+	mock.calls.GetPublishedContentStream = append(mock.calls.GetPublishedContentStream, callInfo)
+	lockClientMockGetPublishedContentStream.Unlock()
+	return mock.GetPublishedContentStreamFunc(ctx, s, uri)
+}
+
+// GetPublishedContentStreamCalls gets all the calls that were made to GetPublishedContentStream.
+// Check the length with:
+//
+//	len(mockedClient.GetPublishedContentStreamCalls())
+func (mock *ClientMock) GetPublishedContentStreamCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	URI string
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		URI string
+	}
+	lockClientMockGetPublishedContentStream.RLock()
+	calls = mock.calls.GetPublishedContentStream
+	lockClientMockGetPublishedContentStream.RUnlock()
+	return calls
+}
+
+// GetPublishedData calls GetPublishedDataFunc.
+func (mock *ClientMock) GetPublishedData(s zebedee.Session, uri string) ([]byte, error) {
+	if mock.GetPublishedDataFunc == nil {
+		panic("ClientMock.GetPublishedDataFunc: method is nil but Client.GetPublishedData was just called")
+	}
+	callInfo := struct {
+		S   zebedee.Session
+		URI string
+	}{
+		S:   s,
+		URI: uri,
+	}
+	lockClientMockGetPublishedData.Lock()
+	mock.calls.GetPublishedData = append(mock.calls.GetPublishedData, callInfo)
+	lockClientMockGetPublishedData.Unlock()
+	return mock.GetPublishedDataFunc(s, uri)
+}
+
+// GetPublishedDataCalls gets all the calls that were made to GetPublishedData.
+// Check the length with:
+//
+//	len(mockedClient.GetPublishedDataCalls())
+func (mock *ClientMock) GetPublishedDataCalls() []struct {
+	S   zebedee.Session
+	URI string
+} {
+	var calls []struct {
+		S   zebedee.Session
+		URI string
+	}
+	lockClientMockGetPublishedData.RLock()
+	calls = mock.calls.GetPublishedData
+	lockClientMockGetPublishedData.RUnlock()
+	return calls
+}
+
+// GetPublishedDataContext calls GetPublishedDataContextFunc.
+func (mock *ClientMock) GetPublishedDataContext(ctx context.Context, s zebedee.Session, uri string) ([]byte, error) {
+	if mock.GetPublishedDataContextFunc == nil {
+		panic("ClientMock.GetPublishedDataContextFunc: method is nil but Client.GetPublishedDataContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		URI string
+	}{
+		Ctx: ctx,
+		S:   s,
+		URI: uri,
+	}
+	lockClientMockGetPublishedDataContext.Lock()
+	mock.calls.GetPublishedDataContext = append(mock.calls.GetPublishedDataContext, callInfo)
+	lockClientMockGetPublishedDataContext.Unlock()
+	return mock.GetPublishedDataContextFunc(ctx, s, uri)
+}
+
+// GetPublishedDataContextCalls gets all the calls that were made to GetPublishedDataContext.
+// Check the length with:
+//
+//	len(mockedClient.GetPublishedDataContextCalls())
+func (mock *ClientMock) GetPublishedDataContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	URI string
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		URI string
+	}
+	lockClientMockGetPublishedDataContext.RLock()
+	calls = mock.calls.GetPublishedDataContext
+	lockClientMockGetPublishedDataContext.RUnlock()
+	return calls
+}
+
+// GetTeam calls GetTeamFunc.
+func (mock *ClientMock) GetTeam(s zebedee.Session, teamName string) (zebedee.Team, error) {
+	if mock.GetTeamFunc == nil {
+		panic("ClientMock.GetTeamFunc: method is nil but Client.GetTeam was just called")
+	}
+	callInfo := struct {
+		S        zebedee.Session
+		TeamName string
+	}{
+		S:        s,
+		TeamName: teamName,
+	}
+	lockClientMockGetTeam.Lock()
+	mock.calls.GetTeam = append(mock.calls.GetTeam, callInfo)
+	lockClientMockGetTeam.Unlock()
+	return mock.GetTeamFunc(s, teamName)
+}
+
+// GetTeamCalls gets all the calls that were made to GetTeam.
+// Check the length with:
+//
+//	len(mockedClient.GetTeamCalls())
+func (mock *ClientMock) GetTeamCalls() []struct {
+	S        zebedee.Session
+	TeamName string
+} {
+	var calls []struct {
+		S        zebedee.Session
+		TeamName string
+	}
+	lockClientMockGetTeam.RLock()
+	calls = mock.calls.GetTeam
+	lockClientMockGetTeam.RUnlock()
+	return calls
+}
+
+// GetTeamContext calls GetTeamContextFunc.
+func (mock *ClientMock) GetTeamContext(ctx context.Context, s zebedee.Session, teamName string) (zebedee.Team, error) {
+	if mock.GetTeamContextFunc == nil {
+		panic("ClientMock.GetTeamContextFunc: method is nil but Client.GetTeamContext was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		S        zebedee.Session
+		TeamName string
+	}{
+		Ctx:      ctx,
+		S:        s,
+		TeamName: teamName,
+	}
+	lockClientMockGetTeamContext.Lock()
+	mock.calls.GetTeamContext = append(mock.calls.GetTeamContext, callInfo)
+	lockClientMockGetTeamContext.Unlock()
+	return mock.GetTeamContextFunc(ctx, s, teamName)
+}
+
+// GetTeamContextCalls gets all the calls that were made to GetTeamContext.
+// Check the length with:
+//
+//	len(mockedClient.GetTeamContextCalls())
+func (mock *ClientMock) GetTeamContextCalls() []struct {
+	Ctx      context.Context
+	S        zebedee.Session
+	TeamName string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		S        zebedee.Session
+		TeamName string
+	}
+	lockClientMockGetTeamContext.RLock()
+	calls = mock.calls.GetTeamContext
+	lockClientMockGetTeamContext.RUnlock()
+	return calls
+}
+
+// GetUser calls GetUserFunc.
+func (mock *ClientMock) GetUser(s zebedee.Session, email string) (zebedee.User, error) {
+	if mock.GetUserFunc == nil {
+		panic("ClientMock.GetUserFunc: method is nil but Client.GetUser was just called")
+	}
+	callInfo := struct {
+		S     zebedee.Session
+		Email string
+	}{
+		S:     s,
+		Email: email,
+	}
+	lockClientMockGetUser.Lock()
+	mock.calls.GetUser = append(mock.calls.GetUser, callInfo)
+	lockClientMockGetUser.Unlock()
+	return mock.GetUserFunc(s, email)
+}
+
+// GetUserCalls gets all the calls that were made to GetUser.
+// Check the length with:
+//
+//	len(mockedClient.GetUserCalls())
+func (mock *ClientMock) GetUserCalls() []struct {
+	S     zebedee.Session
+	Email string
+} {
+	var calls []struct {
+		S     zebedee.Session
+		Email string
+	}
+	lockClientMockGetUser.RLock()
+	calls = mock.calls.GetUser
+	lockClientMockGetUser.RUnlock()
+	return calls
+}
+
+// GetUserContext calls GetUserContextFunc.
+func (mock *ClientMock) GetUserContext(ctx context.Context, s zebedee.Session, email string) (zebedee.User, error) {
+	if mock.GetUserContextFunc == nil {
+		panic("ClientMock.GetUserContextFunc: method is nil but Client.GetUserContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		S     zebedee.Session
+		Email string
+	}{
+		Ctx:   ctx,
+		S:     s,
+		Email: email,
+	}
+	lockClientMockGetUserContext.Lock()
+	mock.calls.GetUserContext = append(mock.calls.GetUserContext, callInfo)
+	lockClientMockGetUserContext.Unlock()
+	return mock.GetUserContextFunc(ctx, s, email)
+}
+
+// GetUserContextCalls gets all the calls that were made to GetUserContext.
+// Check the length with:
+//
+//	len(mockedClient.GetUserContextCalls())
+func (mock *ClientMock) GetUserContextCalls() []struct {
+	Ctx   context.Context
+	S     zebedee.Session
+	Email string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		S     zebedee.Session
+		Email string
+	}
+	lockClientMockGetUserContext.RLock()
+	calls = mock.calls.GetUserContext
+	lockClientMockGetUserContext.RUnlock()
+	return calls
+}
+
+// GetUsers calls GetUsersFunc.
+func (mock *ClientMock) GetUsers(s zebedee.Session) ([]zebedee.User, error) {
+	if mock.GetUsersFunc == nil {
+		panic("ClientMock.GetUsersFunc: method is nil but Client.GetUsers was just called")
+	}
+	callInfo := struct {
+		S zebedee.Session
+	}{
+		S: s,
+	}
+	lockClientMockGetUsers.Lock()
+	mock.calls.GetUsers = append(mock.calls.GetUsers, callInfo)
+	lockClientMockGetUsers.Unlock()
+	return mock.GetUsersFunc(s)
+}
+
+// GetUsersCalls gets all the calls that were made to GetUsers.
+// Check the length with:
+//
+//	len(mockedClient.GetUsersCalls())
+func (mock *ClientMock) GetUsersCalls() []struct {
+	S zebedee.Session
+} {
+	var calls []struct {
+		S zebedee.Session
+	}
+	lockClientMockGetUsers.RLock()
+	calls = mock.calls.GetUsers
+	lockClientMockGetUsers.RUnlock()
+	return calls
+}
+
+// GetUsersContext calls GetUsersContextFunc.
+func (mock *ClientMock) GetUsersContext(ctx context.Context, s zebedee.Session) ([]zebedee.User, error) {
+	if mock.GetUsersContextFunc == nil {
+		panic("ClientMock.GetUsersContextFunc: method is nil but Client.GetUsersContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+	}{
+		Ctx: ctx,
+		S:   s,
+	}
+	lockClientMockGetUsersContext.Lock()
+	mock.calls.GetUsersContext = append(mock.calls.GetUsersContext, callInfo)
+	lockClientMockGetUsersContext.Unlock()
+	return mock.GetUsersContextFunc(ctx, s)
+}
+
+// GetUsersContextCalls gets all the calls that were made to GetUsersContext.
+// Check the length with:
+//
+//	len(mockedClient.GetUsersContextCalls())
+func (mock *ClientMock) GetUsersContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+	}
+	lockClientMockGetUsersContext.RLock()
+	calls = mock.calls.GetUsersContext
+	lockClientMockGetUsersContext.RUnlock()
+	return calls
+}
+
+// ListPublishedChildren calls ListPublishedChildrenFunc.
+func (mock *ClientMock) ListPublishedChildren(s zebedee.Session, uri string) ([]zebedee.ContentNode, error) {
+	if mock.ListPublishedChildrenFunc == nil {
+		panic("ClientMock.ListPublishedChildrenFunc: method is nil but Client.ListPublishedChildren was just called")
+	}
+	callInfo := struct {
+		S   zebedee.Session
+		URI string
+	}{
+		S:   s,
+		URI: uri,
+	}
+	lockClientMockListPublishedChildren.Lock()
+	mock.calls.ListPublishedChildren = append(mock.calls.ListPublishedChildren, callInfo)
+	lockClientMockListPublishedChildren.Unlock()
+	return mock.ListPublishedChildrenFunc(s, uri)
+}
+
+// ListPublishedChildrenCalls gets all the calls that were made to ListPublishedChildren.
+// Check the length with:
+//
+//	len(mockedClient.ListPublishedChildrenCalls())
+func (mock *ClientMock) ListPublishedChildrenCalls() []struct {
+	S   zebedee.Session
+	URI string
+} {
+	var calls []struct {
+		S   zebedee.Session
+		URI string
+	}
+	lockClientMockListPublishedChildren.RLock()
+	calls = mock.calls.ListPublishedChildren
+	lockClientMockListPublishedChildren.RUnlock()
+	return calls
+}
+
+// ListPublishedChildrenContext calls ListPublishedChildrenContextFunc.
+func (mock *ClientMock) ListPublishedChildrenContext(ctx context.Context, s zebedee.Session, uri string) ([]zebedee.ContentNode, error) {
+	if mock.ListPublishedChildrenContextFunc == nil {
+		panic("ClientMock.ListPublishedChildrenContextFunc: method is nil but Client.ListPublishedChildrenContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		URI string
+	}{
+		Ctx: ctx,
+		S:   s,
+		URI: uri,
+	}
+	lockClientMockListPublishedChildrenContext.Lock()
+	mock.calls.ListPublishedChildrenContext = append(mock.calls.ListPublishedChildrenContext, callInfo)
+	lockClientMockListPublishedChildrenContext.Unlock()
+	return mock.ListPublishedChildrenContextFunc(ctx, s, uri)
+}
+
+// ListPublishedChildrenContextCalls gets all the calls that were made to ListPublishedChildrenContext.
+// Check the length with:
+//
+//	len(mockedClient.ListPublishedChildrenContextCalls())
+func (mock *ClientMock) ListPublishedChildrenContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	URI string
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		URI string
+	}
+	lockClientMockListPublishedChildrenContext.RLock()
+	calls = mock.calls.ListPublishedChildrenContext
+	lockClientMockListPublishedChildrenContext.RUnlock()
+	return calls
+}
+
+// ListTeams calls ListTeamsFunc.
+func (mock *ClientMock) ListTeams(s zebedee.Session) (zebedee.TeamsList, error) {
+	if mock.ListTeamsFunc == nil {
+		panic("ClientMock.ListTeamsFunc: method is nil but Client.ListTeams was just called")
+	}
+	callInfo := struct {
+		S zebedee.Session
+	}{
+		S: s,
+	}
+	lockClientMockListTeams.Lock()
+	mock.calls.ListTeams = append(mock.calls.ListTeams, callInfo)
+	lockClientMockListTeams.Unlock()
+	return mock.ListTeamsFunc(s)
+}
+
+// ListTeamsCalls gets all the calls that were made to ListTeams.
+// Check the length with:
+//
+//	len(mockedClient.ListTeamsCalls())
+func (mock *ClientMock) ListTeamsCalls() []struct {
+	S zebedee.Session
+} {
+	var calls []struct {
+		S zebedee.Session
+	}
+	lockClientMockListTeams.RLock()
+	calls = mock.calls.ListTeams
+	lockClientMockListTeams.RUnlock()
+	return calls
+}
+
+// ListTeamsContext calls ListTeamsContextFunc.
+func (mock *ClientMock) ListTeamsContext(ctx context.Context, s zebedee.Session) (zebedee.TeamsList, error) {
+	if mock.ListTeamsContextFunc == nil {
+		panic("ClientMock.ListTeamsContextFunc: method is nil but Client.ListTeamsContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+	}{
+		Ctx: ctx,
+		S:   s,
+	}
+	lockClientMockListTeamsContext.Lock()
+	mock.calls.ListTeamsContext = append(mock.calls.ListTeamsContext, callInfo)
+	lockClientMockListTeamsContext.Unlock()
+	return mock.ListTeamsContextFunc(ctx, s)
+}
+
+// ListTeamsContextCalls gets all the calls that were made to ListTeamsContext.
+// Check the length with:
+//
+//	len(mockedClient.ListTeamsContextCalls())
+func (mock *ClientMock) ListTeamsContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+	}
+	lockClientMockListTeamsContext.RLock()
+	calls = mock.calls.ListTeamsContext
+	lockClientMockListTeamsContext.RUnlock()
+	return calls
+}
+
+// ListUserKeyring calls ListUserKeyringFunc.
+func (mock *ClientMock) ListUserKeyring(s zebedee.Session) ([]string, error) {
+	if mock.ListUserKeyringFunc == nil {
+		panic("ClientMock.ListUserKeyringFunc: method is nil but Client.ListUserKeyring was just called")
+	}
+	callInfo := struct {
+		S zebedee.Session
+	}{
+		S: s,
+	}
+	lockClientMockListUserKeyring.Lock()
+	mock.calls.ListUserKeyring = append(mock.calls.ListUserKeyring, callInfo)
+	lockClientMockListUserKeyring.Unlock()
+	return mock.ListUserKeyringFunc(s)
+}
+
+// ListUserKeyringCalls gets all the calls that were made to ListUserKeyring.
+// Check the length with:
+//
+//	len(mockedClient.ListUserKeyringCalls())
+func (mock *ClientMock) ListUserKeyringCalls() []struct {
+	S zebedee.Session
+} {
+	var calls []struct {
+		S zebedee.Session
+	}
+	lockClientMockListUserKeyring.RLock()
+	calls = mock.calls.ListUserKeyring
+	lockClientMockListUserKeyring.RUnlock()
+	return calls
+}
+
+// ListUserKeyringContext calls ListUserKeyringContextFunc.
+func (mock *ClientMock) ListUserKeyringContext(ctx context.Context, s zebedee.Session) ([]string, error) {
+	if mock.ListUserKeyringContextFunc == nil {
+		panic("ClientMock.ListUserKeyringContextFunc: method is nil but Client.ListUserKeyringContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+	}{
+		Ctx: ctx,
+		S:   s,
+	}
+	lockClientMockListUserKeyringContext.Lock()
+	mock.calls.ListUserKeyringContext = append(mock.calls.ListUserKeyringContext, callInfo)
+	lockClientMockListUserKeyringContext.Unlock()
+	return mock.ListUserKeyringContextFunc(ctx, s)
+}
+
+// ListUserKeyringContextCalls gets all the calls that were made to ListUserKeyringContext.
+// Check the length with:
+//
+//	len(mockedClient.ListUserKeyringContextCalls())
+func (mock *ClientMock) ListUserKeyringContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+	}
+	lockClientMockListUserKeyringContext.RLock()
+	calls = mock.calls.ListUserKeyringContext
+	lockClientMockListUserKeyringContext.RUnlock()
+	return calls
+}
+
+// OpenSession calls OpenSessionFunc.
+func (mock *ClientMock) OpenSession(c zebedee.Credentials) (zebedee.Session, error) {
+	if mock.OpenSessionFunc == nil {
+		panic("ClientMock.OpenSessionFunc: method is nil but Client.OpenSession was just called")
+	}
+	callInfo := struct {
+		C zebedee.Credentials
+	}{
+		C: c,
+	}
+	lockClientMockOpenSession.Lock()
+	mock.calls.OpenSession = append(mock.calls.OpenSession, callInfo)
+	lockClientMockOpenSession.Unlock()
+	return mock.OpenSessionFunc(c)
+}
+
+// OpenSessionCalls gets all the calls that were made to OpenSession.
+// Check the length with:
+//
+//	len(mockedClient.OpenSessionCalls())
+func (mock *ClientMock) OpenSessionCalls() []struct {
+	C zebedee.Credentials
+} {
+	var calls []struct {
+		C zebedee.Credentials
+	}
+	lockClientMockOpenSession.RLock()
+	calls = mock.calls.OpenSession
+	lockClientMockOpenSession.RUnlock()
+	return calls
+}
+
+// OpenSessionContext calls OpenSessionContextFunc.
+func (mock *ClientMock) OpenSessionContext(ctx context.Context, c zebedee.Credentials) (zebedee.Session, error) {
+	if mock.OpenSessionContextFunc == nil {
+		panic("ClientMock.OpenSessionContextFunc: method is nil but Client.OpenSessionContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		C   zebedee.Credentials
+	}{
+		Ctx: ctx,
+		C:   c,
+	}
+	lockClientMockOpenSessionContext.Lock()
+	mock.calls.OpenSessionContext = append(mock.calls.OpenSessionContext, callInfo)
+	lockClientMockOpenSessionContext.Unlock()
+	return mock.OpenSessionContextFunc(ctx, c)
+}
+
+// OpenSessionContextCalls gets all the calls that were made to OpenSessionContext.
+// Check the length with:
+//
+//	len(mockedClient.OpenSessionContextCalls())
+func (mock *ClientMock) OpenSessionContextCalls() []struct {
+	Ctx context.Context
+	C   zebedee.Credentials
+} {
+	var calls []struct {
+		Ctx context.Context
+		C   zebedee.Credentials
+	}
+	lockClientMockOpenSessionContext.RLock()
+	calls = mock.calls.OpenSessionContext
+	lockClientMockOpenSessionContext.RUnlock()
+	return calls
+}
+
+// OpenSessionJWT calls OpenSessionJWTFunc.
+func (mock *ClientMock) OpenSessionJWT(authToken string) (zebedee.Session, error) {
+	if mock.OpenSessionJWTFunc == nil {
+		panic("ClientMock.OpenSessionJWTFunc: method is nil but Client.OpenSessionJWT was just called")
+	}
+	callInfo := struct {
+		AuthToken string
+	}{
+		AuthToken: authToken,
+	}
+	lockClientMockOpenSessionJWT.Lock()
+	mock.calls.OpenSessionJWT = append(mock.calls.OpenSessionJWT, callInfo)
+	lockClientMockOpenSessionJWT.Unlock()
+	return mock.OpenSessionJWTFunc(authToken)
+}
+
+// OpenSessionJWTCalls gets all the calls that were made to OpenSessionJWT.
+// Check the length with:
+//
+//	len(mockedClient.OpenSessionJWTCalls())
+func (mock *ClientMock) OpenSessionJWTCalls() []struct {
+	AuthToken string
+} {
+	var calls []struct {
+		AuthToken string
+	}
+	lockClientMockOpenSessionJWT.RLock()
+	calls = mock.calls.OpenSessionJWT
+	lockClientMockOpenSessionJWT.RUnlock()
+	return calls
+}
+
+// OpenSessionJWTContext calls OpenSessionJWTContextFunc.
+func (mock *ClientMock) OpenSessionJWTContext(ctx context.Context, authToken string) (zebedee.Session, error) {
+	if mock.OpenSessionJWTContextFunc == nil {
+		panic("ClientMock.OpenSessionJWTContextFunc: method is nil but Client.OpenSessionJWTContext was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		AuthToken string
+	}{
+		Ctx:       ctx,
+		AuthToken: authToken,
+	}
+	lockClientMockOpenSessionJWTContext.Lock()
+	mock.calls.OpenSessionJWTContext = append(mock.calls.OpenSessionJWTContext, callInfo)
+	lockClientMockOpenSessionJWTContext.Unlock()
+	return mock.OpenSessionJWTContextFunc(ctx, authToken)
+}
+
+// OpenSessionJWTContextCalls gets all the calls that were made to OpenSessionJWTContext.
+// Check the length with:
+//
+//	len(mockedClient.OpenSessionJWTContextCalls())
+func (mock *ClientMock) OpenSessionJWTContextCalls() []struct {
+	Ctx       context.Context
+	AuthToken string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		AuthToken string
+	}
+	lockClientMockOpenSessionJWTContext.RLock()
+	calls = mock.calls.OpenSessionJWTContext
+	lockClientMockOpenSessionJWTContext.RUnlock()
+	return calls
+}
+
+// PublishCollection calls PublishCollectionFunc.
+func (mock *ClientMock) PublishCollection(s zebedee.Session, id string) error {
+	if mock.PublishCollectionFunc == nil {
+		panic("ClientMock.PublishCollectionFunc: method is nil but Client.PublishCollection was just called")
+	}
+	callInfo := struct {
+		S  zebedee.Session
+		ID string
+	}{
+		S:  s,
+		ID: id,
+	}
+	lockClientMockPublishCollection.Lock()
+	mock.calls.PublishCollection = append(mock.calls.PublishCollection, callInfo)
+	lockClientMockPublishCollection.Unlock()
+	return mock.PublishCollectionFunc(s, id)
+}
+
+// PublishCollectionCalls gets all the calls that were made to PublishCollection.
+// Check the length with:
+//
+//	len(mockedClient.PublishCollectionCalls())
+func (mock *ClientMock) PublishCollectionCalls() []struct {
+	S  zebedee.Session
+	ID string
+} {
+	var calls []struct {
+		S  zebedee.Session
+		ID string
+	}
+	lockClientMockPublishCollection.RLock()
+	calls = mock.calls.PublishCollection
+	lockClientMockPublishCollection.RUnlock()
+	return calls
+}
+
+// PublishCollectionContext calls PublishCollectionContextFunc.
+func (mock *ClientMock) PublishCollectionContext(ctx context.Context, s zebedee.Session, id string) error {
+	if mock.PublishCollectionContextFunc == nil {
+		panic("ClientMock.PublishCollectionContextFunc: method is nil but Client.PublishCollectionContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}{
+		Ctx: ctx,
+		S:   s,
+		ID:  id,
+	}
+	lockClientMockPublishCollectionContext.Lock()
+	mock.calls.PublishCollectionContext = append(mock.calls.PublishCollectionContext, callInfo)
+	lockClientMockPublishCollectionContext.Unlock()
+	return mock.PublishCollectionContextFunc(ctx, s, id)
+}
+
+// PublishCollectionContextCalls gets all the calls that were made to PublishCollectionContext.
+// Check the length with:
+//
+//	len(mockedClient.PublishCollectionContextCalls())
+func (mock *ClientMock) PublishCollectionContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}
+	lockClientMockPublishCollectionContext.RLock()
+	calls = mock.calls.PublishCollectionContext
+	lockClientMockPublishCollectionContext.RUnlock()
+	return calls
+}
+
+// RemoveTeamMember calls RemoveTeamMemberFunc.
+func (mock *ClientMock) RemoveTeamMember(s zebedee.Session, teamName string, email string) error {
+	if mock.RemoveTeamMemberFunc == nil {
+		panic("ClientMock.RemoveTeamMemberFunc: method is nil but Client.RemoveTeamMember was just called")
+	}
+	callInfo := struct {
+		S        zebedee.Session
+		TeamName string
+		Email    string
+	}{
+		S:        s,
+		TeamName: teamName,
+		Email:    email,
+	}
+	lockClientMockRemoveTeamMember.Lock()
+	mock.calls.RemoveTeamMember = append(mock.calls.RemoveTeamMember, callInfo)
+	lockClientMockRemoveTeamMember.Unlock()
+	return mock.RemoveTeamMemberFunc(s, teamName, email)
+}
+
+// RemoveTeamMemberCalls gets all the calls that were made to RemoveTeamMember.
+// Check the length with:
+//
+//	len(mockedClient.RemoveTeamMemberCalls())
+func (mock *ClientMock) RemoveTeamMemberCalls() []struct {
+	S        zebedee.Session
+	TeamName string
+	Email    string
+} {
+	var calls []struct {
+		S        zebedee.Session
+		TeamName string
+		Email    string
+	}
+	lockClientMockRemoveTeamMember.RLock()
+	calls = mock.calls.RemoveTeamMember
+	lockClientMockRemoveTeamMember.RUnlock()
+	return calls
+}
+
+// RemoveTeamMemberContext calls RemoveTeamMemberContextFunc.
+func (mock *ClientMock) RemoveTeamMemberContext(ctx context.Context, s zebedee.Session, teamName string, email string) error {
+	if mock.RemoveTeamMemberContextFunc == nil {
+		panic("ClientMock.RemoveTeamMemberContextFunc: method is nil but Client.RemoveTeamMemberContext was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		S        zebedee.Session
+		TeamName string
+		Email    string
+	}{
+		Ctx:      ctx,
+		S:        s,
+		TeamName: teamName,
+		Email:    email,
+	}
+	lockClientMockRemoveTeamMemberContext.Lock()
+	mock.calls.RemoveTeamMemberContext = append(mock.calls.RemoveTeamMemberContext, callInfo)
+	lockClientMockRemoveTeamMemberContext.Unlock()
+	return mock.RemoveTeamMemberContextFunc(ctx, s, teamName, email)
+}
+
+// RemoveTeamMemberContextCalls gets all the calls that were made to RemoveTeamMemberContext.
+// Check the length with:
+//
+//	len(mockedClient.RemoveTeamMemberContextCalls())
+func (mock *ClientMock) RemoveTeamMemberContextCalls() []struct {
+	Ctx      context.Context
+	S        zebedee.Session
+	TeamName string
+	Email    string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		S        zebedee.Session
+		TeamName string
+		Email    string
+	}
+	lockClientMockRemoveTeamMemberContext.RLock()
+	calls = mock.calls.RemoveTeamMemberContext
+	lockClientMockRemoveTeamMemberContext.RUnlock()
+	return calls
+}
+
+// ReviewCollectionContent calls ReviewCollectionContentFunc.
+func (mock *ClientMock) ReviewCollectionContent(s zebedee.Session, id string, contentUri string) error {
+	if mock.ReviewCollectionContentFunc == nil {
+		panic("ClientMock.ReviewCollectionContentFunc: method is nil but Client.ReviewCollectionContent was just called")
+	}
+	callInfo := struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}{
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+	}
+	lockClientMockReviewCollectionContent.Lock()
+	mock.calls.ReviewCollectionContent = append(mock.calls.ReviewCollectionContent, callInfo)
+	lockClientMockReviewCollectionContent.Unlock()
+	return mock.ReviewCollectionContentFunc(s, id, contentUri)
+}
+
+// ReviewCollectionContentCalls gets all the calls that were made to ReviewCollectionContent.
+// Check the length with:
+//
+//	len(mockedClient.ReviewCollectionContentCalls())
+func (mock *ClientMock) ReviewCollectionContentCalls() []struct {
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+} {
+	var calls []struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}
+	lockClientMockReviewCollectionContent.RLock()
+	calls = mock.calls.ReviewCollectionContent
+	lockClientMockReviewCollectionContent.RUnlock()
+	return calls
+}
+
+// ReviewCollectionContentContext calls ReviewCollectionContentContextFunc.
+func (mock *ClientMock) ReviewCollectionContentContext(ctx context.Context, s zebedee.Session, id string, contentUri string) error {
+	if mock.ReviewCollectionContentContextFunc == nil {
+		panic("ClientMock.ReviewCollectionContentContextFunc: method is nil but Client.ReviewCollectionContentContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}{
+		Ctx:        ctx,
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+	}
+	lockClientMockReviewCollectionContentContext.Lock()
+	mock.calls.ReviewCollectionContentContext = append(mock.calls.ReviewCollectionContentContext, callInfo)
+	lockClientMockReviewCollectionContentContext.Unlock()
+	return mock.ReviewCollectionContentContextFunc(ctx, s, id, contentUri)
+}
+
+// ReviewCollectionContentContextCalls gets all the calls that were made to ReviewCollectionContentContext.
+// Check the length with:
+//
+//	len(mockedClient.ReviewCollectionContentContextCalls())
+func (mock *ClientMock) ReviewCollectionContentContextCalls() []struct {
+	Ctx        context.Context
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}
+	lockClientMockReviewCollectionContentContext.RLock()
+	calls = mock.calls.ReviewCollectionContentContext
+	lockClientMockReviewCollectionContentContext.RUnlock()
+	return calls
+}
+
+// ReviewCollectionContentWithOptions calls ReviewCollectionContentWithOptionsFunc.
+func (mock *ClientMock) ReviewCollectionContentWithOptions(s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error {
+	if mock.ReviewCollectionContentWithOptionsFunc == nil {
+		panic("ClientMock.ReviewCollectionContentWithOptionsFunc: method is nil but Client.ReviewCollectionContentWithOptions was just called")
+	}
+	callInfo := struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Opts       zebedee.ContentOptions
+	}{
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+		Opts:       opts,
+	}
+	lockClientMockReviewCollectionContentWithOptions.Lock()
+	mock.calls.ReviewCollectionContentWithOptions = append(mock.calls.ReviewCollectionContentWithOptions, callInfo)
+	lockClientMockReviewCollectionContentWithOptions.Unlock()
+	return mock.ReviewCollectionContentWithOptionsFunc(s, id, contentUri, opts)
+}
+
+// ReviewCollectionContentWithOptionsCalls gets all the calls that were made to ReviewCollectionContentWithOptions.
+// Check the length with:
+//
+//	len(mockedClient.ReviewCollectionContentWithOptionsCalls())
+func (mock *ClientMock) ReviewCollectionContentWithOptionsCalls() []struct {
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+	Opts       zebedee.ContentOptions
+} {
+	var calls []struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Opts       zebedee.ContentOptions
+	}
+	lockClientMockReviewCollectionContentWithOptions.RLock()
+	calls = mock.calls.ReviewCollectionContentWithOptions
+	lockClientMockReviewCollectionContentWithOptions.RUnlock()
+	return calls
+}
+
+// ReviewCollectionContentWithOptionsContext calls ReviewCollectionContentWithOptionsContextFunc.
+func (mock *ClientMock) ReviewCollectionContentWithOptionsContext(ctx context.Context, s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error {
+	if mock.ReviewCollectionContentWithOptionsContextFunc == nil {
+		panic("ClientMock.ReviewCollectionContentWithOptionsContextFunc: method is nil but Client.ReviewCollectionContentWithOptionsContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Opts       zebedee.ContentOptions
+	}{
+		Ctx:        ctx,
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+		Opts:       opts,
+	}
+	lockClientMockReviewCollectionContentWithOptionsContext.Lock()
+	mock.calls.ReviewCollectionContentWithOptionsContext = append(mock.calls.ReviewCollectionContentWithOptionsContext, callInfo)
+	lockClientMockReviewCollectionContentWithOptionsContext.Unlock()
+	return mock.ReviewCollectionContentWithOptionsContextFunc(ctx, s, id, contentUri, opts)
+}
+
+// ReviewCollectionContentWithOptionsContextCalls gets all the calls that were made to ReviewCollectionContentWithOptionsContext.
+// Check the length with:
+//
+//	len(mockedClient.ReviewCollectionContentWithOptionsContextCalls())
+func (mock *ClientMock) ReviewCollectionContentWithOptionsContextCalls() []struct {
+	Ctx        context.Context
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+	Opts       zebedee.ContentOptions
+} {
+	var calls []struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Opts       zebedee.ContentOptions
+	}
+	lockClientMockReviewCollectionContentWithOptionsContext.RLock()
+	calls = mock.calls.ReviewCollectionContentWithOptionsContext
+	lockClientMockReviewCollectionContentWithOptionsContext.RUnlock()
+	return calls
+}
+
+// SetPassword calls SetPasswordFunc.
+func (mock *ClientMock) SetPassword(s zebedee.Session, c zebedee.Credentials) error {
+	if mock.SetPasswordFunc == nil {
+		panic("ClientMock.SetPasswordFunc: method is nil but Client.SetPassword was just called")
+	}
+	callInfo := struct {
+		S zebedee.Session
+		C zebedee.Credentials
+	}{
+		S: s,
+		C: c,
+	}
+	lockClientMockSetPassword.Lock()
+	mock.calls.SetPassword = append(mock.calls.SetPassword, callInfo)
+	lockClientMockSetPassword.Unlock()
+	return mock.SetPasswordFunc(s, c)
+}
+
+// SetPasswordCalls gets all the calls that were made to SetPassword.
+// Check the length with:
+//
+//	len(mockedClient.SetPasswordCalls())
+func (mock *ClientMock) SetPasswordCalls() []struct {
+	S zebedee.Session
+	C zebedee.Credentials
+} {
+	var calls []struct {
+		S zebedee.Session
+		C zebedee.Credentials
+	}
+	lockClientMockSetPassword.RLock()
+	calls = mock.calls.SetPassword
+	lockClientMockSetPassword.RUnlock()
+	return calls
+}
+
+// SetPasswordContext calls SetPasswordContextFunc.
+func (mock *ClientMock) SetPasswordContext(ctx context.Context, s zebedee.Session, c zebedee.Credentials) error {
+	if mock.SetPasswordContextFunc == nil {
+		panic("ClientMock.SetPasswordContextFunc: method is nil but Client.SetPasswordContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		C   zebedee.Credentials
+	}{
+		Ctx: ctx,
+		S:   s,
+		C:   c,
+	}
+	lockClientMockSetPasswordContext.Lock()
+	mock.calls.SetPasswordContext = append(mock.calls.SetPasswordContext, callInfo)
+	lockClientMockSetPasswordContext.Unlock()
+	return mock.SetPasswordContextFunc(ctx, s, c)
+}
+
+// SetPasswordContextCalls gets all the calls that were made to SetPasswordContext.
+// Check the length with:
+//
+//	len(mockedClient.SetPasswordContextCalls())
+func (mock *ClientMock) SetPasswordContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	C   zebedee.Credentials
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		C   zebedee.Credentials
+	}
+	lockClientMockSetPasswordContext.RLock()
+	calls = mock.calls.SetPasswordContext
+	lockClientMockSetPasswordContext.RUnlock()
+	return calls
+}
+
+// SetPermissions calls SetPermissionsFunc.
+func (mock *ClientMock) SetPermissions(s zebedee.Session, p zebedee.Permissions) error {
+	if mock.SetPermissionsFunc == nil {
+		panic("ClientMock.SetPermissionsFunc: method is nil but Client.SetPermissions was just called")
+	}
+	callInfo := struct {
+		S zebedee.Session
+		P zebedee.Permissions
+	}{
+		S: s,
+		P: p,
+	}
+	lockClientMockSetPermissions.Lock()
+	mock.calls.SetPermissions = append(mock.calls.SetPermissions, callInfo)
+	lockClientMockSetPermissions.Unlock()
+	return mock.SetPermissionsFunc(s, p)
+}
+
+// SetPermissionsCalls gets all the calls that were made to SetPermissions.
+// Check the length with:
+//
+//	len(mockedClient.SetPermissionsCalls())
+func (mock *ClientMock) SetPermissionsCalls() []struct {
+	S zebedee.Session
+	P zebedee.Permissions
+} {
+	var calls []struct {
+		S zebedee.Session
+		P zebedee.Permissions
+	}
+	lockClientMockSetPermissions.RLock()
+	calls = mock.calls.SetPermissions
+	lockClientMockSetPermissions.RUnlock()
+	return calls
+}
+
+// SetPermissionsContext calls SetPermissionsContextFunc.
+func (mock *ClientMock) SetPermissionsContext(ctx context.Context, s zebedee.Session, p zebedee.Permissions) error {
+	if mock.SetPermissionsContextFunc == nil {
+		panic("ClientMock.SetPermissionsContextFunc: method is nil but Client.SetPermissionsContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		P   zebedee.Permissions
+	}{
+		Ctx: ctx,
+		S:   s,
+		P:   p,
+	}
+	lockClientMockSetPermissionsContext.Lock()
+	mock.calls.SetPermissionsContext = append(mock.calls.SetPermissionsContext, callInfo)
+	lockClientMockSetPermissionsContext.Unlock()
+	return mock.SetPermissionsContextFunc(ctx, s, p)
+}
+
+// SetPermissionsContextCalls gets all the calls that were made to SetPermissionsContext.
+// Check the length with:
+//
+//	len(mockedClient.SetPermissionsContextCalls())
+func (mock *ClientMock) SetPermissionsContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	P   zebedee.Permissions
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		P   zebedee.Permissions
+	}
+	lockClientMockSetPermissionsContext.RLock()
+	calls = mock.calls.SetPermissionsContext
+	lockClientMockSetPermissionsContext.RUnlock()
+	return calls
+}
+
+// UnlockCollection calls UnlockCollectionFunc.
+func (mock *ClientMock) UnlockCollection(s zebedee.Session, id string) error {
+	if mock.UnlockCollectionFunc == nil {
+		panic("ClientMock.UnlockCollectionFunc: method is nil but Client.UnlockCollection was just called")
+	}
+	callInfo := struct {
+		S  zebedee.Session
+		ID string
+	}{
+		S:  s,
+		ID: id,
+	}
+	lockClientMockUnlockCollection.Lock()
+	mock.calls.UnlockCollection = append(mock.calls.UnlockCollection, callInfo)
+	lockClientMockUnlockCollection.Unlock()
+	return mock.UnlockCollectionFunc(s, id)
+}
+
+// UnlockCollectionCalls gets all the calls that were made to UnlockCollection.
+// Check the length with:
+//
+//	len(mockedClient.UnlockCollectionCalls())
+func (mock *ClientMock) UnlockCollectionCalls() []struct {
+	S  zebedee.Session
+	ID string
+} {
+	var calls []struct {
+		S  zebedee.Session
+		ID string
+	}
+	lockClientMockUnlockCollection.RLock()
+	calls = mock.calls.UnlockCollection
+	lockClientMockUnlockCollection.RUnlock()
+	return calls
+}
+
+// UnlockCollectionContext calls UnlockCollectionContextFunc.
+func (mock *ClientMock) UnlockCollectionContext(ctx context.Context, s zebedee.Session, id string) error {
+	if mock.UnlockCollectionContextFunc == nil {
+		panic("ClientMock.UnlockCollectionContextFunc: method is nil but Client.UnlockCollectionContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}{
+		Ctx: ctx,
+		S:   s,
+		ID:  id,
+	}
+	lockClientMockUnlockCollectionContext.Lock()
+	mock.calls.UnlockCollectionContext = append(mock.calls.UnlockCollectionContext, callInfo)
+	lockClientMockUnlockCollectionContext.Unlock()
+	return mock.UnlockCollectionContextFunc(ctx, s, id)
+}
+
+// UnlockCollectionContextCalls gets all the calls that were made to UnlockCollectionContext.
+// Check the length with:
+//
+//	len(mockedClient.UnlockCollectionContextCalls())
+func (mock *ClientMock) UnlockCollectionContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}
+	lockClientMockUnlockCollectionContext.RLock()
+	calls = mock.calls.UnlockCollectionContext
+	lockClientMockUnlockCollectionContext.RUnlock()
+	return calls
+}
+
+// UpdateCollection calls UpdateCollectionFunc.
+func (mock *ClientMock) UpdateCollection(s zebedee.Session, desc zebedee.CollectionDescription) error {
+	if mock.UpdateCollectionFunc == nil {
+		panic("ClientMock.UpdateCollectionFunc: method is nil but Client.UpdateCollection was just called")
+	}
+	callInfo := struct {
+		S    zebedee.Session
+		Desc zebedee.CollectionDescription
+	}{
+		S:    s,
+		Desc: desc,
+	}
+	lockClientMockUpdateCollection.Lock()
+	mock.calls.UpdateCollection = append(mock.calls.UpdateCollection, callInfo)
+	lockClientMockUpdateCollection.Unlock()
+	return mock.UpdateCollectionFunc(s, desc)
+}
+
+// UpdateCollectionCalls gets all the calls that were made to UpdateCollection.
+// Check the length with:
+//
+//	len(mockedClient.UpdateCollectionCalls())
+func (mock *ClientMock) UpdateCollectionCalls() []struct {
+	S    zebedee.Session
+	Desc zebedee.CollectionDescription
+} {
+	var calls []struct {
+		S    zebedee.Session
+		Desc zebedee.CollectionDescription
+	}
+	lockClientMockUpdateCollection.RLock()
+	calls = mock.calls.UpdateCollection
+	lockClientMockUpdateCollection.RUnlock()
+	return calls
+}
+
+// UpdateCollectionContent calls UpdateCollectionContentFunc.
+func (mock *ClientMock) UpdateCollectionContent(s zebedee.Session, id string, contentUri string, content interface{}) error {
+	if mock.UpdateCollectionContentFunc == nil {
+		panic("ClientMock.UpdateCollectionContentFunc: method is nil but Client.UpdateCollectionContent was just called")
+	}
+	callInfo := struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Content    interface{}
+	}{
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+		Content:    content,
+	}
+	lockClientMockUpdateCollectionContent.Lock()
+	mock.calls.UpdateCollectionContent = append(mock.calls.UpdateCollectionContent, callInfo)
+	lockClientMockUpdateCollectionContent.Unlock()
+	return mock.UpdateCollectionContentFunc(s, id, contentUri, content)
+}
+
+// UpdateCollectionContentCalls gets all the calls that were made to UpdateCollectionContent.
+// Check the length with:
+//
+//	len(mockedClient.UpdateCollectionContentCalls())
+func (mock *ClientMock) UpdateCollectionContentCalls() []struct {
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+	Content    interface{}
+} {
+	var calls []struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Content    interface{}
+	}
+	lockClientMockUpdateCollectionContent.RLock()
+	calls = mock.calls.UpdateCollectionContent
+	lockClientMockUpdateCollectionContent.RUnlock()
+	return calls
+}
+
+// UpdateCollectionContentContext calls UpdateCollectionContentContextFunc.
+func (mock *ClientMock) UpdateCollectionContentContext(ctx context.Context, s zebedee.Session, id string, contentUri string, content interface{}) error {
+	if mock.UpdateCollectionContentContextFunc == nil {
+		panic("ClientMock.UpdateCollectionContentContextFunc: method is nil but Client.UpdateCollectionContentContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Content    interface{}
+	}{
+		Ctx:        ctx,
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+		Content:    content,
+	}
+	lockClientMockUpdateCollectionContentContext.Lock()
+	mock.calls.UpdateCollectionContentContext = append(mock.calls.UpdateCollectionContentContext, callInfo)
+	lockClientMockUpdateCollectionContentContext.Unlock()
+	return mock.UpdateCollectionContentContextFunc(ctx, s, id, contentUri, content)
+}
+
+// UpdateCollectionContentContextCalls gets all the calls that were made to UpdateCollectionContentContext.
+// Check the length with:
+//
+//	len(mockedClient.UpdateCollectionContentContextCalls())
+func (mock *ClientMock) UpdateCollectionContentContextCalls() []struct {
+	Ctx        context.Context
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+	Content    interface{}
+} {
+	var calls []struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Content    interface{}
+	}
+	lockClientMockUpdateCollectionContentContext.RLock()
+	calls = mock.calls.UpdateCollectionContentContext
+	lockClientMockUpdateCollectionContentContext.RUnlock()
+	return calls
+}
+
+// UpdateCollectionContentWithOptions calls UpdateCollectionContentWithOptionsFunc.
+func (mock *ClientMock) UpdateCollectionContentWithOptions(s zebedee.Session, id string, contentUri string, content interface{}, opts zebedee.ContentOptions) error {
+	if mock.UpdateCollectionContentWithOptionsFunc == nil {
+		panic("ClientMock.UpdateCollectionContentWithOptionsFunc: method is nil but Client.UpdateCollectionContentWithOptions was just called")
+	}
+	callInfo := struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Content    interface{}
+		Opts       zebedee.ContentOptions
+	}{
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+		Content:    content,
+		Opts:       opts,
+	}
+	lockClientMockUpdateCollectionContentWithOptions.Lock()
+	mock.calls.UpdateCollectionContentWithOptions = append(mock.calls.UpdateCollectionContentWithOptions, callInfo)
+	lockClientMockUpdateCollectionContentWithOptions.Unlock()
+	return mock.UpdateCollectionContentWithOptionsFunc(s, id, contentUri, content, opts)
+}
+
+// UpdateCollectionContentWithOptionsCalls gets all the calls that were made to UpdateCollectionContentWithOptions.
+// Check the length with:
+//
+//	len(mockedClient.UpdateCollectionContentWithOptionsCalls())
+func (mock *ClientMock) UpdateCollectionContentWithOptionsCalls() []struct {
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+	Content    interface{}
+	Opts       zebedee.ContentOptions
+} {
+	var calls []struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Content    interface{}
+		Opts       zebedee.ContentOptions
+	}
+	lockClientMockUpdateCollectionContentWithOptions.RLock()
+	calls = mock.calls.UpdateCollectionContentWithOptions
+	lockClientMockUpdateCollectionContentWithOptions.RUnlock()
+	return calls
+}
+
+// UpdateCollectionContentWithOptionsContext calls UpdateCollectionContentWithOptionsContextFunc.
+func (mock *ClientMock) UpdateCollectionContentWithOptionsContext(ctx context.Context, s zebedee.Session, id string, contentUri string, content interface{}, opts zebedee.ContentOptions) error {
+	if mock.UpdateCollectionContentWithOptionsContextFunc == nil {
+		panic("ClientMock.UpdateCollectionContentWithOptionsContextFunc: method is nil but Client.UpdateCollectionContentWithOptionsContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Content    interface{}
+		Opts       zebedee.ContentOptions
+	}{
+		Ctx:        ctx,
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+		Content:    content,
+		Opts:       opts,
+	}
+	lockClientMockUpdateCollectionContentWithOptionsContext.Lock()
+	mock.calls.UpdateCollectionContentWithOptionsContext = append(mock.calls.UpdateCollectionContentWithOptionsContext, callInfo)
+	lockClientMockUpdateCollectionContentWithOptionsContext.Unlock()
+	return mock.UpdateCollectionContentWithOptionsContextFunc(ctx, s, id, contentUri, content, opts)
+}
+
+// UpdateCollectionContentWithOptionsContextCalls gets all the calls that were made to UpdateCollectionContentWithOptionsContext.
+// Check the length with:
+//
+//	len(mockedClient.UpdateCollectionContentWithOptionsContextCalls())
+func (mock *ClientMock) UpdateCollectionContentWithOptionsContextCalls() []struct {
+	Ctx        context.Context
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+	Content    interface{}
+	Opts       zebedee.ContentOptions
+} {
+	var calls []struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Content    interface{}
+		Opts       zebedee.ContentOptions
+	}
+	lockClientMockUpdateCollectionContentWithOptionsContext.RLock()
+	calls = mock.calls.UpdateCollectionContentWithOptionsContext
+	lockClientMockUpdateCollectionContentWithOptionsContext.RUnlock()
+	return calls
+}
+
+// UpdateCollectionContext calls UpdateCollectionContextFunc.
+func (mock *ClientMock) UpdateCollectionContext(ctx context.Context, s zebedee.Session, desc zebedee.CollectionDescription) error {
+	if mock.UpdateCollectionContextFunc == nil {
+		panic("ClientMock.UpdateCollectionContextFunc: method is nil but Client.UpdateCollectionContext was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		S    zebedee.Session
+		Desc zebedee.CollectionDescription
+	}{
+		Ctx:  ctx,
+		S:    s,
+		Desc: desc,
+	}
+	lockClientMockUpdateCollectionContext.Lock()
+	mock.calls.UpdateCollectionContext = append(mock.calls.UpdateCollectionContext, callInfo)
+	lockClientMockUpdateCollectionContext.Unlock()
+	return mock.UpdateCollectionContextFunc(ctx, s, desc)
+}
+
+// UpdateCollectionContextCalls gets all the calls that were made to UpdateCollectionContext.
+// Check the length with:
+//
+//	len(mockedClient.UpdateCollectionContextCalls())
+func (mock *ClientMock) UpdateCollectionContextCalls() []struct {
+	Ctx  context.Context
+	S    zebedee.Session
+	Desc zebedee.CollectionDescription
+} {
+	var calls []struct {
+		Ctx  context.Context
+		S    zebedee.Session
+		Desc zebedee.CollectionDescription
+	}
+	lockClientMockUpdateCollectionContext.RLock()
+	calls = mock.calls.UpdateCollectionContext
+	lockClientMockUpdateCollectionContext.RUnlock()
+	return calls
+}
+
+// UploadFile calls UploadFileFunc.
+func (mock *ClientMock) UploadFile(s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string) error {
+	if mock.UploadFileFunc == nil {
+		panic("ClientMock.UploadFileFunc: method is nil but Client.UploadFile was just called")
+	}
+	callInfo := struct {
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		R            io.Reader
+		ContentType  string
+	}{
+		S:            s,
+		CollectionID: collectionID,
+		URI:          uri,
+		R:            r,
+		ContentType:  contentType,
+	}
+	lockClientMockUploadFile.Lock()
+	mock.calls.UploadFile = append(mock.calls.UploadFile, callInfo)
+	lockClientMockUploadFile.Unlock()
+	return mock.UploadFileFunc(s, collectionID, uri, r, contentType)
+}
+
+// UploadFileCalls gets all the calls that were made to UploadFile.
+// Check the length with:
+//
+//	len(mockedClient.UploadFileCalls())
+func (mock *ClientMock) UploadFileCalls() []struct {
+	S            zebedee.Session
+	CollectionID string
+	URI          string
+	R            io.Reader
+	ContentType  string
+} {
+	var calls []struct {
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		R            io.Reader
+		ContentType  string
+	}
+	lockClientMockUploadFile.RLock()
+	calls = mock.calls.UploadFile
+	lockClientMockUploadFile.RUnlock()
+	return calls
+}
+
+// UploadFileContext calls UploadFileContextFunc.
+func (mock *ClientMock) UploadFileContext(ctx context.Context, s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string) error {
+	if mock.UploadFileContextFunc == nil {
+		panic("ClientMock.UploadFileContextFunc: method is nil but Client.UploadFileContext was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		R            io.Reader
+		ContentType  string
+	}{
+		Ctx:          ctx,
+		S:            s,
+		CollectionID: collectionID,
+		URI:          uri,
+		R:            r,
+		ContentType:  contentType,
+	}
+	lockClientMockUploadFileContext.Lock()
+	mock.calls.UploadFileContext = append(mock.calls.UploadFileContext, callInfo)
+	lockClientMockUploadFileContext.Unlock()
+	return mock.UploadFileContextFunc(ctx, s, collectionID, uri, r, contentType)
+}
+
+// UploadFileContextCalls gets all the calls that were made to UploadFileContext.
+// Check the length with:
+//
+//	len(mockedClient.UploadFileContextCalls())
+func (mock *ClientMock) UploadFileContextCalls() []struct {
+	Ctx          context.Context
+	S            zebedee.Session
+	CollectionID string
+	URI          string
+	R            io.Reader
+	ContentType  string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		R            io.Reader
+		ContentType  string
+	}
+	lockClientMockUploadFileContext.RLock()
+	calls = mock.calls.UploadFileContext
+	lockClientMockUploadFileContext.RUnlock()
+	return calls
+}
+
+// UploadFileWithOptions calls UploadFileWithOptionsFunc.
+func (mock *ClientMock) UploadFileWithOptions(s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string, opts zebedee.ContentOptions) error {
+	if mock.UploadFileWithOptionsFunc == nil {
+		panic("ClientMock.UploadFileWithOptionsFunc: method is nil but Client.UploadFileWithOptions was just called")
+	}
+	callInfo := struct {
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		R            io.Reader
+		ContentType  string
+		Opts         zebedee.ContentOptions
+	}{
+		S:            s,
+		CollectionID: collectionID,
+		URI:          uri,
+		R:            r,
+		ContentType:  contentType,
+		Opts:         opts,
+	}
+	lockClientMockUploadFileWithOptions.Lock()
+	mock.calls.UploadFileWithOptions = append(mock.calls.UploadFileWithOptions, callInfo)
+	lockClientMockUploadFileWithOptions.Unlock()
+	return mock.UploadFileWithOptionsFunc(s, collectionID, uri, r, contentType, opts)
+}
+
+// UploadFileWithOptionsCalls gets all the calls that were made to UploadFileWithOptions.
+// Check the length with:
+//
+//	len(mockedClient.UploadFileWithOptionsCalls())
+func (mock *ClientMock) UploadFileWithOptionsCalls() []struct {
+	S            zebedee.Session
+	CollectionID string
+	URI          string
+	R            io.Reader
+	ContentType  string
+	Opts         zebedee.ContentOptions
+} {
+	var calls []struct {
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		R            io.Reader
+		ContentType  string
+		Opts         zebedee.ContentOptions
+	}
+	lockClientMockUploadFileWithOptions.RLock()
+	calls = mock.calls.UploadFileWithOptions
+	lockClientMockUploadFileWithOptions.RUnlock()
+	return calls
+}
+
+// UploadFileWithOptionsContext calls UploadFileWithOptionsContextFunc.
+func (mock *ClientMock) UploadFileWithOptionsContext(ctx context.Context, s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string, opts zebedee.ContentOptions) error {
+	if mock.UploadFileWithOptionsContextFunc == nil {
+		panic("ClientMock.UploadFileWithOptionsContextFunc: method is nil but Client.UploadFileWithOptionsContext was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		R            io.Reader
+		ContentType  string
+		Opts         zebedee.ContentOptions
+	}{
+		Ctx:          ctx,
+		S:            s,
+		CollectionID: collectionID,
+		URI:          uri,
+		R:            r,
+		ContentType:  contentType,
+		Opts:         opts,
+	}
+	lockClientMockUploadFileWithOptionsContext.Lock()
+	mock.calls.UploadFileWithOptionsContext = append(mock.calls.UploadFileWithOptionsContext, callInfo)
+	lockClientMockUploadFileWithOptionsContext.Unlock()
+	return mock.UploadFileWithOptionsContextFunc(ctx, s, collectionID, uri, r, contentType, opts)
+}
+
+// UploadFileWithOptionsContextCalls gets all the calls that were made to UploadFileWithOptionsContext.
+// Check the length with:
+//
+//	len(mockedClient.UploadFileWithOptionsContextCalls())
+func (mock *ClientMock) UploadFileWithOptionsContextCalls() []struct {
+	Ctx          context.Context
+	S            zebedee.Session
+	CollectionID string
+	URI          string
+	R            io.Reader
+	ContentType  string
+	Opts         zebedee.ContentOptions
+} {
+	var calls []struct {
+		Ctx          context.Context
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		R            io.Reader
+		ContentType  string
+		Opts         zebedee.ContentOptions
+	}
+	lockClientMockUploadFileWithOptionsContext.RLock()
+	calls = mock.calls.UploadFileWithOptionsContext
+	lockClientMockUploadFileWithOptionsContext.RUnlock()
+	return calls
+}
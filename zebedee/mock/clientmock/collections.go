@@ -0,0 +1,1863 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package clientmock
+
+import (
+	"context"
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	"sync"
+)
+
+var (
+	lockCollectionsAPIMockApproveCollection                           sync.RWMutex
+	lockCollectionsAPIMockApproveCollectionContext                    sync.RWMutex
+	lockCollectionsAPIMockCompleteCollectionContent                   sync.RWMutex
+	lockCollectionsAPIMockCompleteCollectionContentContext            sync.RWMutex
+	lockCollectionsAPIMockCompleteCollectionContentWithOptions        sync.RWMutex
+	lockCollectionsAPIMockCompleteCollectionContentWithOptionsContext sync.RWMutex
+	lockCollectionsAPIMockCreateCollection                            sync.RWMutex
+	lockCollectionsAPIMockCreateCollectionContext                     sync.RWMutex
+	lockCollectionsAPIMockDeleteCollection                            sync.RWMutex
+	lockCollectionsAPIMockDeleteCollectionContent                     sync.RWMutex
+	lockCollectionsAPIMockDeleteCollectionContentContext              sync.RWMutex
+	lockCollectionsAPIMockDeleteCollectionContext                     sync.RWMutex
+	lockCollectionsAPIMockGetCollectionByID                           sync.RWMutex
+	lockCollectionsAPIMockGetCollectionByIDContext                    sync.RWMutex
+	lockCollectionsAPIMockGetCollectionDetails                        sync.RWMutex
+	lockCollectionsAPIMockGetCollectionDetailsContext                 sync.RWMutex
+	lockCollectionsAPIMockGetCollections                              sync.RWMutex
+	lockCollectionsAPIMockGetCollectionsContext                       sync.RWMutex
+	lockCollectionsAPIMockPublishCollection                           sync.RWMutex
+	lockCollectionsAPIMockPublishCollectionContext                    sync.RWMutex
+	lockCollectionsAPIMockReviewCollectionContent                     sync.RWMutex
+	lockCollectionsAPIMockReviewCollectionContentContext              sync.RWMutex
+	lockCollectionsAPIMockReviewCollectionContentWithOptions          sync.RWMutex
+	lockCollectionsAPIMockReviewCollectionContentWithOptionsContext   sync.RWMutex
+	lockCollectionsAPIMockUnlockCollection                            sync.RWMutex
+	lockCollectionsAPIMockUnlockCollectionContext                     sync.RWMutex
+	lockCollectionsAPIMockUpdateCollection                            sync.RWMutex
+	lockCollectionsAPIMockUpdateCollectionContent                     sync.RWMutex
+	lockCollectionsAPIMockUpdateCollectionContentContext              sync.RWMutex
+	lockCollectionsAPIMockUpdateCollectionContentWithOptions          sync.RWMutex
+	lockCollectionsAPIMockUpdateCollectionContentWithOptionsContext   sync.RWMutex
+	lockCollectionsAPIMockUpdateCollectionContext                     sync.RWMutex
+)
+
+// Ensure, that CollectionsAPIMock does implement CollectionsAPI.
+// If this is not the case, regenerate this file with moq.
+var _ zebedee.CollectionsAPI = &CollectionsAPIMock{}
+
+// CollectionsAPIMock is a mock implementation of CollectionsAPI.
+//
+//	    func TestSomethingThatUsesCollectionsAPI(t *testing.T) {
+//
+//	        // make and configure a mocked CollectionsAPI
+//	        mockedCollectionsAPI := &CollectionsAPIMock{
+//	            ApproveCollectionFunc: func(s zebedee.Session, id string) error {
+//		               panic("mock out the ApproveCollection method")
+//	            },
+//	            ApproveCollectionContextFunc: func(ctx context.Context, s zebedee.Session, id string) error {
+//		               panic("mock out the ApproveCollectionContext method")
+//	            },
+//	            CompleteCollectionContentFunc: func(s zebedee.Session, id string, contentUri string) error {
+//		               panic("mock out the CompleteCollectionContent method")
+//	            },
+//	            CompleteCollectionContentContextFunc: func(ctx context.Context, s zebedee.Session, id string, contentUri string) error {
+//		               panic("mock out the CompleteCollectionContentContext method")
+//	            },
+//	            CompleteCollectionContentWithOptionsFunc: func(s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error {
+//		               panic("mock out the CompleteCollectionContentWithOptions method")
+//	            },
+//	            CompleteCollectionContentWithOptionsContextFunc: func(ctx context.Context, s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error {
+//		               panic("mock out the CompleteCollectionContentWithOptionsContext method")
+//	            },
+//	            CreateCollectionFunc: func(s zebedee.Session, desc zebedee.CollectionDescription) (zebedee.CollectionDescription, error) {
+//		               panic("mock out the CreateCollection method")
+//	            },
+//	            CreateCollectionContextFunc: func(ctx context.Context, s zebedee.Session, desc zebedee.CollectionDescription) (zebedee.CollectionDescription, error) {
+//		               panic("mock out the CreateCollectionContext method")
+//	            },
+//	            DeleteCollectionFunc: func(s zebedee.Session, id string) error {
+//		               panic("mock out the DeleteCollection method")
+//	            },
+//	            DeleteCollectionContentFunc: func(s zebedee.Session, id string, contentUri string) error {
+//		               panic("mock out the DeleteCollectionContent method")
+//	            },
+//	            DeleteCollectionContentContextFunc: func(ctx context.Context, s zebedee.Session, id string, contentUri string) error {
+//		               panic("mock out the DeleteCollectionContentContext method")
+//	            },
+//	            DeleteCollectionContextFunc: func(ctx context.Context, s zebedee.Session, id string) error {
+//		               panic("mock out the DeleteCollectionContext method")
+//	            },
+//	            GetCollectionByIDFunc: func(s zebedee.Session, id string) (zebedee.CollectionDescription, error) {
+//		               panic("mock out the GetCollectionByID method")
+//	            },
+//	            GetCollectionByIDContextFunc: func(ctx context.Context, s zebedee.Session, id string) (zebedee.CollectionDescription, error) {
+//		               panic("mock out the GetCollectionByIDContext method")
+//	            },
+//	            GetCollectionDetailsFunc: func(s zebedee.Session, id string) (zebedee.CollectionDetails, error) {
+//		               panic("mock out the GetCollectionDetails method")
+//	            },
+//	            GetCollectionDetailsContextFunc: func(ctx context.Context, s zebedee.Session, id string) (zebedee.CollectionDetails, error) {
+//		               panic("mock out the GetCollectionDetailsContext method")
+//	            },
+//	            GetCollectionsFunc: func(s zebedee.Session) ([]zebedee.CollectionDescription, error) {
+//		               panic("mock out the GetCollections method")
+//	            },
+//	            GetCollectionsContextFunc: func(ctx context.Context, s zebedee.Session) ([]zebedee.CollectionDescription, error) {
+//		               panic("mock out the GetCollectionsContext method")
+//	            },
+//	            PublishCollectionFunc: func(s zebedee.Session, id string) error {
+//		               panic("mock out the PublishCollection method")
+//	            },
+//	            PublishCollectionContextFunc: func(ctx context.Context, s zebedee.Session, id string) error {
+//		               panic("mock out the PublishCollectionContext method")
+//	            },
+//	            ReviewCollectionContentFunc: func(s zebedee.Session, id string, contentUri string) error {
+//		               panic("mock out the ReviewCollectionContent method")
+//	            },
+//	            ReviewCollectionContentContextFunc: func(ctx context.Context, s zebedee.Session, id string, contentUri string) error {
+//		               panic("mock out the ReviewCollectionContentContext method")
+//	            },
+//	            ReviewCollectionContentWithOptionsFunc: func(s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error {
+//		               panic("mock out the ReviewCollectionContentWithOptions method")
+//	            },
+//	            ReviewCollectionContentWithOptionsContextFunc: func(ctx context.Context, s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error {
+//		               panic("mock out the ReviewCollectionContentWithOptionsContext method")
+//	            },
+//	            UnlockCollectionFunc: func(s zebedee.Session, id string) error {
+//		               panic("mock out the UnlockCollection method")
+//	            },
+//	            UnlockCollectionContextFunc: func(ctx context.Context, s zebedee.Session, id string) error {
+//		               panic("mock out the UnlockCollectionContext method")
+//	            },
+//	            UpdateCollectionFunc: func(s zebedee.Session, desc zebedee.CollectionDescription) error {
+//		               panic("mock out the UpdateCollection method")
+//	            },
+//	            UpdateCollectionContentFunc: func(s zebedee.Session, id string, contentUri string, content interface{}) error {
+//		               panic("mock out the UpdateCollectionContent method")
+//	            },
+//	            UpdateCollectionContentContextFunc: func(ctx context.Context, s zebedee.Session, id string, contentUri string, content interface{}) error {
+//		               panic("mock out the UpdateCollectionContentContext method")
+//	            },
+//	            UpdateCollectionContentWithOptionsFunc: func(s zebedee.Session, id string, contentUri string, content interface{}, opts zebedee.ContentOptions) error {
+//		               panic("mock out the UpdateCollectionContentWithOptions method")
+//	            },
+//	            UpdateCollectionContentWithOptionsContextFunc: func(ctx context.Context, s zebedee.Session, id string, contentUri string, content interface{}, opts zebedee.ContentOptions) error {
+//		               panic("mock out the UpdateCollectionContentWithOptionsContext method")
+//	            },
+//	            UpdateCollectionContextFunc: func(ctx context.Context, s zebedee.Session, desc zebedee.CollectionDescription) error {
+//		               panic("mock out the UpdateCollectionContext method")
+//	            },
+//	        }
+//
+//	        // use mockedCollectionsAPI in code that requires CollectionsAPI
+//	        // and then make assertions.
+//
+//	    }
+type CollectionsAPIMock struct {
+	// ApproveCollectionFunc mocks the ApproveCollection method.
+	ApproveCollectionFunc func(s zebedee.Session, id string) error
+
+	// ApproveCollectionContextFunc mocks the ApproveCollectionContext method.
+	ApproveCollectionContextFunc func(ctx context.Context, s zebedee.Session, id string) error
+
+	// CompleteCollectionContentFunc mocks the CompleteCollectionContent method.
+	CompleteCollectionContentFunc func(s zebedee.Session, id string, contentUri string) error
+
+	// CompleteCollectionContentContextFunc mocks the CompleteCollectionContentContext method.
+	CompleteCollectionContentContextFunc func(ctx context.Context, s zebedee.Session, id string, contentUri string) error
+
+	// CompleteCollectionContentWithOptionsFunc mocks the CompleteCollectionContentWithOptions method.
+	CompleteCollectionContentWithOptionsFunc func(s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error
+
+	// CompleteCollectionContentWithOptionsContextFunc mocks the CompleteCollectionContentWithOptionsContext method.
+	CompleteCollectionContentWithOptionsContextFunc func(ctx context.Context, s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error
+
+	// CreateCollectionFunc mocks the CreateCollection method.
+	CreateCollectionFunc func(s zebedee.Session, desc zebedee.CollectionDescription) (zebedee.CollectionDescription, error)
+
+	// CreateCollectionContextFunc mocks the CreateCollectionContext method.
+	CreateCollectionContextFunc func(ctx context.Context, s zebedee.Session, desc zebedee.CollectionDescription) (zebedee.CollectionDescription, error)
+
+	// DeleteCollectionFunc mocks the DeleteCollection method.
+	DeleteCollectionFunc func(s zebedee.Session, id string) error
+
+	// DeleteCollectionContentFunc mocks the DeleteCollectionContent method.
+	DeleteCollectionContentFunc func(s zebedee.Session, id string, contentUri string) error
+
+	// DeleteCollectionContentContextFunc mocks the DeleteCollectionContentContext method.
+	DeleteCollectionContentContextFunc func(ctx context.Context, s zebedee.Session, id string, contentUri string) error
+
+	// DeleteCollectionContextFunc mocks the DeleteCollectionContext method.
+	DeleteCollectionContextFunc func(ctx context.Context, s zebedee.Session, id string) error
+
+	// GetCollectionByIDFunc mocks the GetCollectionByID method.
+	GetCollectionByIDFunc func(s zebedee.Session, id string) (zebedee.CollectionDescription, error)
+
+	// GetCollectionByIDContextFunc mocks the GetCollectionByIDContext method.
+	GetCollectionByIDContextFunc func(ctx context.Context, s zebedee.Session, id string) (zebedee.CollectionDescription, error)
+
+	// GetCollectionDetailsFunc mocks the GetCollectionDetails method.
+	GetCollectionDetailsFunc func(s zebedee.Session, id string) (zebedee.CollectionDetails, error)
+
+	// GetCollectionDetailsContextFunc mocks the GetCollectionDetailsContext method.
+	GetCollectionDetailsContextFunc func(ctx context.Context, s zebedee.Session, id string) (zebedee.CollectionDetails, error)
+
+	// GetCollectionsFunc mocks the GetCollections method.
+	GetCollectionsFunc func(s zebedee.Session) ([]zebedee.CollectionDescription, error)
+
+	// GetCollectionsContextFunc mocks the GetCollectionsContext method.
+	GetCollectionsContextFunc func(ctx context.Context, s zebedee.Session) ([]zebedee.CollectionDescription, error)
+
+	// PublishCollectionFunc mocks the PublishCollection method.
+	PublishCollectionFunc func(s zebedee.Session, id string) error
+
+	// PublishCollectionContextFunc mocks the PublishCollectionContext method.
+	PublishCollectionContextFunc func(ctx context.Context, s zebedee.Session, id string) error
+
+	// ReviewCollectionContentFunc mocks the ReviewCollectionContent method.
+	ReviewCollectionContentFunc func(s zebedee.Session, id string, contentUri string) error
+
+	// ReviewCollectionContentContextFunc mocks the ReviewCollectionContentContext method.
+	ReviewCollectionContentContextFunc func(ctx context.Context, s zebedee.Session, id string, contentUri string) error
+
+	// ReviewCollectionContentWithOptionsFunc mocks the ReviewCollectionContentWithOptions method.
+	ReviewCollectionContentWithOptionsFunc func(s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error
+
+	// ReviewCollectionContentWithOptionsContextFunc mocks the ReviewCollectionContentWithOptionsContext method.
+	ReviewCollectionContentWithOptionsContextFunc func(ctx context.Context, s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error
+
+	// UnlockCollectionFunc mocks the UnlockCollection method.
+	UnlockCollectionFunc func(s zebedee.Session, id string) error
+
+	// UnlockCollectionContextFunc mocks the UnlockCollectionContext method.
+	UnlockCollectionContextFunc func(ctx context.Context, s zebedee.Session, id string) error
+
+	// UpdateCollectionFunc mocks the UpdateCollection method.
+	UpdateCollectionFunc func(s zebedee.Session, desc zebedee.CollectionDescription) error
+
+	// UpdateCollectionContentFunc mocks the UpdateCollectionContent method.
+	UpdateCollectionContentFunc func(s zebedee.Session, id string, contentUri string, content interface{}) error
+
+	// UpdateCollectionContentContextFunc mocks the UpdateCollectionContentContext method.
+	UpdateCollectionContentContextFunc func(ctx context.Context, s zebedee.Session, id string, contentUri string, content interface{}) error
+
+	// UpdateCollectionContentWithOptionsFunc mocks the UpdateCollectionContentWithOptions method.
+	UpdateCollectionContentWithOptionsFunc func(s zebedee.Session, id string, contentUri string, content interface{}, opts zebedee.ContentOptions) error
+
+	// UpdateCollectionContentWithOptionsContextFunc mocks the UpdateCollectionContentWithOptionsContext method.
+	UpdateCollectionContentWithOptionsContextFunc func(ctx context.Context, s zebedee.Session, id string, contentUri string, content interface{}, opts zebedee.ContentOptions) error
+
+	// UpdateCollectionContextFunc mocks the UpdateCollectionContext method.
+	UpdateCollectionContextFunc func(ctx context.Context, s zebedee.Session, desc zebedee.CollectionDescription) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ApproveCollection holds details about calls to the ApproveCollection method.
+		ApproveCollection []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// ApproveCollectionContext holds details about calls to the ApproveCollectionContext method.
+		ApproveCollectionContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// CompleteCollectionContent holds details about calls to the CompleteCollectionContent method.
+		CompleteCollectionContent []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+		}
+		// CompleteCollectionContentContext holds details about calls to the CompleteCollectionContentContext method.
+		CompleteCollectionContentContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+		}
+		// CompleteCollectionContentWithOptions holds details about calls to the CompleteCollectionContentWithOptions method.
+		CompleteCollectionContentWithOptions []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+			// Opts is the opts argument value.
+			Opts zebedee.ContentOptions
+		}
+		// CompleteCollectionContentWithOptionsContext holds details about calls to the CompleteCollectionContentWithOptionsContext method.
+		CompleteCollectionContentWithOptionsContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+			// Opts is the opts argument value.
+			Opts zebedee.ContentOptions
+		}
+		// CreateCollection holds details about calls to the CreateCollection method.
+		CreateCollection []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// Desc is the desc argument value.
+			Desc zebedee.CollectionDescription
+		}
+		// CreateCollectionContext holds details about calls to the CreateCollectionContext method.
+		CreateCollectionContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// Desc is the desc argument value.
+			Desc zebedee.CollectionDescription
+		}
+		// DeleteCollection holds details about calls to the DeleteCollection method.
+		DeleteCollection []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// DeleteCollectionContent holds details about calls to the DeleteCollectionContent method.
+		DeleteCollectionContent []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+		}
+		// DeleteCollectionContentContext holds details about calls to the DeleteCollectionContentContext method.
+		DeleteCollectionContentContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+		}
+		// DeleteCollectionContext holds details about calls to the DeleteCollectionContext method.
+		DeleteCollectionContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// GetCollectionByID holds details about calls to the GetCollectionByID method.
+		GetCollectionByID []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// GetCollectionByIDContext holds details about calls to the GetCollectionByIDContext method.
+		GetCollectionByIDContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// GetCollectionDetails holds details about calls to the GetCollectionDetails method.
+		GetCollectionDetails []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// GetCollectionDetailsContext holds details about calls to the GetCollectionDetailsContext method.
+		GetCollectionDetailsContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// GetCollections holds details about calls to the GetCollections method.
+		GetCollections []struct {
+			// S is the s argument value.
+			S zebedee.Session
+		}
+		// GetCollectionsContext holds details about calls to the GetCollectionsContext method.
+		GetCollectionsContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+		}
+		// PublishCollection holds details about calls to the PublishCollection method.
+		PublishCollection []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// PublishCollectionContext holds details about calls to the PublishCollectionContext method.
+		PublishCollectionContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// ReviewCollectionContent holds details about calls to the ReviewCollectionContent method.
+		ReviewCollectionContent []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+		}
+		// ReviewCollectionContentContext holds details about calls to the ReviewCollectionContentContext method.
+		ReviewCollectionContentContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+		}
+		// ReviewCollectionContentWithOptions holds details about calls to the ReviewCollectionContentWithOptions method.
+		ReviewCollectionContentWithOptions []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+			// Opts is the opts argument value.
+			Opts zebedee.ContentOptions
+		}
+		// ReviewCollectionContentWithOptionsContext holds details about calls to the ReviewCollectionContentWithOptionsContext method.
+		ReviewCollectionContentWithOptionsContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+			// Opts is the opts argument value.
+			Opts zebedee.ContentOptions
+		}
+		// UnlockCollection holds details about calls to the UnlockCollection method.
+		UnlockCollection []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// UnlockCollectionContext holds details about calls to the UnlockCollectionContext method.
+		UnlockCollectionContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+		}
+		// UpdateCollection holds details about calls to the UpdateCollection method.
+		UpdateCollection []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// Desc is the desc argument value.
+			Desc zebedee.CollectionDescription
+		}
+		// UpdateCollectionContent holds details about calls to the UpdateCollectionContent method.
+		UpdateCollectionContent []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+			// Content is the content argument value.
+			Content interface{}
+		}
+		// UpdateCollectionContentContext holds details about calls to the UpdateCollectionContentContext method.
+		UpdateCollectionContentContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+			// Content is the content argument value.
+			Content interface{}
+		}
+		// UpdateCollectionContentWithOptions holds details about calls to the UpdateCollectionContentWithOptions method.
+		UpdateCollectionContentWithOptions []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+			// Content is the content argument value.
+			Content interface{}
+			// Opts is the opts argument value.
+			Opts zebedee.ContentOptions
+		}
+		// UpdateCollectionContentWithOptionsContext holds details about calls to the UpdateCollectionContentWithOptionsContext method.
+		UpdateCollectionContentWithOptionsContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// ID is the id argument value.
+			ID string
+			// ContentUri is the contentUri argument value.
+			ContentUri string
+			// Content is the content argument value.
+			Content interface{}
+			// Opts is the opts argument value.
+			Opts zebedee.ContentOptions
+		}
+		// UpdateCollectionContext holds details about calls to the UpdateCollectionContext method.
+		UpdateCollectionContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// Desc is the desc argument value.
+			Desc zebedee.CollectionDescription
+		}
+	}
+}
+
+// ApproveCollection calls ApproveCollectionFunc.
+func (mock *CollectionsAPIMock) ApproveCollection(s zebedee.Session, id string) error {
+	if mock.ApproveCollectionFunc == nil {
+		panic("CollectionsAPIMock.ApproveCollectionFunc: method is nil but CollectionsAPI.ApproveCollection was just called")
+	}
+	callInfo := struct {
+		S  zebedee.Session
+		ID string
+	}{
+		S:  s,
+		ID: id,
+	}
+	lockCollectionsAPIMockApproveCollection.Lock()
+	mock.calls.ApproveCollection = append(mock.calls.ApproveCollection, callInfo)
+	lockCollectionsAPIMockApproveCollection.Unlock()
+	return mock.ApproveCollectionFunc(s, id)
+}
+
+// ApproveCollectionCalls gets all the calls that were made to ApproveCollection.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.ApproveCollectionCalls())
+func (mock *CollectionsAPIMock) ApproveCollectionCalls() []struct {
+	S  zebedee.Session
+	ID string
+} {
+	var calls []struct {
+		S  zebedee.Session
+		ID string
+	}
+	lockCollectionsAPIMockApproveCollection.RLock()
+	calls = mock.calls.ApproveCollection
+	lockCollectionsAPIMockApproveCollection.RUnlock()
+	return calls
+}
+
+// ApproveCollectionContext calls ApproveCollectionContextFunc.
+func (mock *CollectionsAPIMock) ApproveCollectionContext(ctx context.Context, s zebedee.Session, id string) error {
+	if mock.ApproveCollectionContextFunc == nil {
+		panic("CollectionsAPIMock.ApproveCollectionContextFunc: method is nil but CollectionsAPI.ApproveCollectionContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}{
+		Ctx: ctx,
+		S:   s,
+		ID:  id,
+	}
+	lockCollectionsAPIMockApproveCollectionContext.Lock()
+	mock.calls.ApproveCollectionContext = append(mock.calls.ApproveCollectionContext, callInfo)
+	lockCollectionsAPIMockApproveCollectionContext.Unlock()
+	return mock.ApproveCollectionContextFunc(ctx, s, id)
+}
+
+// ApproveCollectionContextCalls gets all the calls that were made to ApproveCollectionContext.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.ApproveCollectionContextCalls())
+func (mock *CollectionsAPIMock) ApproveCollectionContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}
+	lockCollectionsAPIMockApproveCollectionContext.RLock()
+	calls = mock.calls.ApproveCollectionContext
+	lockCollectionsAPIMockApproveCollectionContext.RUnlock()
+	return calls
+}
+
+// CompleteCollectionContent calls CompleteCollectionContentFunc.
+func (mock *CollectionsAPIMock) CompleteCollectionContent(s zebedee.Session, id string, contentUri string) error {
+	if mock.CompleteCollectionContentFunc == nil {
+		panic("CollectionsAPIMock.CompleteCollectionContentFunc: method is nil but CollectionsAPI.CompleteCollectionContent was just called")
+	}
+	callInfo := struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}{
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+	}
+	lockCollectionsAPIMockCompleteCollectionContent.Lock()
+	mock.calls.CompleteCollectionContent = append(mock.calls.CompleteCollectionContent, callInfo)
+	lockCollectionsAPIMockCompleteCollectionContent.Unlock()
+	return mock.CompleteCollectionContentFunc(s, id, contentUri)
+}
+
+// CompleteCollectionContentCalls gets all the calls that were made to CompleteCollectionContent.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.CompleteCollectionContentCalls())
+func (mock *CollectionsAPIMock) CompleteCollectionContentCalls() []struct {
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+} {
+	var calls []struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}
+	lockCollectionsAPIMockCompleteCollectionContent.RLock()
+	calls = mock.calls.CompleteCollectionContent
+	lockCollectionsAPIMockCompleteCollectionContent.RUnlock()
+	return calls
+}
+
+// CompleteCollectionContentContext calls CompleteCollectionContentContextFunc.
+func (mock *CollectionsAPIMock) CompleteCollectionContentContext(ctx context.Context, s zebedee.Session, id string, contentUri string) error {
+	if mock.CompleteCollectionContentContextFunc == nil {
+		panic("CollectionsAPIMock.CompleteCollectionContentContextFunc: method is nil but CollectionsAPI.CompleteCollectionContentContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}{
+		Ctx:        ctx,
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+	}
+	lockCollectionsAPIMockCompleteCollectionContentContext.Lock()
+	mock.calls.CompleteCollectionContentContext = append(mock.calls.CompleteCollectionContentContext, callInfo)
+	lockCollectionsAPIMockCompleteCollectionContentContext.Unlock()
+	return mock.CompleteCollectionContentContextFunc(ctx, s, id, contentUri)
+}
+
+// CompleteCollectionContentContextCalls gets all the calls that were made to CompleteCollectionContentContext.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.CompleteCollectionContentContextCalls())
+func (mock *CollectionsAPIMock) CompleteCollectionContentContextCalls() []struct {
+	Ctx        context.Context
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}
+	lockCollectionsAPIMockCompleteCollectionContentContext.RLock()
+	calls = mock.calls.CompleteCollectionContentContext
+	lockCollectionsAPIMockCompleteCollectionContentContext.RUnlock()
+	return calls
+}
+
+// CompleteCollectionContentWithOptions calls CompleteCollectionContentWithOptionsFunc.
+func (mock *CollectionsAPIMock) CompleteCollectionContentWithOptions(s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error {
+	if mock.CompleteCollectionContentWithOptionsFunc == nil {
+		panic("CollectionsAPIMock.CompleteCollectionContentWithOptionsFunc: method is nil but CollectionsAPI.CompleteCollectionContentWithOptions was just called")
+	}
+	callInfo := struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Opts       zebedee.ContentOptions
+	}{
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+		Opts:       opts,
+	}
+	lockCollectionsAPIMockCompleteCollectionContentWithOptions.Lock()
+	mock.calls.CompleteCollectionContentWithOptions = append(mock.calls.CompleteCollectionContentWithOptions, callInfo)
+	lockCollectionsAPIMockCompleteCollectionContentWithOptions.Unlock()
+	return mock.CompleteCollectionContentWithOptionsFunc(s, id, contentUri, opts)
+}
+
+// CompleteCollectionContentWithOptionsCalls gets all the calls that were made to CompleteCollectionContentWithOptions.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.CompleteCollectionContentWithOptionsCalls())
+func (mock *CollectionsAPIMock) CompleteCollectionContentWithOptionsCalls() []struct {
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+	Opts       zebedee.ContentOptions
+} {
+	var calls []struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Opts       zebedee.ContentOptions
+	}
+	lockCollectionsAPIMockCompleteCollectionContentWithOptions.RLock()
+	calls = mock.calls.CompleteCollectionContentWithOptions
+	lockCollectionsAPIMockCompleteCollectionContentWithOptions.RUnlock()
+	return calls
+}
+
+// CompleteCollectionContentWithOptionsContext calls CompleteCollectionContentWithOptionsContextFunc.
+func (mock *CollectionsAPIMock) CompleteCollectionContentWithOptionsContext(ctx context.Context, s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error {
+	if mock.CompleteCollectionContentWithOptionsContextFunc == nil {
+		panic("CollectionsAPIMock.CompleteCollectionContentWithOptionsContextFunc: method is nil but CollectionsAPI.CompleteCollectionContentWithOptionsContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Opts       zebedee.ContentOptions
+	}{
+		Ctx:        ctx,
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+		Opts:       opts,
+	}
+	lockCollectionsAPIMockCompleteCollectionContentWithOptionsContext.Lock()
+	mock.calls.CompleteCollectionContentWithOptionsContext = append(mock.calls.CompleteCollectionContentWithOptionsContext, callInfo)
+	lockCollectionsAPIMockCompleteCollectionContentWithOptionsContext.Unlock()
+	return mock.CompleteCollectionContentWithOptionsContextFunc(ctx, s, id, contentUri, opts)
+}
+
+// CompleteCollectionContentWithOptionsContextCalls gets all the calls that were made to CompleteCollectionContentWithOptionsContext.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.CompleteCollectionContentWithOptionsContextCalls())
+func (mock *CollectionsAPIMock) CompleteCollectionContentWithOptionsContextCalls() []struct {
+	Ctx        context.Context
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+	Opts       zebedee.ContentOptions
+} {
+	var calls []struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Opts       zebedee.ContentOptions
+	}
+	lockCollectionsAPIMockCompleteCollectionContentWithOptionsContext.RLock()
+	calls = mock.calls.CompleteCollectionContentWithOptionsContext
+	lockCollectionsAPIMockCompleteCollectionContentWithOptionsContext.RUnlock()
+	return calls
+}
+
+// CreateCollection calls CreateCollectionFunc.
+func (mock *CollectionsAPIMock) CreateCollection(s zebedee.Session, desc zebedee.CollectionDescription) (zebedee.CollectionDescription, error) {
+	if mock.CreateCollectionFunc == nil {
+		panic("CollectionsAPIMock.CreateCollectionFunc: method is nil but CollectionsAPI.CreateCollection was just called")
+	}
+	callInfo := struct {
+		S    zebedee.Session
+		Desc zebedee.CollectionDescription
+	}{
+		S:    s,
+		Desc: desc,
+	}
+	lockCollectionsAPIMockCreateCollection.Lock()
+	mock.calls.CreateCollection = append(mock.calls.CreateCollection, callInfo)
+	lockCollectionsAPIMockCreateCollection.Unlock()
+	return mock.CreateCollectionFunc(s, desc)
+}
+
+// CreateCollectionCalls gets all the calls that were made to CreateCollection.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.CreateCollectionCalls())
+func (mock *CollectionsAPIMock) CreateCollectionCalls() []struct {
+	S    zebedee.Session
+	Desc zebedee.CollectionDescription
+} {
+	var calls []struct {
+		S    zebedee.Session
+		Desc zebedee.CollectionDescription
+	}
+	lockCollectionsAPIMockCreateCollection.RLock()
+	calls = mock.calls.CreateCollection
+	lockCollectionsAPIMockCreateCollection.RUnlock()
+	return calls
+}
+
+// CreateCollectionContext calls CreateCollectionContextFunc.
+func (mock *CollectionsAPIMock) CreateCollectionContext(ctx context.Context, s zebedee.Session, desc zebedee.CollectionDescription) (zebedee.CollectionDescription, error) {
+	if mock.CreateCollectionContextFunc == nil {
+		panic("CollectionsAPIMock.CreateCollectionContextFunc: method is nil but CollectionsAPI.CreateCollectionContext was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		S    zebedee.Session
+		Desc zebedee.CollectionDescription
+	}{
+		Ctx:  ctx,
+		S:    s,
+		Desc: desc,
+	}
+	lockCollectionsAPIMockCreateCollectionContext.Lock()
+	mock.calls.CreateCollectionContext = append(mock.calls.CreateCollectionContext, callInfo)
+	lockCollectionsAPIMockCreateCollectionContext.Unlock()
+	return mock.CreateCollectionContextFunc(ctx, s, desc)
+}
+
+// CreateCollectionContextCalls gets all the calls that were made to CreateCollectionContext.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.CreateCollectionContextCalls())
+func (mock *CollectionsAPIMock) CreateCollectionContextCalls() []struct {
+	Ctx  context.Context
+	S    zebedee.Session
+	Desc zebedee.CollectionDescription
+} {
+	var calls []struct {
+		Ctx  context.Context
+		S    zebedee.Session
+		Desc zebedee.CollectionDescription
+	}
+	lockCollectionsAPIMockCreateCollectionContext.RLock()
+	calls = mock.calls.CreateCollectionContext
+	lockCollectionsAPIMockCreateCollectionContext.RUnlock()
+	return calls
+}
+
+// DeleteCollection calls DeleteCollectionFunc.
+func (mock *CollectionsAPIMock) DeleteCollection(s zebedee.Session, id string) error {
+	if mock.DeleteCollectionFunc == nil {
+		panic("CollectionsAPIMock.DeleteCollectionFunc: method is nil but CollectionsAPI.DeleteCollection was just called")
+	}
+	callInfo := struct {
+		S  zebedee.Session
+		ID string
+	}{
+		S:  s,
+		ID: id,
+	}
+	lockCollectionsAPIMockDeleteCollection.Lock()
+	mock.calls.DeleteCollection = append(mock.calls.DeleteCollection, callInfo)
+	lockCollectionsAPIMockDeleteCollection.Unlock()
+	return mock.DeleteCollectionFunc(s, id)
+}
+
+// DeleteCollectionCalls gets all the calls that were made to DeleteCollection.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.DeleteCollectionCalls())
+func (mock *CollectionsAPIMock) DeleteCollectionCalls() []struct {
+	S  zebedee.Session
+	ID string
+} {
+	var calls []struct {
+		S  zebedee.Session
+		ID string
+	}
+	lockCollectionsAPIMockDeleteCollection.RLock()
+	calls = mock.calls.DeleteCollection
+	lockCollectionsAPIMockDeleteCollection.RUnlock()
+	return calls
+}
+
+// DeleteCollectionContent calls DeleteCollectionContentFunc.
+func (mock *CollectionsAPIMock) DeleteCollectionContent(s zebedee.Session, id string, contentUri string) error {
+	if mock.DeleteCollectionContentFunc == nil {
+		panic("CollectionsAPIMock.DeleteCollectionContentFunc: method is nil but CollectionsAPI.DeleteCollectionContent was just called")
+	}
+	callInfo := struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}{
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+	}
+	lockCollectionsAPIMockDeleteCollectionContent.Lock()
+	mock.calls.DeleteCollectionContent = append(mock.calls.DeleteCollectionContent, callInfo)
+	lockCollectionsAPIMockDeleteCollectionContent.Unlock()
+	return mock.DeleteCollectionContentFunc(s, id, contentUri)
+}
+
+// DeleteCollectionContentCalls gets all the calls that were made to DeleteCollectionContent.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.DeleteCollectionContentCalls())
+func (mock *CollectionsAPIMock) DeleteCollectionContentCalls() []struct {
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+} {
+	var calls []struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}
+	lockCollectionsAPIMockDeleteCollectionContent.RLock()
+	calls = mock.calls.DeleteCollectionContent
+	lockCollectionsAPIMockDeleteCollectionContent.RUnlock()
+	return calls
+}
+
+// DeleteCollectionContentContext calls DeleteCollectionContentContextFunc.
+func (mock *CollectionsAPIMock) DeleteCollectionContentContext(ctx context.Context, s zebedee.Session, id string, contentUri string) error {
+	if mock.DeleteCollectionContentContextFunc == nil {
+		panic("CollectionsAPIMock.DeleteCollectionContentContextFunc: method is nil but CollectionsAPI.DeleteCollectionContentContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}{
+		Ctx:        ctx,
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+	}
+	lockCollectionsAPIMockDeleteCollectionContentContext.Lock()
+	mock.calls.DeleteCollectionContentContext = append(mock.calls.DeleteCollectionContentContext, callInfo)
+	lockCollectionsAPIMockDeleteCollectionContentContext.Unlock()
+	return mock.DeleteCollectionContentContextFunc(ctx, s, id, contentUri)
+}
+
+// DeleteCollectionContentContextCalls gets all the calls that were made to DeleteCollectionContentContext.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.DeleteCollectionContentContextCalls())
+func (mock *CollectionsAPIMock) DeleteCollectionContentContextCalls() []struct {
+	Ctx        context.Context
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}
+	lockCollectionsAPIMockDeleteCollectionContentContext.RLock()
+	calls = mock.calls.DeleteCollectionContentContext
+	lockCollectionsAPIMockDeleteCollectionContentContext.RUnlock()
+	return calls
+}
+
+// DeleteCollectionContext calls DeleteCollectionContextFunc.
+func (mock *CollectionsAPIMock) DeleteCollectionContext(ctx context.Context, s zebedee.Session, id string) error {
+	if mock.DeleteCollectionContextFunc == nil {
+		panic("CollectionsAPIMock.DeleteCollectionContextFunc: method is nil but CollectionsAPI.DeleteCollectionContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}{
+		Ctx: ctx,
+		S:   s,
+		ID:  id,
+	}
+	lockCollectionsAPIMockDeleteCollectionContext.Lock()
+	mock.calls.DeleteCollectionContext = append(mock.calls.DeleteCollectionContext, callInfo)
+	lockCollectionsAPIMockDeleteCollectionContext.Unlock()
+	return mock.DeleteCollectionContextFunc(ctx, s, id)
+}
+
+// DeleteCollectionContextCalls gets all the calls that were made to DeleteCollectionContext.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.DeleteCollectionContextCalls())
+func (mock *CollectionsAPIMock) DeleteCollectionContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}
+	lockCollectionsAPIMockDeleteCollectionContext.RLock()
+	calls = mock.calls.DeleteCollectionContext
+	lockCollectionsAPIMockDeleteCollectionContext.RUnlock()
+	return calls
+}
+
+// GetCollectionByID calls GetCollectionByIDFunc.
+func (mock *CollectionsAPIMock) GetCollectionByID(s zebedee.Session, id string) (zebedee.CollectionDescription, error) {
+	if mock.GetCollectionByIDFunc == nil {
+		panic("CollectionsAPIMock.GetCollectionByIDFunc: method is nil but CollectionsAPI.GetCollectionByID was just called")
+	}
+	callInfo := struct {
+		S  zebedee.Session
+		ID string
+	}{
+		S:  s,
+		ID: id,
+	}
+	lockCollectionsAPIMockGetCollectionByID.Lock()
+	mock.calls.GetCollectionByID = append(mock.calls.GetCollectionByID, callInfo)
+	lockCollectionsAPIMockGetCollectionByID.Unlock()
+	return mock.GetCollectionByIDFunc(s, id)
+}
+
+// GetCollectionByIDCalls gets all the calls that were made to GetCollectionByID.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.GetCollectionByIDCalls())
+func (mock *CollectionsAPIMock) GetCollectionByIDCalls() []struct {
+	S  zebedee.Session
+	ID string
+} {
+	var calls []struct {
+		S  zebedee.Session
+		ID string
+	}
+	lockCollectionsAPIMockGetCollectionByID.RLock()
+	calls = mock.calls.GetCollectionByID
+	lockCollectionsAPIMockGetCollectionByID.RUnlock()
+	return calls
+}
+
+// GetCollectionByIDContext calls GetCollectionByIDContextFunc.
+func (mock *CollectionsAPIMock) GetCollectionByIDContext(ctx context.Context, s zebedee.Session, id string) (zebedee.CollectionDescription, error) {
+	if mock.GetCollectionByIDContextFunc == nil {
+		panic("CollectionsAPIMock.GetCollectionByIDContextFunc: method is nil but CollectionsAPI.GetCollectionByIDContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}{
+		Ctx: ctx,
+		S:   s,
+		ID:  id,
+	}
+	lockCollectionsAPIMockGetCollectionByIDContext.Lock()
+	mock.calls.GetCollectionByIDContext = append(mock.calls.GetCollectionByIDContext, callInfo)
+	lockCollectionsAPIMockGetCollectionByIDContext.Unlock()
+	return mock.GetCollectionByIDContextFunc(ctx, s, id)
+}
+
+// GetCollectionByIDContextCalls gets all the calls that were made to GetCollectionByIDContext.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.GetCollectionByIDContextCalls())
+func (mock *CollectionsAPIMock) GetCollectionByIDContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}
+	lockCollectionsAPIMockGetCollectionByIDContext.RLock()
+	calls = mock.calls.GetCollectionByIDContext
+	lockCollectionsAPIMockGetCollectionByIDContext.RUnlock()
+	return calls
+}
+
+// GetCollectionDetails calls GetCollectionDetailsFunc.
+func (mock *CollectionsAPIMock) GetCollectionDetails(s zebedee.Session, id string) (zebedee.CollectionDetails, error) {
+	if mock.GetCollectionDetailsFunc == nil {
+		panic("CollectionsAPIMock.GetCollectionDetailsFunc: method is nil but CollectionsAPI.GetCollectionDetails was just called")
+	}
+	callInfo := struct {
+		S  zebedee.Session
+		ID string
+	}{
+		S:  s,
+		ID: id,
+	}
+	lockCollectionsAPIMockGetCollectionDetails.Lock()
+	mock.calls.GetCollectionDetails = append(mock.calls.GetCollectionDetails, callInfo)
+	lockCollectionsAPIMockGetCollectionDetails.Unlock()
+	return mock.GetCollectionDetailsFunc(s, id)
+}
+
+// GetCollectionDetailsCalls gets all the calls that were made to GetCollectionDetails.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.GetCollectionDetailsCalls())
+func (mock *CollectionsAPIMock) GetCollectionDetailsCalls() []struct {
+	S  zebedee.Session
+	ID string
+} {
+	var calls []struct {
+		S  zebedee.Session
+		ID string
+	}
+	lockCollectionsAPIMockGetCollectionDetails.RLock()
+	calls = mock.calls.GetCollectionDetails
+	lockCollectionsAPIMockGetCollectionDetails.RUnlock()
+	return calls
+}
+
+// GetCollectionDetailsContext calls GetCollectionDetailsContextFunc.
+func (mock *CollectionsAPIMock) GetCollectionDetailsContext(ctx context.Context, s zebedee.Session, id string) (zebedee.CollectionDetails, error) {
+	if mock.GetCollectionDetailsContextFunc == nil {
+		panic("CollectionsAPIMock.GetCollectionDetailsContextFunc: method is nil but CollectionsAPI.GetCollectionDetailsContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}{
+		Ctx: ctx,
+		S:   s,
+		ID:  id,
+	}
+	lockCollectionsAPIMockGetCollectionDetailsContext.Lock()
+	mock.calls.GetCollectionDetailsContext = append(mock.calls.GetCollectionDetailsContext, callInfo)
+	lockCollectionsAPIMockGetCollectionDetailsContext.Unlock()
+	return mock.GetCollectionDetailsContextFunc(ctx, s, id)
+}
+
+// GetCollectionDetailsContextCalls gets all the calls that were made to GetCollectionDetailsContext.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.GetCollectionDetailsContextCalls())
+func (mock *CollectionsAPIMock) GetCollectionDetailsContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}
+	lockCollectionsAPIMockGetCollectionDetailsContext.RLock()
+	calls = mock.calls.GetCollectionDetailsContext
+	lockCollectionsAPIMockGetCollectionDetailsContext.RUnlock()
+	return calls
+}
+
+// GetCollections calls GetCollectionsFunc.
+func (mock *CollectionsAPIMock) GetCollections(s zebedee.Session) ([]zebedee.CollectionDescription, error) {
+	if mock.GetCollectionsFunc == nil {
+		panic("CollectionsAPIMock.GetCollectionsFunc: method is nil but CollectionsAPI.GetCollections was just called")
+	}
+	callInfo := struct {
+		S zebedee.Session
+	}{
+		S: s,
+	}
+	lockCollectionsAPIMockGetCollections.Lock()
+	mock.calls.GetCollections = append(mock.calls.GetCollections, callInfo)
+	lockCollectionsAPIMockGetCollections.Unlock()
+	return mock.GetCollectionsFunc(s)
+}
+
+// GetCollectionsCalls gets all the calls that were made to GetCollections.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.GetCollectionsCalls())
+func (mock *CollectionsAPIMock) GetCollectionsCalls() []struct {
+	S zebedee.Session
+} {
+	var calls []struct {
+		S zebedee.Session
+	}
+	lockCollectionsAPIMockGetCollections.RLock()
+	calls = mock.calls.GetCollections
+	lockCollectionsAPIMockGetCollections.RUnlock()
+	return calls
+}
+
+// GetCollectionsContext calls GetCollectionsContextFunc.
+func (mock *CollectionsAPIMock) GetCollectionsContext(ctx context.Context, s zebedee.Session) ([]zebedee.CollectionDescription, error) {
+	if mock.GetCollectionsContextFunc == nil {
+		panic("CollectionsAPIMock.GetCollectionsContextFunc: method is nil but CollectionsAPI.GetCollectionsContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+	}{
+		Ctx: ctx,
+		S:   s,
+	}
+	lockCollectionsAPIMockGetCollectionsContext.Lock()
+	mock.calls.GetCollectionsContext = append(mock.calls.GetCollectionsContext, callInfo)
+	lockCollectionsAPIMockGetCollectionsContext.Unlock()
+	return mock.GetCollectionsContextFunc(ctx, s)
+}
+
+// GetCollectionsContextCalls gets all the calls that were made to GetCollectionsContext.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.GetCollectionsContextCalls())
+func (mock *CollectionsAPIMock) GetCollectionsContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+	}
+	lockCollectionsAPIMockGetCollectionsContext.RLock()
+	calls = mock.calls.GetCollectionsContext
+	lockCollectionsAPIMockGetCollectionsContext.RUnlock()
+	return calls
+}
+
+// PublishCollection calls PublishCollectionFunc.
+func (mock *CollectionsAPIMock) PublishCollection(s zebedee.Session, id string) error {
+	if mock.PublishCollectionFunc == nil {
+		panic("CollectionsAPIMock.PublishCollectionFunc: method is nil but CollectionsAPI.PublishCollection was just called")
+	}
+	callInfo := struct {
+		S  zebedee.Session
+		ID string
+	}{
+		S:  s,
+		ID: id,
+	}
+	lockCollectionsAPIMockPublishCollection.Lock()
+	mock.calls.PublishCollection = append(mock.calls.PublishCollection, callInfo)
+	lockCollectionsAPIMockPublishCollection.Unlock()
+	return mock.PublishCollectionFunc(s, id)
+}
+
+// PublishCollectionCalls gets all the calls that were made to PublishCollection.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.PublishCollectionCalls())
+func (mock *CollectionsAPIMock) PublishCollectionCalls() []struct {
+	S  zebedee.Session
+	ID string
+} {
+	var calls []struct {
+		S  zebedee.Session
+		ID string
+	}
+	lockCollectionsAPIMockPublishCollection.RLock()
+	calls = mock.calls.PublishCollection
+	lockCollectionsAPIMockPublishCollection.RUnlock()
+	return calls
+}
+
+// PublishCollectionContext calls PublishCollectionContextFunc.
+func (mock *CollectionsAPIMock) PublishCollectionContext(ctx context.Context, s zebedee.Session, id string) error {
+	if mock.PublishCollectionContextFunc == nil {
+		panic("CollectionsAPIMock.PublishCollectionContextFunc: method is nil but CollectionsAPI.PublishCollectionContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}{
+		Ctx: ctx,
+		S:   s,
+		ID:  id,
+	}
+	lockCollectionsAPIMockPublishCollectionContext.Lock()
+	mock.calls.PublishCollectionContext = append(mock.calls.PublishCollectionContext, callInfo)
+	lockCollectionsAPIMockPublishCollectionContext.Unlock()
+	return mock.PublishCollectionContextFunc(ctx, s, id)
+}
+
+// PublishCollectionContextCalls gets all the calls that were made to PublishCollectionContext.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.PublishCollectionContextCalls())
+func (mock *CollectionsAPIMock) PublishCollectionContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}
+	lockCollectionsAPIMockPublishCollectionContext.RLock()
+	calls = mock.calls.PublishCollectionContext
+	lockCollectionsAPIMockPublishCollectionContext.RUnlock()
+	return calls
+}
+
+// ReviewCollectionContent calls ReviewCollectionContentFunc.
+func (mock *CollectionsAPIMock) ReviewCollectionContent(s zebedee.Session, id string, contentUri string) error {
+	if mock.ReviewCollectionContentFunc == nil {
+		panic("CollectionsAPIMock.ReviewCollectionContentFunc: method is nil but CollectionsAPI.ReviewCollectionContent was just called")
+	}
+	callInfo := struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}{
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+	}
+	lockCollectionsAPIMockReviewCollectionContent.Lock()
+	mock.calls.ReviewCollectionContent = append(mock.calls.ReviewCollectionContent, callInfo)
+	lockCollectionsAPIMockReviewCollectionContent.Unlock()
+	return mock.ReviewCollectionContentFunc(s, id, contentUri)
+}
+
+// ReviewCollectionContentCalls gets all the calls that were made to ReviewCollectionContent.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.ReviewCollectionContentCalls())
+func (mock *CollectionsAPIMock) ReviewCollectionContentCalls() []struct {
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+} {
+	var calls []struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}
+	lockCollectionsAPIMockReviewCollectionContent.RLock()
+	calls = mock.calls.ReviewCollectionContent
+	lockCollectionsAPIMockReviewCollectionContent.RUnlock()
+	return calls
+}
+
+// ReviewCollectionContentContext calls ReviewCollectionContentContextFunc.
+func (mock *CollectionsAPIMock) ReviewCollectionContentContext(ctx context.Context, s zebedee.Session, id string, contentUri string) error {
+	if mock.ReviewCollectionContentContextFunc == nil {
+		panic("CollectionsAPIMock.ReviewCollectionContentContextFunc: method is nil but CollectionsAPI.ReviewCollectionContentContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}{
+		Ctx:        ctx,
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+	}
+	lockCollectionsAPIMockReviewCollectionContentContext.Lock()
+	mock.calls.ReviewCollectionContentContext = append(mock.calls.ReviewCollectionContentContext, callInfo)
+	lockCollectionsAPIMockReviewCollectionContentContext.Unlock()
+	return mock.ReviewCollectionContentContextFunc(ctx, s, id, contentUri)
+}
+
+// ReviewCollectionContentContextCalls gets all the calls that were made to ReviewCollectionContentContext.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.ReviewCollectionContentContextCalls())
+func (mock *CollectionsAPIMock) ReviewCollectionContentContextCalls() []struct {
+	Ctx        context.Context
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+	}
+	lockCollectionsAPIMockReviewCollectionContentContext.RLock()
+	calls = mock.calls.ReviewCollectionContentContext
+	lockCollectionsAPIMockReviewCollectionContentContext.RUnlock()
+	return calls
+}
+
+// ReviewCollectionContentWithOptions calls ReviewCollectionContentWithOptionsFunc.
+func (mock *CollectionsAPIMock) ReviewCollectionContentWithOptions(s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error {
+	if mock.ReviewCollectionContentWithOptionsFunc == nil {
+		panic("CollectionsAPIMock.ReviewCollectionContentWithOptionsFunc: method is nil but CollectionsAPI.ReviewCollectionContentWithOptions was just called")
+	}
+	callInfo := struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Opts       zebedee.ContentOptions
+	}{
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+		Opts:       opts,
+	}
+	lockCollectionsAPIMockReviewCollectionContentWithOptions.Lock()
+	mock.calls.ReviewCollectionContentWithOptions = append(mock.calls.ReviewCollectionContentWithOptions, callInfo)
+	lockCollectionsAPIMockReviewCollectionContentWithOptions.Unlock()
+	return mock.ReviewCollectionContentWithOptionsFunc(s, id, contentUri, opts)
+}
+
+// ReviewCollectionContentWithOptionsCalls gets all the calls that were made to ReviewCollectionContentWithOptions.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.ReviewCollectionContentWithOptionsCalls())
+func (mock *CollectionsAPIMock) ReviewCollectionContentWithOptionsCalls() []struct {
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+	Opts       zebedee.ContentOptions
+} {
+	var calls []struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Opts       zebedee.ContentOptions
+	}
+	lockCollectionsAPIMockReviewCollectionContentWithOptions.RLock()
+	calls = mock.calls.ReviewCollectionContentWithOptions
+	lockCollectionsAPIMockReviewCollectionContentWithOptions.RUnlock()
+	return calls
+}
+
+// ReviewCollectionContentWithOptionsContext calls ReviewCollectionContentWithOptionsContextFunc.
+func (mock *CollectionsAPIMock) ReviewCollectionContentWithOptionsContext(ctx context.Context, s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error {
+	if mock.ReviewCollectionContentWithOptionsContextFunc == nil {
+		panic("CollectionsAPIMock.ReviewCollectionContentWithOptionsContextFunc: method is nil but CollectionsAPI.ReviewCollectionContentWithOptionsContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Opts       zebedee.ContentOptions
+	}{
+		Ctx:        ctx,
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+		Opts:       opts,
+	}
+	lockCollectionsAPIMockReviewCollectionContentWithOptionsContext.Lock()
+	mock.calls.ReviewCollectionContentWithOptionsContext = append(mock.calls.ReviewCollectionContentWithOptionsContext, callInfo)
+	lockCollectionsAPIMockReviewCollectionContentWithOptionsContext.Unlock()
+	return mock.ReviewCollectionContentWithOptionsContextFunc(ctx, s, id, contentUri, opts)
+}
+
+// ReviewCollectionContentWithOptionsContextCalls gets all the calls that were made to ReviewCollectionContentWithOptionsContext.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.ReviewCollectionContentWithOptionsContextCalls())
+func (mock *CollectionsAPIMock) ReviewCollectionContentWithOptionsContextCalls() []struct {
+	Ctx        context.Context
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+	Opts       zebedee.ContentOptions
+} {
+	var calls []struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Opts       zebedee.ContentOptions
+	}
+	lockCollectionsAPIMockReviewCollectionContentWithOptionsContext.RLock()
+	calls = mock.calls.ReviewCollectionContentWithOptionsContext
+	lockCollectionsAPIMockReviewCollectionContentWithOptionsContext.RUnlock()
+	return calls
+}
+
+// UnlockCollection calls UnlockCollectionFunc.
+func (mock *CollectionsAPIMock) UnlockCollection(s zebedee.Session, id string) error {
+	if mock.UnlockCollectionFunc == nil {
+		panic("CollectionsAPIMock.UnlockCollectionFunc: method is nil but CollectionsAPI.UnlockCollection was just called")
+	}
+	callInfo := struct {
+		S  zebedee.Session
+		ID string
+	}{
+		S:  s,
+		ID: id,
+	}
+	lockCollectionsAPIMockUnlockCollection.Lock()
+	mock.calls.UnlockCollection = append(mock.calls.UnlockCollection, callInfo)
+	lockCollectionsAPIMockUnlockCollection.Unlock()
+	return mock.UnlockCollectionFunc(s, id)
+}
+
+// UnlockCollectionCalls gets all the calls that were made to UnlockCollection.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.UnlockCollectionCalls())
+func (mock *CollectionsAPIMock) UnlockCollectionCalls() []struct {
+	S  zebedee.Session
+	ID string
+} {
+	var calls []struct {
+		S  zebedee.Session
+		ID string
+	}
+	lockCollectionsAPIMockUnlockCollection.RLock()
+	calls = mock.calls.UnlockCollection
+	lockCollectionsAPIMockUnlockCollection.RUnlock()
+	return calls
+}
+
+// UnlockCollectionContext calls UnlockCollectionContextFunc.
+func (mock *CollectionsAPIMock) UnlockCollectionContext(ctx context.Context, s zebedee.Session, id string) error {
+	if mock.UnlockCollectionContextFunc == nil {
+		panic("CollectionsAPIMock.UnlockCollectionContextFunc: method is nil but CollectionsAPI.UnlockCollectionContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}{
+		Ctx: ctx,
+		S:   s,
+		ID:  id,
+	}
+	lockCollectionsAPIMockUnlockCollectionContext.Lock()
+	mock.calls.UnlockCollectionContext = append(mock.calls.UnlockCollectionContext, callInfo)
+	lockCollectionsAPIMockUnlockCollectionContext.Unlock()
+	return mock.UnlockCollectionContextFunc(ctx, s, id)
+}
+
+// UnlockCollectionContextCalls gets all the calls that were made to UnlockCollectionContext.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.UnlockCollectionContextCalls())
+func (mock *CollectionsAPIMock) UnlockCollectionContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		ID  string
+	}
+	lockCollectionsAPIMockUnlockCollectionContext.RLock()
+	calls = mock.calls.UnlockCollectionContext
+	lockCollectionsAPIMockUnlockCollectionContext.RUnlock()
+	return calls
+}
+
+// UpdateCollection calls UpdateCollectionFunc.
+func (mock *CollectionsAPIMock) UpdateCollection(s zebedee.Session, desc zebedee.CollectionDescription) error {
+	if mock.UpdateCollectionFunc == nil {
+		panic("CollectionsAPIMock.UpdateCollectionFunc: method is nil but CollectionsAPI.UpdateCollection was just called")
+	}
+	callInfo := struct {
+		S    zebedee.Session
+		Desc zebedee.CollectionDescription
+	}{
+		S:    s,
+		Desc: desc,
+	}
+	lockCollectionsAPIMockUpdateCollection.Lock()
+	mock.calls.UpdateCollection = append(mock.calls.UpdateCollection, callInfo)
+	lockCollectionsAPIMockUpdateCollection.Unlock()
+	return mock.UpdateCollectionFunc(s, desc)
+}
+
+// UpdateCollectionCalls gets all the calls that were made to UpdateCollection.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.UpdateCollectionCalls())
+func (mock *CollectionsAPIMock) UpdateCollectionCalls() []struct {
+	S    zebedee.Session
+	Desc zebedee.CollectionDescription
+} {
+	var calls []struct {
+		S    zebedee.Session
+		Desc zebedee.CollectionDescription
+	}
+	lockCollectionsAPIMockUpdateCollection.RLock()
+	calls = mock.calls.UpdateCollection
+	lockCollectionsAPIMockUpdateCollection.RUnlock()
+	return calls
+}
+
+// UpdateCollectionContent calls UpdateCollectionContentFunc.
+func (mock *CollectionsAPIMock) UpdateCollectionContent(s zebedee.Session, id string, contentUri string, content interface{}) error {
+	if mock.UpdateCollectionContentFunc == nil {
+		panic("CollectionsAPIMock.UpdateCollectionContentFunc: method is nil but CollectionsAPI.UpdateCollectionContent was just called")
+	}
+	callInfo := struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Content    interface{}
+	}{
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+		Content:    content,
+	}
+	lockCollectionsAPIMockUpdateCollectionContent.Lock()
+	mock.calls.UpdateCollectionContent = append(mock.calls.UpdateCollectionContent, callInfo)
+	lockCollectionsAPIMockUpdateCollectionContent.Unlock()
+	return mock.UpdateCollectionContentFunc(s, id, contentUri, content)
+}
+
+// UpdateCollectionContentCalls gets all the calls that were made to UpdateCollectionContent.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.UpdateCollectionContentCalls())
+func (mock *CollectionsAPIMock) UpdateCollectionContentCalls() []struct {
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+	Content    interface{}
+} {
+	var calls []struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Content    interface{}
+	}
+	lockCollectionsAPIMockUpdateCollectionContent.RLock()
+	calls = mock.calls.UpdateCollectionContent
+	lockCollectionsAPIMockUpdateCollectionContent.RUnlock()
+	return calls
+}
+
+// UpdateCollectionContentContext calls UpdateCollectionContentContextFunc.
+func (mock *CollectionsAPIMock) UpdateCollectionContentContext(ctx context.Context, s zebedee.Session, id string, contentUri string, content interface{}) error {
+	if mock.UpdateCollectionContentContextFunc == nil {
+		panic("CollectionsAPIMock.UpdateCollectionContentContextFunc: method is nil but CollectionsAPI.UpdateCollectionContentContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Content    interface{}
+	}{
+		Ctx:        ctx,
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+		Content:    content,
+	}
+	lockCollectionsAPIMockUpdateCollectionContentContext.Lock()
+	mock.calls.UpdateCollectionContentContext = append(mock.calls.UpdateCollectionContentContext, callInfo)
+	lockCollectionsAPIMockUpdateCollectionContentContext.Unlock()
+	return mock.UpdateCollectionContentContextFunc(ctx, s, id, contentUri, content)
+}
+
+// UpdateCollectionContentContextCalls gets all the calls that were made to UpdateCollectionContentContext.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.UpdateCollectionContentContextCalls())
+func (mock *CollectionsAPIMock) UpdateCollectionContentContextCalls() []struct {
+	Ctx        context.Context
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+	Content    interface{}
+} {
+	var calls []struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Content    interface{}
+	}
+	lockCollectionsAPIMockUpdateCollectionContentContext.RLock()
+	calls = mock.calls.UpdateCollectionContentContext
+	lockCollectionsAPIMockUpdateCollectionContentContext.RUnlock()
+	return calls
+}
+
+// UpdateCollectionContentWithOptions calls UpdateCollectionContentWithOptionsFunc.
+func (mock *CollectionsAPIMock) UpdateCollectionContentWithOptions(s zebedee.Session, id string, contentUri string, content interface{}, opts zebedee.ContentOptions) error {
+	if mock.UpdateCollectionContentWithOptionsFunc == nil {
+		panic("CollectionsAPIMock.UpdateCollectionContentWithOptionsFunc: method is nil but CollectionsAPI.UpdateCollectionContentWithOptions was just called")
+	}
+	callInfo := struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Content    interface{}
+		Opts       zebedee.ContentOptions
+	}{
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+		Content:    content,
+		Opts:       opts,
+	}
+	lockCollectionsAPIMockUpdateCollectionContentWithOptions.Lock()
+	mock.calls.UpdateCollectionContentWithOptions = append(mock.calls.UpdateCollectionContentWithOptions, callInfo)
+	lockCollectionsAPIMockUpdateCollectionContentWithOptions.Unlock()
+	return mock.UpdateCollectionContentWithOptionsFunc(s, id, contentUri, content, opts)
+}
+
+// UpdateCollectionContentWithOptionsCalls gets all the calls that were made to UpdateCollectionContentWithOptions.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.UpdateCollectionContentWithOptionsCalls())
+func (mock *CollectionsAPIMock) UpdateCollectionContentWithOptionsCalls() []struct {
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+	Content    interface{}
+	Opts       zebedee.ContentOptions
+} {
+	var calls []struct {
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Content    interface{}
+		Opts       zebedee.ContentOptions
+	}
+	lockCollectionsAPIMockUpdateCollectionContentWithOptions.RLock()
+	calls = mock.calls.UpdateCollectionContentWithOptions
+	lockCollectionsAPIMockUpdateCollectionContentWithOptions.RUnlock()
+	return calls
+}
+
+// UpdateCollectionContentWithOptionsContext calls UpdateCollectionContentWithOptionsContextFunc.
+func (mock *CollectionsAPIMock) UpdateCollectionContentWithOptionsContext(ctx context.Context, s zebedee.Session, id string, contentUri string, content interface{}, opts zebedee.ContentOptions) error {
+	if mock.UpdateCollectionContentWithOptionsContextFunc == nil {
+		panic("CollectionsAPIMock.UpdateCollectionContentWithOptionsContextFunc: method is nil but CollectionsAPI.UpdateCollectionContentWithOptionsContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Content    interface{}
+		Opts       zebedee.ContentOptions
+	}{
+		Ctx:        ctx,
+		S:          s,
+		ID:         id,
+		ContentUri: contentUri,
+		Content:    content,
+		Opts:       opts,
+	}
+	lockCollectionsAPIMockUpdateCollectionContentWithOptionsContext.Lock()
+	mock.calls.UpdateCollectionContentWithOptionsContext = append(mock.calls.UpdateCollectionContentWithOptionsContext, callInfo)
+	lockCollectionsAPIMockUpdateCollectionContentWithOptionsContext.Unlock()
+	return mock.UpdateCollectionContentWithOptionsContextFunc(ctx, s, id, contentUri, content, opts)
+}
+
+// UpdateCollectionContentWithOptionsContextCalls gets all the calls that were made to UpdateCollectionContentWithOptionsContext.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.UpdateCollectionContentWithOptionsContextCalls())
+func (mock *CollectionsAPIMock) UpdateCollectionContentWithOptionsContextCalls() []struct {
+	Ctx        context.Context
+	S          zebedee.Session
+	ID         string
+	ContentUri string
+	Content    interface{}
+	Opts       zebedee.ContentOptions
+} {
+	var calls []struct {
+		Ctx        context.Context
+		S          zebedee.Session
+		ID         string
+		ContentUri string
+		Content    interface{}
+		Opts       zebedee.ContentOptions
+	}
+	lockCollectionsAPIMockUpdateCollectionContentWithOptionsContext.RLock()
+	calls = mock.calls.UpdateCollectionContentWithOptionsContext
+	lockCollectionsAPIMockUpdateCollectionContentWithOptionsContext.RUnlock()
+	return calls
+}
+
+// UpdateCollectionContext calls UpdateCollectionContextFunc.
+func (mock *CollectionsAPIMock) UpdateCollectionContext(ctx context.Context, s zebedee.Session, desc zebedee.CollectionDescription) error {
+	if mock.UpdateCollectionContextFunc == nil {
+		panic("CollectionsAPIMock.UpdateCollectionContextFunc: method is nil but CollectionsAPI.UpdateCollectionContext was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		S    zebedee.Session
+		Desc zebedee.CollectionDescription
+	}{
+		Ctx:  ctx,
+		S:    s,
+		Desc: desc,
+	}
+	lockCollectionsAPIMockUpdateCollectionContext.Lock()
+	mock.calls.UpdateCollectionContext = append(mock.calls.UpdateCollectionContext, callInfo)
+	lockCollectionsAPIMockUpdateCollectionContext.Unlock()
+	return mock.UpdateCollectionContextFunc(ctx, s, desc)
+}
+
+// UpdateCollectionContextCalls gets all the calls that were made to UpdateCollectionContext.
+// Check the length with:
+//
+//	len(mockedCollectionsAPI.UpdateCollectionContextCalls())
+func (mock *CollectionsAPIMock) UpdateCollectionContextCalls() []struct {
+	Ctx  context.Context
+	S    zebedee.Session
+	Desc zebedee.CollectionDescription
+} {
+	var calls []struct {
+		Ctx  context.Context
+		S    zebedee.Session
+		Desc zebedee.CollectionDescription
+	}
+	lockCollectionsAPIMockUpdateCollectionContext.RLock()
+	calls = mock.calls.UpdateCollectionContext
+	lockCollectionsAPIMockUpdateCollectionContext.RUnlock()
+	return calls
+}
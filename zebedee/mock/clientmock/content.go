@@ -0,0 +1,760 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package clientmock
+
+import (
+	"context"
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	"io"
+	"sync"
+)
+
+var (
+	lockContentAPIMockDownloadFile                 sync.RWMutex
+	lockContentAPIMockDownloadFileContext          sync.RWMutex
+	lockContentAPIMockGetContent                   sync.RWMutex
+	lockContentAPIMockGetContentContext            sync.RWMutex
+	lockContentAPIMockGetContentJSON               sync.RWMutex
+	lockContentAPIMockGetContentJSONContext        sync.RWMutex
+	lockContentAPIMockGetContentStream             sync.RWMutex
+	lockContentAPIMockUploadFile                   sync.RWMutex
+	lockContentAPIMockUploadFileContext            sync.RWMutex
+	lockContentAPIMockUploadFileWithOptions        sync.RWMutex
+	lockContentAPIMockUploadFileWithOptionsContext sync.RWMutex
+)
+
+// Ensure, that ContentAPIMock does implement ContentAPI.
+// If this is not the case, regenerate this file with moq.
+var _ zebedee.ContentAPI = &ContentAPIMock{}
+
+// ContentAPIMock is a mock implementation of ContentAPI.
+//
+//	    func TestSomethingThatUsesContentAPI(t *testing.T) {
+//
+//	        // make and configure a mocked ContentAPI
+//	        mockedContentAPI := &ContentAPIMock{
+//	            DownloadFileFunc: func(s zebedee.Session, collectionID string, uri string) (io.ReadCloser, error) {
+//		               panic("mock out the DownloadFile method")
+//	            },
+//	            DownloadFileContextFunc: func(ctx context.Context, s zebedee.Session, collectionID string, uri string) (io.ReadCloser, error) {
+//		               panic("mock out the DownloadFileContext method")
+//	            },
+//	            GetContentFunc: func(s zebedee.Session, collectionName string, uri string) ([]byte, error) {
+//		               panic("mock out the GetContent method")
+//	            },
+//	            GetContentContextFunc: func(ctx context.Context, s zebedee.Session, collectionName string, uri string) ([]byte, error) {
+//		               panic("mock out the GetContentContext method")
+//	            },
+//	            GetContentJSONFunc: func(s zebedee.Session, collectionID string, uri string, v any) error {
+//		               panic("mock out the GetContentJSON method")
+//	            },
+//	            GetContentJSONContextFunc: func(ctx context.Context, s zebedee.Session, collectionID string, uri string, v any) error {
+//		               panic("mock out the GetContentJSONContext method")
+//	            },
+//	            GetContentStreamFunc: func(ctx context.Context, s zebedee.Session, collectionID string, uri string) (io.ReadCloser, zebedee.ContentInfo, error) {
+//		               panic("mock out the GetContentStream method")
+//	            },
+//	            UploadFileFunc: func(s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string) error {
+//		               panic("mock out the UploadFile method")
+//	            },
+//	            UploadFileContextFunc: func(ctx context.Context, s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string) error {
+//		               panic("mock out the UploadFileContext method")
+//	            },
+//	            UploadFileWithOptionsFunc: func(s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string, opts zebedee.ContentOptions) error {
+//		               panic("mock out the UploadFileWithOptions method")
+//	            },
+//	            UploadFileWithOptionsContextFunc: func(ctx context.Context, s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string, opts zebedee.ContentOptions) error {
+//		               panic("mock out the UploadFileWithOptionsContext method")
+//	            },
+//	        }
+//
+//	        // use mockedContentAPI in code that requires ContentAPI
+//	        // and then make assertions.
+//
+//	    }
+type ContentAPIMock struct {
+	// DownloadFileFunc mocks the DownloadFile method.
+	DownloadFileFunc func(s zebedee.Session, collectionID string, uri string) (io.ReadCloser, error)
+
+	// DownloadFileContextFunc mocks the DownloadFileContext method.
+	DownloadFileContextFunc func(ctx context.Context, s zebedee.Session, collectionID string, uri string) (io.ReadCloser, error)
+
+	// GetContentFunc mocks the GetContent method.
+	GetContentFunc func(s zebedee.Session, collectionName string, uri string) ([]byte, error)
+
+	// GetContentContextFunc mocks the GetContentContext method.
+	GetContentContextFunc func(ctx context.Context, s zebedee.Session, collectionName string, uri string) ([]byte, error)
+
+	// GetContentJSONFunc mocks the GetContentJSON method.
+	GetContentJSONFunc func(s zebedee.Session, collectionID string, uri string, v any) error
+
+	// GetContentJSONContextFunc mocks the GetContentJSONContext method.
+	GetContentJSONContextFunc func(ctx context.Context, s zebedee.Session, collectionID string, uri string, v any) error
+
+	// GetContentStreamFunc mocks the GetContentStream method.
+	GetContentStreamFunc func(ctx context.Context, s zebedee.Session, collectionID string, uri string) (io.ReadCloser, zebedee.ContentInfo, error)
+
+	// UploadFileFunc mocks the UploadFile method.
+	UploadFileFunc func(s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string) error
+
+	// UploadFileContextFunc mocks the UploadFileContext method.
+	UploadFileContextFunc func(ctx context.Context, s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string) error
+
+	// UploadFileWithOptionsFunc mocks the UploadFileWithOptions method.
+	UploadFileWithOptionsFunc func(s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string, opts zebedee.ContentOptions) error
+
+	// UploadFileWithOptionsContextFunc mocks the UploadFileWithOptionsContext method.
+	UploadFileWithOptionsContextFunc func(ctx context.Context, s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string, opts zebedee.ContentOptions) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DownloadFile holds details about calls to the DownloadFile method.
+		DownloadFile []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// CollectionID is the collectionID argument value.
+			CollectionID string
+			// URI is the uri argument value.
+			URI string
+		}
+		// DownloadFileContext holds details about calls to the DownloadFileContext method.
+		DownloadFileContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// CollectionID is the collectionID argument value.
+			CollectionID string
+			// URI is the uri argument value.
+			URI string
+		}
+		// GetContent holds details about calls to the GetContent method.
+		GetContent []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// CollectionName is the collectionName argument value.
+			CollectionName string
+			// URI is the uri argument value.
+			URI string
+		}
+		// GetContentContext holds details about calls to the GetContentContext method.
+		GetContentContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// CollectionName is the collectionName argument value.
+			CollectionName string
+			// URI is the uri argument value.
+			URI string
+		}
+		// GetContentJSON holds details about calls to the GetContentJSON method.
+		GetContentJSON []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// CollectionID is the collectionID argument value.
+			CollectionID string
+			// URI is the uri argument value.
+			URI string
+			// V is the v argument value.
+			V any
+		}
+		// GetContentJSONContext holds details about calls to the GetContentJSONContext method.
+		GetContentJSONContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// CollectionID is the collectionID argument value.
+			CollectionID string
+			// URI is the uri argument value.
+			URI string
+			// V is the v argument value.
+			V any
+		}
+		// GetContentStream holds details about calls to the GetContentStream method.
+		GetContentStream []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// CollectionID is the collectionID argument value.
+			CollectionID string
+			// URI is the uri argument value.
+			URI string
+		}
+		// UploadFile holds details about calls to the UploadFile method.
+		UploadFile []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// CollectionID is the collectionID argument value.
+			CollectionID string
+			// URI is the uri argument value.
+			URI string
+			// R is the r argument value.
+			R io.Reader
+			// ContentType is the contentType argument value.
+			ContentType string
+		}
+		// UploadFileContext holds details about calls to the UploadFileContext method.
+		UploadFileContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// CollectionID is the collectionID argument value.
+			CollectionID string
+			// URI is the uri argument value.
+			URI string
+			// R is the r argument value.
+			R io.Reader
+			// ContentType is the contentType argument value.
+			ContentType string
+		}
+		// UploadFileWithOptions holds details about calls to the UploadFileWithOptions method.
+		UploadFileWithOptions []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// CollectionID is the collectionID argument value.
+			CollectionID string
+			// URI is the uri argument value.
+			URI string
+			// R is the r argument value.
+			R io.Reader
+			// ContentType is the contentType argument value.
+			ContentType string
+			// Opts is the opts argument value.
+			Opts zebedee.ContentOptions
+		}
+		// UploadFileWithOptionsContext holds details about calls to the UploadFileWithOptionsContext method.
+		UploadFileWithOptionsContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// CollectionID is the collectionID argument value.
+			CollectionID string
+			// URI is the uri argument value.
+			URI string
+			// R is the r argument value.
+			R io.Reader
+			// ContentType is the contentType argument value.
+			ContentType string
+			// Opts is the opts argument value.
+			Opts zebedee.ContentOptions
+		}
+	}
+}
+
+// DownloadFile calls DownloadFileFunc.
+func (mock *ContentAPIMock) DownloadFile(s zebedee.Session, collectionID string, uri string) (io.ReadCloser, error) {
+	if mock.DownloadFileFunc == nil {
+		panic("ContentAPIMock.DownloadFileFunc: method is nil but ContentAPI.DownloadFile was just called")
+	}
+	callInfo := struct {
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+	}{
+		S:            s,
+		CollectionID: collectionID,
+		URI:          uri,
+	}
+	lockContentAPIMockDownloadFile.Lock()
+	mock.calls.DownloadFile = append(mock.calls.DownloadFile, callInfo)
+	lockContentAPIMockDownloadFile.Unlock()
+	return mock.DownloadFileFunc(s, collectionID, uri)
+}
+
+// DownloadFileCalls gets all the calls that were made to DownloadFile.
+// Check the length with:
+//
+//	len(mockedContentAPI.DownloadFileCalls())
+func (mock *ContentAPIMock) DownloadFileCalls() []struct {
+	S            zebedee.Session
+	CollectionID string
+	URI          string
+} {
+	var calls []struct {
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+	}
+	lockContentAPIMockDownloadFile.RLock()
+	calls = mock.calls.DownloadFile
+	lockContentAPIMockDownloadFile.RUnlock()
+	return calls
+}
+
+// DownloadFileContext calls DownloadFileContextFunc.
+func (mock *ContentAPIMock) DownloadFileContext(ctx context.Context, s zebedee.Session, collectionID string, uri string) (io.ReadCloser, error) {
+	if mock.DownloadFileContextFunc == nil {
+		panic("ContentAPIMock.DownloadFileContextFunc: method is nil but ContentAPI.DownloadFileContext was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+	}{
+		Ctx:          ctx,
+		S:            s,
+		CollectionID: collectionID,
+		URI:          uri,
+	}
+	lockContentAPIMockDownloadFileContext.Lock()
+	mock.calls.DownloadFileContext = append(mock.calls.DownloadFileContext, callInfo)
+	lockContentAPIMockDownloadFileContext.Unlock()
+	return mock.DownloadFileContextFunc(ctx, s, collectionID, uri)
+}
+
+// DownloadFileContextCalls gets all the calls that were made to DownloadFileContext.
+// Check the length with:
+//
+//	len(mockedContentAPI.DownloadFileContextCalls())
+func (mock *ContentAPIMock) DownloadFileContextCalls() []struct {
+	Ctx          context.Context
+	S            zebedee.Session
+	CollectionID string
+	URI          string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+	}
+	lockContentAPIMockDownloadFileContext.RLock()
+	calls = mock.calls.DownloadFileContext
+	lockContentAPIMockDownloadFileContext.RUnlock()
+	return calls
+}
+
+// GetContent calls GetContentFunc.
+func (mock *ContentAPIMock) GetContent(s zebedee.Session, collectionName string, uri string) ([]byte, error) {
+	if mock.GetContentFunc == nil {
+		panic("ContentAPIMock.GetContentFunc: method is nil but ContentAPI.GetContent was just called")
+	}
+	callInfo := struct {
+		S              zebedee.Session
+		CollectionName string
+		URI            string
+	}{
+		S:              s,
+		CollectionName: collectionName,
+		URI:            uri,
+	}
+	lockContentAPIMockGetContent.Lock()
+	mock.calls.GetContent = append(mock.calls.GetContent, callInfo)
+	lockContentAPIMockGetContent.Unlock()
+	return mock.GetContentFunc(s, collectionName, uri)
+}
+
+// GetContentCalls gets all the calls that were made to GetContent.
+// Check the length with:
+//
+//	len(mockedContentAPI.GetContentCalls())
+func (mock *ContentAPIMock) GetContentCalls() []struct {
+	S              zebedee.Session
+	CollectionName string
+	URI            string
+} {
+	var calls []struct {
+		S              zebedee.Session
+		CollectionName string
+		URI            string
+	}
+	lockContentAPIMockGetContent.RLock()
+	calls = mock.calls.GetContent
+	lockContentAPIMockGetContent.RUnlock()
+	return calls
+}
+
+// GetContentContext calls GetContentContextFunc.
+func (mock *ContentAPIMock) GetContentContext(ctx context.Context, s zebedee.Session, collectionName string, uri string) ([]byte, error) {
+	if mock.GetContentContextFunc == nil {
+		panic("ContentAPIMock.GetContentContextFunc: method is nil but ContentAPI.GetContentContext was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		S              zebedee.Session
+		CollectionName string
+		URI            string
+	}{
+		Ctx:            ctx,
+		S:              s,
+		CollectionName: collectionName,
+		URI:            uri,
+	}
+	lockContentAPIMockGetContentContext.Lock()
+	mock.calls.GetContentContext = append(mock.calls.GetContentContext, callInfo)
+	lockContentAPIMockGetContentContext.Unlock()
+	return mock.GetContentContextFunc(ctx, s, collectionName, uri)
+}
+
+// GetContentContextCalls gets all the calls that were made to GetContentContext.
+// Check the length with:
+//
+//	len(mockedContentAPI.GetContentContextCalls())
+func (mock *ContentAPIMock) GetContentContextCalls() []struct {
+	Ctx            context.Context
+	S              zebedee.Session
+	CollectionName string
+	URI            string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		S              zebedee.Session
+		CollectionName string
+		URI            string
+	}
+	lockContentAPIMockGetContentContext.RLock()
+	calls = mock.calls.GetContentContext
+	lockContentAPIMockGetContentContext.RUnlock()
+	return calls
+}
+
+// GetContentJSON calls GetContentJSONFunc.
+func (mock *ContentAPIMock) GetContentJSON(s zebedee.Session, collectionID string, uri string, v any) error {
+	if mock.GetContentJSONFunc == nil {
+		panic("ContentAPIMock.GetContentJSONFunc: method is nil but ContentAPI.GetContentJSON was just called")
+	}
+	callInfo := struct {
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		V            any
+	}{
+		S:            s,
+		CollectionID: collectionID,
+		URI:          uri,
+		V:            v,
+	}
+	lockContentAPIMockGetContentJSON.Lock()
+	mock.calls.GetContentJSON = append(mock.calls.GetContentJSON, callInfo)
+	lockContentAPIMockGetContentJSON.Unlock()
+	return mock.GetContentJSONFunc(s, collectionID, uri, v)
+}
+
+// GetContentJSONCalls gets all the calls that were made to GetContentJSON.
+// Check the length with:
+//
+//	len(mockedContentAPI.GetContentJSONCalls())
+func (mock *ContentAPIMock) GetContentJSONCalls() []struct {
+	S            zebedee.Session
+	CollectionID string
+	URI          string
+	V            any
+} {
+	var calls []struct {
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		V            any
+	}
+	lockContentAPIMockGetContentJSON.RLock()
+	calls = mock.calls.GetContentJSON
+	lockContentAPIMockGetContentJSON.RUnlock()
+	return calls
+}
+
+// GetContentJSONContext calls GetContentJSONContextFunc.
+func (mock *ContentAPIMock) GetContentJSONContext(ctx context.Context, s zebedee.Session, collectionID string, uri string, v any) error {
+	if mock.GetContentJSONContextFunc == nil {
+		panic("ContentAPIMock.GetContentJSONContextFunc: method is nil but ContentAPI.GetContentJSONContext was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		V            any
+	}{
+		Ctx:          ctx,
+		S:            s,
+		CollectionID: collectionID,
+		URI:          uri,
+		V:            v,
+	}
+	lockContentAPIMockGetContentJSONContext.Lock()
+	mock.calls.GetContentJSONContext = append(mock.calls.GetContentJSONContext, callInfo)
+	lockContentAPIMockGetContentJSONContext.Unlock()
+	return mock.GetContentJSONContextFunc(ctx, s, collectionID, uri, v)
+}
+
+// GetContentJSONContextCalls gets all the calls that were made to GetContentJSONContext.
+// Check the length with:
+//
+//	len(mockedContentAPI.GetContentJSONContextCalls())
+func (mock *ContentAPIMock) GetContentJSONContextCalls() []struct {
+	Ctx          context.Context
+	S            zebedee.Session
+	CollectionID string
+	URI          string
+	V            any
+} {
+	var calls []struct {
+		Ctx          context.Context
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		V            any
+	}
+	lockContentAPIMockGetContentJSONContext.RLock()
+	calls = mock.calls.GetContentJSONContext
+	lockContentAPIMockGetContentJSONContext.RUnlock()
+	return calls
+}
+
+// GetContentStream calls GetContentStreamFunc.
+func (mock *ContentAPIMock) GetContentStream(ctx context.Context, s zebedee.Session, collectionID string, uri string) (io.ReadCloser, zebedee.ContentInfo, error) {
+	if mock.GetContentStreamFunc == nil {
+		panic("ContentAPIMock.GetContentStreamFunc: method is nil but ContentAPI.GetContentStream was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+	}{
+		Ctx:          ctx,
+		S:            s,
+		CollectionID: collectionID,
+		URI:          uri,
+	}
+	lockContentAPIMockGetContentStream.Lock()
+	mock.calls.GetContentStream = append(mock.calls.GetContentStream, callInfo)
+	lockContentAPIMockGetContentStream.Unlock()
+	return mock.GetContentStreamFunc(ctx, s, collectionID, uri)
+}
+
+// GetContentStreamCalls gets all the calls that were made to GetContentStream.
+// Check the length with:
+//
+//	len(mockedContentAPI.GetContentStreamCalls())
+func (mock *ContentAPIMock) GetContentStreamCalls() []struct {
+	Ctx          context.Context
+	S            zebedee.Session
+	CollectionID string
+	URI          string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+	}
+	lockContentAPIMockGetContentStream.RLock()
+	calls = mock.calls.GetContentStream
+	lockContentAPIMockGetContentStream.RUnlock()
+	return calls
+}
+
+// UploadFile calls UploadFileFunc.
+func (mock *ContentAPIMock) UploadFile(s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string) error {
+	if mock.UploadFileFunc == nil {
+		panic("ContentAPIMock.UploadFileFunc: method is nil but ContentAPI.UploadFile was just called")
+	}
+	callInfo := struct {
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		R            io.Reader
+		ContentType  string
+	}{
+		S:            s,
+		CollectionID: collectionID,
+		URI:          uri,
+		R:            r,
+		ContentType:  contentType,
+	}
+	lockContentAPIMockUploadFile.Lock()
+	mock.calls.UploadFile = append(mock.calls.UploadFile, callInfo)
+	lockContentAPIMockUploadFile.Unlock()
+	return mock.UploadFileFunc(s, collectionID, uri, r, contentType)
+}
+
+// UploadFileCalls gets all the calls that were made to UploadFile.
+// Check the length with:
+//
+//	len(mockedContentAPI.UploadFileCalls())
+func (mock *ContentAPIMock) UploadFileCalls() []struct {
+	S            zebedee.Session
+	CollectionID string
+	URI          string
+	R            io.Reader
+	ContentType  string
+} {
+	var calls []struct {
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		R            io.Reader
+		ContentType  string
+	}
+	lockContentAPIMockUploadFile.RLock()
+	calls = mock.calls.UploadFile
+	lockContentAPIMockUploadFile.RUnlock()
+	return calls
+}
+
+// UploadFileContext calls UploadFileContextFunc.
+func (mock *ContentAPIMock) UploadFileContext(ctx context.Context, s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string) error {
+	if mock.UploadFileContextFunc == nil {
+		panic("ContentAPIMock.UploadFileContextFunc: method is nil but ContentAPI.UploadFileContext was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		R            io.Reader
+		ContentType  string
+	}{
+		Ctx:          ctx,
+		S:            s,
+		CollectionID: collectionID,
+		URI:          uri,
+		R:            r,
+		ContentType:  contentType,
+	}
+	lockContentAPIMockUploadFileContext.Lock()
+	mock.calls.UploadFileContext = append(mock.calls.UploadFileContext, callInfo)
+	lockContentAPIMockUploadFileContext.Unlock()
+	return mock.UploadFileContextFunc(ctx, s, collectionID, uri, r, contentType)
+}
+
+// UploadFileContextCalls gets all the calls that were made to UploadFileContext.
+// Check the length with:
+//
+//	len(mockedContentAPI.UploadFileContextCalls())
+func (mock *ContentAPIMock) UploadFileContextCalls() []struct {
+	Ctx          context.Context
+	S            zebedee.Session
+	CollectionID string
+	URI          string
+	R            io.Reader
+	ContentType  string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		R            io.Reader
+		ContentType  string
+	}
+	lockContentAPIMockUploadFileContext.RLock()
+	calls = mock.calls.UploadFileContext
+	lockContentAPIMockUploadFileContext.RUnlock()
+	return calls
+}
+
+// UploadFileWithOptions calls UploadFileWithOptionsFunc.
+func (mock *ContentAPIMock) UploadFileWithOptions(s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string, opts zebedee.ContentOptions) error {
+	if mock.UploadFileWithOptionsFunc == nil {
+		panic("ContentAPIMock.UploadFileWithOptionsFunc: method is nil but ContentAPI.UploadFileWithOptions was just called")
+	}
+	callInfo := struct {
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		R            io.Reader
+		ContentType  string
+		Opts         zebedee.ContentOptions
+	}{
+		S:            s,
+		CollectionID: collectionID,
+		URI:          uri,
+		R:            r,
+		ContentType:  contentType,
+		Opts:         opts,
+	}
+	lockContentAPIMockUploadFileWithOptions.Lock()
+	mock.calls.UploadFileWithOptions = append(mock.calls.UploadFileWithOptions, callInfo)
+	lockContentAPIMockUploadFileWithOptions.Unlock()
+	return mock.UploadFileWithOptionsFunc(s, collectionID, uri, r, contentType, opts)
+}
+
+// UploadFileWithOptionsCalls gets all the calls that were made to UploadFileWithOptions.
+// Check the length with:
+//
+//	len(mockedContentAPI.UploadFileWithOptionsCalls())
+func (mock *ContentAPIMock) UploadFileWithOptionsCalls() []struct {
+	S            zebedee.Session
+	CollectionID string
+	URI          string
+	R            io.Reader
+	ContentType  string
+	Opts         zebedee.ContentOptions
+} {
+	var calls []struct {
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		R            io.Reader
+		ContentType  string
+		Opts         zebedee.ContentOptions
+	}
+	lockContentAPIMockUploadFileWithOptions.RLock()
+	calls = mock.calls.UploadFileWithOptions
+	lockContentAPIMockUploadFileWithOptions.RUnlock()
+	return calls
+}
+
+// UploadFileWithOptionsContext calls UploadFileWithOptionsContextFunc.
+func (mock *ContentAPIMock) UploadFileWithOptionsContext(ctx context.Context, s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string, opts zebedee.ContentOptions) error {
+	if mock.UploadFileWithOptionsContextFunc == nil {
+		panic("ContentAPIMock.UploadFileWithOptionsContextFunc: method is nil but ContentAPI.UploadFileWithOptionsContext was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		R            io.Reader
+		ContentType  string
+		Opts         zebedee.ContentOptions
+	}{
+		Ctx:          ctx,
+		S:            s,
+		CollectionID: collectionID,
+		URI:          uri,
+		R:            r,
+		ContentType:  contentType,
+		Opts:         opts,
+	}
+	lockContentAPIMockUploadFileWithOptionsContext.Lock()
+	mock.calls.UploadFileWithOptionsContext = append(mock.calls.UploadFileWithOptionsContext, callInfo)
+	lockContentAPIMockUploadFileWithOptionsContext.Unlock()
+	return mock.UploadFileWithOptionsContextFunc(ctx, s, collectionID, uri, r, contentType, opts)
+}
+
+// UploadFileWithOptionsContextCalls gets all the calls that were made to UploadFileWithOptionsContext.
+// Check the length with:
+//
+//	len(mockedContentAPI.UploadFileWithOptionsContextCalls())
+func (mock *ContentAPIMock) UploadFileWithOptionsContextCalls() []struct {
+	Ctx          context.Context
+	S            zebedee.Session
+	CollectionID string
+	URI          string
+	R            io.Reader
+	ContentType  string
+	Opts         zebedee.ContentOptions
+} {
+	var calls []struct {
+		Ctx          context.Context
+		S            zebedee.Session
+		CollectionID string
+		URI          string
+		R            io.Reader
+		ContentType  string
+		Opts         zebedee.ContentOptions
+	}
+	lockContentAPIMockUploadFileWithOptionsContext.RLock()
+	calls = mock.calls.UploadFileWithOptionsContext
+	lockContentAPIMockUploadFileWithOptionsContext.RUnlock()
+	return calls
+}
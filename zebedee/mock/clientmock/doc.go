@@ -0,0 +1,7 @@
+// Package clientmock contains moq generated mocks of the zebedee Client and each of the interfaces it is made up of,
+// and helpers returning mocks where every call succeeds.
+//
+// The mocks are not in the mock package alongside HttpClientMock as they import the zebedee package, whereas the mock
+// package is imported by the tests of the zebedee package itself, which would be an import cycle. The mocks are
+// generated by the go:generate directives on the Client interface; only helpers.go is maintained by hand.
+package clientmock
@@ -0,0 +1,455 @@
+package clientmock
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync/atomic"
+	"unicode"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+)
+
+// SessionID is the session ID returned by the happy path OpenSession mocks.
+const SessionID = "mock-session-id"
+
+var collectionCount atomic.Int64
+
+// NewClient returns a ClientMock where every method succeeds. Use the sub-interface constructors, e.g.
+// NewCollectionsAPI, for a description of the values returned. Individual funcs can be replaced to customise the mock.
+func NewClient() *ClientMock {
+	auth := NewAuthAPI()
+	users := NewUsersAPI()
+	perms := NewPermissionsAPI()
+	cols := NewCollectionsAPI()
+	teams := NewTeamsAPI()
+	keyring := NewKeyringAPI()
+	content := NewContentAPI()
+	published := NewPublishedContentAPI()
+
+	return &ClientMock{
+		OpenSessionFunc:           auth.OpenSessionFunc,
+		OpenSessionContextFunc:    auth.OpenSessionContextFunc,
+		OpenSessionJWTFunc:        auth.OpenSessionJWTFunc,
+		OpenSessionJWTContextFunc: auth.OpenSessionJWTContextFunc,
+
+		CreateUserFunc:         users.CreateUserFunc,
+		CreateUserContextFunc:  users.CreateUserContextFunc,
+		GetUserFunc:            users.GetUserFunc,
+		GetUserContextFunc:     users.GetUserContextFunc,
+		GetUsersFunc:           users.GetUsersFunc,
+		GetUsersContextFunc:    users.GetUsersContextFunc,
+		DeleteUserFunc:         users.DeleteUserFunc,
+		DeleteUserContextFunc:  users.DeleteUserContextFunc,
+		SetPasswordFunc:        users.SetPasswordFunc,
+		SetPasswordContextFunc: users.SetPasswordContextFunc,
+
+		SetPermissionsFunc:        perms.SetPermissionsFunc,
+		SetPermissionsContextFunc: perms.SetPermissionsContextFunc,
+		GetPermissionsFunc:        perms.GetPermissionsFunc,
+		GetPermissionsContextFunc: perms.GetPermissionsContextFunc,
+
+		GetCollectionByIDFunc:                           cols.GetCollectionByIDFunc,
+		GetCollectionByIDContextFunc:                    cols.GetCollectionByIDContextFunc,
+		CreateCollectionFunc:                            cols.CreateCollectionFunc,
+		CreateCollectionContextFunc:                     cols.CreateCollectionContextFunc,
+		DeleteCollectionFunc:                            cols.DeleteCollectionFunc,
+		DeleteCollectionContextFunc:                     cols.DeleteCollectionContextFunc,
+		GetCollectionsFunc:                              cols.GetCollectionsFunc,
+		GetCollectionsContextFunc:                       cols.GetCollectionsContextFunc,
+		UpdateCollectionFunc:                            cols.UpdateCollectionFunc,
+		UpdateCollectionContextFunc:                     cols.UpdateCollectionContextFunc,
+		UpdateCollectionContentFunc:                     cols.UpdateCollectionContentFunc,
+		UpdateCollectionContentContextFunc:              cols.UpdateCollectionContentContextFunc,
+		UpdateCollectionContentWithOptionsFunc:          cols.UpdateCollectionContentWithOptionsFunc,
+		UpdateCollectionContentWithOptionsContextFunc:   cols.UpdateCollectionContentWithOptionsContextFunc,
+		DeleteCollectionContentFunc:                     cols.DeleteCollectionContentFunc,
+		DeleteCollectionContentContextFunc:              cols.DeleteCollectionContentContextFunc,
+		CompleteCollectionContentFunc:                   cols.CompleteCollectionContentFunc,
+		CompleteCollectionContentContextFunc:            cols.CompleteCollectionContentContextFunc,
+		CompleteCollectionContentWithOptionsFunc:        cols.CompleteCollectionContentWithOptionsFunc,
+		CompleteCollectionContentWithOptionsContextFunc: cols.CompleteCollectionContentWithOptionsContextFunc,
+		ReviewCollectionContentFunc:                     cols.ReviewCollectionContentFunc,
+		ReviewCollectionContentContextFunc:              cols.ReviewCollectionContentContextFunc,
+		ReviewCollectionContentWithOptionsFunc:          cols.ReviewCollectionContentWithOptionsFunc,
+		ReviewCollectionContentWithOptionsContextFunc:   cols.ReviewCollectionContentWithOptionsContextFunc,
+		ApproveCollectionFunc:                           cols.ApproveCollectionFunc,
+		ApproveCollectionContextFunc:                    cols.ApproveCollectionContextFunc,
+		UnlockCollectionFunc:                            cols.UnlockCollectionFunc,
+		UnlockCollectionContextFunc:                     cols.UnlockCollectionContextFunc,
+		PublishCollectionFunc:                           cols.PublishCollectionFunc,
+		PublishCollectionContextFunc:                    cols.PublishCollectionContextFunc,
+		GetCollectionDetailsFunc:                        cols.GetCollectionDetailsFunc,
+		GetCollectionDetailsContextFunc:                 cols.GetCollectionDetailsContextFunc,
+
+		AddTeamMemberFunc:           teams.AddTeamMemberFunc,
+		AddTeamMemberContextFunc:    teams.AddTeamMemberContextFunc,
+		RemoveTeamMemberFunc:        teams.RemoveTeamMemberFunc,
+		RemoveTeamMemberContextFunc: teams.RemoveTeamMemberContextFunc,
+		CreateTeamFunc:              teams.CreateTeamFunc,
+		CreateTeamContextFunc:       teams.CreateTeamContextFunc,
+		DeleteTeamFunc:              teams.DeleteTeamFunc,
+		DeleteTeamContextFunc:       teams.DeleteTeamContextFunc,
+		ListTeamsFunc:               teams.ListTeamsFunc,
+		ListTeamsContextFunc:        teams.ListTeamsContextFunc,
+		GetTeamFunc:                 teams.GetTeamFunc,
+		GetTeamContextFunc:          teams.GetTeamContextFunc,
+
+		ListUserKeyringFunc:        keyring.ListUserKeyringFunc,
+		ListUserKeyringContextFunc: keyring.ListUserKeyringContextFunc,
+
+		GetContentFunc:                   content.GetContentFunc,
+		GetContentContextFunc:            content.GetContentContextFunc,
+		GetContentStreamFunc:             content.GetContentStreamFunc,
+		GetContentJSONFunc:               content.GetContentJSONFunc,
+		GetContentJSONContextFunc:        content.GetContentJSONContextFunc,
+		UploadFileFunc:                   content.UploadFileFunc,
+		UploadFileContextFunc:            content.UploadFileContextFunc,
+		UploadFileWithOptionsFunc:        content.UploadFileWithOptionsFunc,
+		UploadFileWithOptionsContextFunc: content.UploadFileWithOptionsContextFunc,
+		DownloadFileFunc:                 content.DownloadFileFunc,
+		DownloadFileContextFunc:          content.DownloadFileContextFunc,
+
+		GetPublishedContentFunc:          published.GetPublishedContentFunc,
+		GetPublishedContentContextFunc:   published.GetPublishedContentContextFunc,
+		GetPublishedContentStreamFunc:    published.GetPublishedContentStreamFunc,
+		GetPublishedDataFunc:             published.GetPublishedDataFunc,
+		GetPublishedDataContextFunc:      published.GetPublishedDataContextFunc,
+		ListPublishedChildrenFunc:        published.ListPublishedChildrenFunc,
+		ListPublishedChildrenContextFunc: published.ListPublishedChildrenContextFunc,
+	}
+}
+
+// NewAuthAPI returns an AuthAPIMock where OpenSession returns a session for the credentials email with ID SessionID,
+// and OpenSessionJWT returns a session with the token as its ID.
+func NewAuthAPI() *AuthAPIMock {
+	return &AuthAPIMock{
+		OpenSessionFunc: func(c zebedee.Credentials) (zebedee.Session, error) {
+			return zebedee.Session{Email: c.Email, ID: SessionID}, nil
+		},
+		OpenSessionContextFunc: func(ctx context.Context, c zebedee.Credentials) (zebedee.Session, error) {
+			return zebedee.Session{Email: c.Email, ID: SessionID}, nil
+		},
+		OpenSessionJWTFunc: func(authToken string) (zebedee.Session, error) {
+			return zebedee.Session{ID: authToken}, nil
+		},
+		OpenSessionJWTContextFunc: func(ctx context.Context, authToken string) (zebedee.Session, error) {
+			return zebedee.Session{ID: authToken}, nil
+		},
+	}
+}
+
+// NewUsersAPI returns a UsersAPIMock where CreateUser echoes the user back, GetUser returns a user with the requested
+// email, GetUsers returns an empty list and all other methods succeed.
+func NewUsersAPI() *UsersAPIMock {
+	return &UsersAPIMock{
+		CreateUserFunc: func(s zebedee.Session, u zebedee.User) (zebedee.User, error) {
+			return u, nil
+		},
+		CreateUserContextFunc: func(ctx context.Context, s zebedee.Session, u zebedee.User) (zebedee.User, error) {
+			return u, nil
+		},
+		GetUserFunc: func(s zebedee.Session, email string) (zebedee.User, error) {
+			return zebedee.User{Email: email}, nil
+		},
+		GetUserContextFunc: func(ctx context.Context, s zebedee.Session, email string) (zebedee.User, error) {
+			return zebedee.User{Email: email}, nil
+		},
+		GetUsersFunc: func(s zebedee.Session) ([]zebedee.User, error) {
+			return []zebedee.User{}, nil
+		},
+		GetUsersContextFunc: func(ctx context.Context, s zebedee.Session) ([]zebedee.User, error) {
+			return []zebedee.User{}, nil
+		},
+		DeleteUserFunc: func(s zebedee.Session, email string) error {
+			return nil
+		},
+		DeleteUserContextFunc: func(ctx context.Context, s zebedee.Session, email string) error {
+			return nil
+		},
+		SetPasswordFunc: func(s zebedee.Session, c zebedee.Credentials) error {
+			return nil
+		},
+		SetPasswordContextFunc: func(ctx context.Context, s zebedee.Session, c zebedee.Credentials) error {
+			return nil
+		},
+	}
+}
+
+// NewPermissionsAPI returns a PermissionsAPIMock where GetPermissions returns editor permissions for the requested
+// email and SetPermissions succeeds.
+func NewPermissionsAPI() *PermissionsAPIMock {
+	return &PermissionsAPIMock{
+		SetPermissionsFunc: func(s zebedee.Session, p zebedee.Permissions) error {
+			return nil
+		},
+		SetPermissionsContextFunc: func(ctx context.Context, s zebedee.Session, p zebedee.Permissions) error {
+			return nil
+		},
+		GetPermissionsFunc: func(s zebedee.Session, email string) (zebedee.Permissions, error) {
+			return zebedee.Permissions{Email: email, Editor: true}, nil
+		},
+		GetPermissionsContextFunc: func(ctx context.Context, s zebedee.Session, email string) (zebedee.Permissions, error) {
+			return zebedee.Permissions{Email: email, Editor: true}, nil
+		},
+	}
+}
+
+// NewCollectionsAPI returns a CollectionsAPIMock where CreateCollection echoes the description back with a generated
+// ID, GetCollectionByID and GetCollectionDetails return an empty collection with the requested ID, GetCollections
+// returns an empty list and all other methods succeed.
+func NewCollectionsAPI() *CollectionsAPIMock {
+	return &CollectionsAPIMock{
+		GetCollectionByIDFunc: func(s zebedee.Session, id string) (zebedee.CollectionDescription, error) {
+			return collectionWithID(id), nil
+		},
+		GetCollectionByIDContextFunc: func(ctx context.Context, s zebedee.Session, id string) (zebedee.CollectionDescription, error) {
+			return collectionWithID(id), nil
+		},
+		CreateCollectionFunc: func(s zebedee.Session, desc zebedee.CollectionDescription) (zebedee.CollectionDescription, error) {
+			desc.ID = GenerateCollectionID(desc.Name)
+			return desc, nil
+		},
+		CreateCollectionContextFunc: func(ctx context.Context, s zebedee.Session, desc zebedee.CollectionDescription) (zebedee.CollectionDescription, error) {
+			desc.ID = GenerateCollectionID(desc.Name)
+			return desc, nil
+		},
+		DeleteCollectionFunc: func(s zebedee.Session, id string) error {
+			return nil
+		},
+		DeleteCollectionContextFunc: func(ctx context.Context, s zebedee.Session, id string) error {
+			return nil
+		},
+		GetCollectionsFunc: func(s zebedee.Session) ([]zebedee.CollectionDescription, error) {
+			return []zebedee.CollectionDescription{}, nil
+		},
+		GetCollectionsContextFunc: func(ctx context.Context, s zebedee.Session) ([]zebedee.CollectionDescription, error) {
+			return []zebedee.CollectionDescription{}, nil
+		},
+		UpdateCollectionFunc: func(s zebedee.Session, desc zebedee.CollectionDescription) error {
+			return nil
+		},
+		UpdateCollectionContextFunc: func(ctx context.Context, s zebedee.Session, desc zebedee.CollectionDescription) error {
+			return nil
+		},
+		UpdateCollectionContentFunc: func(s zebedee.Session, id string, contentUri string, content interface{}) error {
+			return nil
+		},
+		UpdateCollectionContentContextFunc: func(ctx context.Context, s zebedee.Session, id string, contentUri string, content interface{}) error {
+			return nil
+		},
+		UpdateCollectionContentWithOptionsFunc: func(s zebedee.Session, id string, contentUri string, content interface{}, opts zebedee.ContentOptions) error {
+			return nil
+		},
+		UpdateCollectionContentWithOptionsContextFunc: func(ctx context.Context, s zebedee.Session, id string, contentUri string, content interface{}, opts zebedee.ContentOptions) error {
+			return nil
+		},
+		DeleteCollectionContentFunc: func(s zebedee.Session, id string, contentUri string) error {
+			return nil
+		},
+		DeleteCollectionContentContextFunc: func(ctx context.Context, s zebedee.Session, id string, contentUri string) error {
+			return nil
+		},
+		CompleteCollectionContentFunc: func(s zebedee.Session, id string, contentUri string) error {
+			return nil
+		},
+		CompleteCollectionContentContextFunc: func(ctx context.Context, s zebedee.Session, id string, contentUri string) error {
+			return nil
+		},
+		CompleteCollectionContentWithOptionsFunc: func(s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error {
+			return nil
+		},
+		CompleteCollectionContentWithOptionsContextFunc: func(ctx context.Context, s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error {
+			return nil
+		},
+		ReviewCollectionContentFunc: func(s zebedee.Session, id string, contentUri string) error {
+			return nil
+		},
+		ReviewCollectionContentContextFunc: func(ctx context.Context, s zebedee.Session, id string, contentUri string) error {
+			return nil
+		},
+		ReviewCollectionContentWithOptionsFunc: func(s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error {
+			return nil
+		},
+		ReviewCollectionContentWithOptionsContextFunc: func(ctx context.Context, s zebedee.Session, id string, contentUri string, opts zebedee.ContentOptions) error {
+			return nil
+		},
+		ApproveCollectionFunc: func(s zebedee.Session, id string) error {
+			return nil
+		},
+		ApproveCollectionContextFunc: func(ctx context.Context, s zebedee.Session, id string) error {
+			return nil
+		},
+		UnlockCollectionFunc: func(s zebedee.Session, id string) error {
+			return nil
+		},
+		UnlockCollectionContextFunc: func(ctx context.Context, s zebedee.Session, id string) error {
+			return nil
+		},
+		PublishCollectionFunc: func(s zebedee.Session, id string) error {
+			return nil
+		},
+		PublishCollectionContextFunc: func(ctx context.Context, s zebedee.Session, id string) error {
+			return nil
+		},
+		GetCollectionDetailsFunc: func(s zebedee.Session, id string) (zebedee.CollectionDetails, error) {
+			return collectionDetailsWithID(id), nil
+		},
+		GetCollectionDetailsContextFunc: func(ctx context.Context, s zebedee.Session, id string) (zebedee.CollectionDetails, error) {
+			return collectionDetailsWithID(id), nil
+		},
+	}
+}
+
+// NewTeamsAPI returns a TeamsAPIMock where CreateTeam returns true, GetTeam returns an empty team with the requested
+// name, ListTeams returns an empty list and all other methods succeed.
+func NewTeamsAPI() *TeamsAPIMock {
+	return &TeamsAPIMock{
+		AddTeamMemberFunc: func(s zebedee.Session, teamName string, email string) error {
+			return nil
+		},
+		AddTeamMemberContextFunc: func(ctx context.Context, s zebedee.Session, teamName string, email string) error {
+			return nil
+		},
+		RemoveTeamMemberFunc: func(s zebedee.Session, teamName string, email string) error {
+			return nil
+		},
+		RemoveTeamMemberContextFunc: func(ctx context.Context, s zebedee.Session, teamName string, email string) error {
+			return nil
+		},
+		CreateTeamFunc: func(s zebedee.Session, teamName string) (bool, error) {
+			return true, nil
+		},
+		CreateTeamContextFunc: func(ctx context.Context, s zebedee.Session, teamName string) (bool, error) {
+			return true, nil
+		},
+		DeleteTeamFunc: func(s zebedee.Session, teamName string) error {
+			return nil
+		},
+		DeleteTeamContextFunc: func(ctx context.Context, s zebedee.Session, teamName string) error {
+			return nil
+		},
+		ListTeamsFunc: func(s zebedee.Session) (zebedee.TeamsList, error) {
+			return zebedee.TeamsList{Teams: []zebedee.Team{}}, nil
+		},
+		ListTeamsContextFunc: func(ctx context.Context, s zebedee.Session) (zebedee.TeamsList, error) {
+			return zebedee.TeamsList{Teams: []zebedee.Team{}}, nil
+		},
+		GetTeamFunc: func(s zebedee.Session, teamName string) (zebedee.Team, error) {
+			return zebedee.Team{Name: teamName, Members: []string{}}, nil
+		},
+		GetTeamContextFunc: func(ctx context.Context, s zebedee.Session, teamName string) (zebedee.Team, error) {
+			return zebedee.Team{Name: teamName, Members: []string{}}, nil
+		},
+	}
+}
+
+// NewKeyringAPI returns a KeyringAPIMock where ListUserKeyring returns an empty list.
+func NewKeyringAPI() *KeyringAPIMock {
+	return &KeyringAPIMock{
+		ListUserKeyringFunc: func(s zebedee.Session) ([]string, error) {
+			return []string{}, nil
+		},
+		ListUserKeyringContextFunc: func(ctx context.Context, s zebedee.Session) ([]string, error) {
+			return []string{}, nil
+		},
+	}
+}
+
+// NewContentAPI returns a ContentAPIMock where GetContent returns an empty JSON object, GetContentJSON leaves the
+// value unchanged, UploadFile succeeds and DownloadFile returns an empty file.
+func NewContentAPI() *ContentAPIMock {
+	return &ContentAPIMock{
+		GetContentFunc: func(s zebedee.Session, collectionName string, uri string) ([]byte, error) {
+			return []byte("{}"), nil
+		},
+		GetContentContextFunc: func(ctx context.Context, s zebedee.Session, collectionName string, uri string) ([]byte, error) {
+			return []byte("{}"), nil
+		},
+		GetContentStreamFunc: func(ctx context.Context, s zebedee.Session, collectionID string, uri string) (io.ReadCloser, zebedee.ContentInfo, error) {
+			return io.NopCloser(strings.NewReader("{}")), zebedee.ContentInfo{ContentType: "application/json", ContentLength: 2}, nil
+		},
+		GetContentJSONFunc: func(s zebedee.Session, collectionID string, uri string, v any) error {
+			return nil
+		},
+		GetContentJSONContextFunc: func(ctx context.Context, s zebedee.Session, collectionID string, uri string, v any) error {
+			return nil
+		},
+		UploadFileFunc: func(s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string) error {
+			return nil
+		},
+		UploadFileContextFunc: func(ctx context.Context, s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string) error {
+			return nil
+		},
+		UploadFileWithOptionsFunc: func(s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string, opts zebedee.ContentOptions) error {
+			return nil
+		},
+		UploadFileWithOptionsContextFunc: func(ctx context.Context, s zebedee.Session, collectionID string, uri string, r io.Reader, contentType string, opts zebedee.ContentOptions) error {
+			return nil
+		},
+		DownloadFileFunc: func(s zebedee.Session, collectionID string, uri string) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader("")), nil
+		},
+		DownloadFileContextFunc: func(ctx context.Context, s zebedee.Session, collectionID string, uri string) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader("")), nil
+		},
+	}
+}
+
+// NewPublishedContentAPI returns a PublishedContentAPIMock where the published content and page JSON are an empty JSON
+// object and no URI has any children.
+func NewPublishedContentAPI() *PublishedContentAPIMock {
+	return &PublishedContentAPIMock{
+		GetPublishedContentFunc: func(s zebedee.Session, uri string) ([]byte, error) {
+			return []byte("{}"), nil
+		},
+		GetPublishedContentContextFunc: func(ctx context.Context, s zebedee.Session, uri string) ([]byte, error) {
+			return []byte("{}"), nil
+		},
+		GetPublishedContentStreamFunc: func(ctx context.Context, s zebedee.Session, uri string) (io.ReadCloser, zebedee.ContentInfo, error) {
+			return io.NopCloser(strings.NewReader("{}")), zebedee.ContentInfo{ContentType: "application/json", ContentLength: 2}, nil
+		},
+		GetPublishedDataFunc: func(s zebedee.Session, uri string) ([]byte, error) {
+			return []byte("{}"), nil
+		},
+		GetPublishedDataContextFunc: func(ctx context.Context, s zebedee.Session, uri string) ([]byte, error) {
+			return []byte("{}"), nil
+		},
+		ListPublishedChildrenFunc: func(s zebedee.Session, uri string) ([]zebedee.ContentNode, error) {
+			return []zebedee.ContentNode{}, nil
+		},
+		ListPublishedChildrenContextFunc: func(ctx context.Context, s zebedee.Session, uri string) ([]zebedee.ContentNode, error) {
+			return []zebedee.ContentNode{}, nil
+		},
+	}
+}
+
+// GenerateCollectionID returns a unique collection ID in the same form as Zebedee, the alphanumeric collection name
+// followed by a suffix.
+func GenerateCollectionID(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return fmt.Sprintf("%s-%d", b.String(), collectionCount.Add(1))
+}
+
+func collectionWithID(id string) zebedee.CollectionDescription {
+	desc := zebedee.NewCollection(id)
+	desc.ID = id
+	return desc
+}
+
+func collectionDetailsWithID(id string) zebedee.CollectionDetails {
+	var details zebedee.CollectionDetails
+	details.ID = id
+	details.Name = id
+	details.Teams = []string{}
+	details.InProgress = []zebedee.ContentDetail{}
+	details.Complete = []zebedee.ContentDetail{}
+	details.Reviewed = []zebedee.ContentDetail{}
+	return details
+}
@@ -0,0 +1,73 @@
+package clientmock
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// ensure the helpers can be used wherever the SDK interfaces are expected
+var _ zebedee.Client = NewClient()
+
+func TestNewClient(t *testing.T) {
+	Convey("Given a happy path client mock", t, func() {
+		cli := NewClient()
+		s := zebedee.Session{Email: "editor@ons.gov.uk", ID: SessionID}
+
+		Convey("When a collection is created", func() {
+			desc, err := cli.CreateCollection(s, zebedee.NewCollection("My Collection"))
+
+			Convey("Then the description is echoed back with a generated ID", func() {
+				So(err, ShouldBeNil)
+				So(desc.Name, ShouldEqual, "My Collection")
+				So(desc.ID, ShouldStartWith, "mycollection-")
+				So(cli.CreateCollectionCalls(), ShouldHaveLength, 1)
+			})
+
+			Convey("And a second collection receives a different ID", func() {
+				other, err := cli.CreateCollectionContext(context.Background(), s, zebedee.NewCollection("My Collection"))
+				So(err, ShouldBeNil)
+				So(other.ID, ShouldNotEqual, desc.ID)
+			})
+		})
+
+		Convey("When a session is opened", func() {
+			sess, err := cli.OpenSession(zebedee.Credentials{Email: "editor@ons.gov.uk", Password: "password"})
+
+			Convey("Then a session for the user is returned", func() {
+				So(err, ShouldBeNil)
+				So(sess, ShouldResemble, s)
+			})
+		})
+
+		Convey("When a mock func is replaced", func() {
+			cli.GetContentFunc = func(s zebedee.Session, collectionName string, uri string) ([]byte, error) {
+				return nil, zebedee.ErrNotFound
+			}
+
+			Convey("Then the replacement is called", func() {
+				_, err := cli.GetContent(s, "collection-id", "/economy")
+				So(err, ShouldEqual, zebedee.ErrNotFound)
+			})
+		})
+	})
+}
+
+func TestNewClient_AllFuncsSet(t *testing.T) {
+	Convey("Given a happy path client mock", t, func() {
+		v := reflect.ValueOf(NewClient()).Elem()
+
+		Convey("Then every mock func is set", func() {
+			for i := 0; i < v.NumField(); i++ {
+				name := v.Type().Field(i).Name
+				if strings.HasSuffix(name, "Func") {
+					So(v.Field(i).IsNil(), ShouldBeFalse)
+				}
+			}
+		})
+	})
+}
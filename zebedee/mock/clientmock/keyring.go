@@ -0,0 +1,129 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package clientmock
+
+import (
+	"context"
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	"sync"
+)
+
+var (
+	lockKeyringAPIMockListUserKeyring        sync.RWMutex
+	lockKeyringAPIMockListUserKeyringContext sync.RWMutex
+)
+
+// Ensure, that KeyringAPIMock does implement KeyringAPI.
+// If this is not the case, regenerate this file with moq.
+var _ zebedee.KeyringAPI = &KeyringAPIMock{}
+
+// KeyringAPIMock is a mock implementation of KeyringAPI.
+//
+//	    func TestSomethingThatUsesKeyringAPI(t *testing.T) {
+//
+//	        // make and configure a mocked KeyringAPI
+//	        mockedKeyringAPI := &KeyringAPIMock{
+//	            ListUserKeyringFunc: func(s zebedee.Session) ([]string, error) {
+//		               panic("mock out the ListUserKeyring method")
+//	            },
+//	            ListUserKeyringContextFunc: func(ctx context.Context, s zebedee.Session) ([]string, error) {
+//		               panic("mock out the ListUserKeyringContext method")
+//	            },
+//	        }
+//
+//	        // use mockedKeyringAPI in code that requires KeyringAPI
+//	        // and then make assertions.
+//
+//	    }
+type KeyringAPIMock struct {
+	// ListUserKeyringFunc mocks the ListUserKeyring method.
+	ListUserKeyringFunc func(s zebedee.Session) ([]string, error)
+
+	// ListUserKeyringContextFunc mocks the ListUserKeyringContext method.
+	ListUserKeyringContextFunc func(ctx context.Context, s zebedee.Session) ([]string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListUserKeyring holds details about calls to the ListUserKeyring method.
+		ListUserKeyring []struct {
+			// S is the s argument value.
+			S zebedee.Session
+		}
+		// ListUserKeyringContext holds details about calls to the ListUserKeyringContext method.
+		ListUserKeyringContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+		}
+	}
+}
+
+// ListUserKeyring calls ListUserKeyringFunc.
+func (mock *KeyringAPIMock) ListUserKeyring(s zebedee.Session) ([]string, error) {
+	if mock.ListUserKeyringFunc == nil {
+		panic("KeyringAPIMock.ListUserKeyringFunc: method is nil but KeyringAPI.ListUserKeyring was just called")
+	}
+	callInfo := struct {
+		S zebedee.Session
+	}{
+		S: s,
+	}
+	lockKeyringAPIMockListUserKeyring.Lock()
+	mock.calls.ListUserKeyring = append(mock.calls.ListUserKeyring, callInfo)
+	lockKeyringAPIMockListUserKeyring.Unlock()
+	return mock.ListUserKeyringFunc(s)
+}
+
+// ListUserKeyringCalls gets all the calls that were made to ListUserKeyring.
+// Check the length with:
+//
+//	len(mockedKeyringAPI.ListUserKeyringCalls())
+func (mock *KeyringAPIMock) ListUserKeyringCalls() []struct {
+	S zebedee.Session
+} {
+	var calls []struct {
+		S zebedee.Session
+	}
+	lockKeyringAPIMockListUserKeyring.RLock()
+	calls = mock.calls.ListUserKeyring
+	lockKeyringAPIMockListUserKeyring.RUnlock()
+	return calls
+}
+
+// ListUserKeyringContext calls ListUserKeyringContextFunc.
+func (mock *KeyringAPIMock) ListUserKeyringContext(ctx context.Context, s zebedee.Session) ([]string, error) {
+	if mock.ListUserKeyringContextFunc == nil {
+		panic("KeyringAPIMock.ListUserKeyringContextFunc: method is nil but KeyringAPI.ListUserKeyringContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+	}{
+		Ctx: ctx,
+		S:   s,
+	}
+	lockKeyringAPIMockListUserKeyringContext.Lock()
+	mock.calls.ListUserKeyringContext = append(mock.calls.ListUserKeyringContext, callInfo)
+	lockKeyringAPIMockListUserKeyringContext.Unlock()
+	return mock.ListUserKeyringContextFunc(ctx, s)
+}
+
+// ListUserKeyringContextCalls gets all the calls that were made to ListUserKeyringContext.
+// Check the length with:
+//
+//	len(mockedKeyringAPI.ListUserKeyringContextCalls())
+func (mock *KeyringAPIMock) ListUserKeyringContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+	}
+	lockKeyringAPIMockListUserKeyringContext.RLock()
+	calls = mock.calls.ListUserKeyringContext
+	lockKeyringAPIMockListUserKeyringContext.RUnlock()
+	return calls
+}
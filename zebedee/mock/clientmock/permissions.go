@@ -0,0 +1,247 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package clientmock
+
+import (
+	"context"
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	"sync"
+)
+
+var (
+	lockPermissionsAPIMockGetPermissions        sync.RWMutex
+	lockPermissionsAPIMockGetPermissionsContext sync.RWMutex
+	lockPermissionsAPIMockSetPermissions        sync.RWMutex
+	lockPermissionsAPIMockSetPermissionsContext sync.RWMutex
+)
+
+// Ensure, that PermissionsAPIMock does implement PermissionsAPI.
+// If this is not the case, regenerate this file with moq.
+var _ zebedee.PermissionsAPI = &PermissionsAPIMock{}
+
+// PermissionsAPIMock is a mock implementation of PermissionsAPI.
+//
+//	    func TestSomethingThatUsesPermissionsAPI(t *testing.T) {
+//
+//	        // make and configure a mocked PermissionsAPI
+//	        mockedPermissionsAPI := &PermissionsAPIMock{
+//	            GetPermissionsFunc: func(s zebedee.Session, email string) (zebedee.Permissions, error) {
+//		               panic("mock out the GetPermissions method")
+//	            },
+//	            GetPermissionsContextFunc: func(ctx context.Context, s zebedee.Session, email string) (zebedee.Permissions, error) {
+//		               panic("mock out the GetPermissionsContext method")
+//	            },
+//	            SetPermissionsFunc: func(s zebedee.Session, p zebedee.Permissions) error {
+//		               panic("mock out the SetPermissions method")
+//	            },
+//	            SetPermissionsContextFunc: func(ctx context.Context, s zebedee.Session, p zebedee.Permissions) error {
+//		               panic("mock out the SetPermissionsContext method")
+//	            },
+//	        }
+//
+//	        // use mockedPermissionsAPI in code that requires PermissionsAPI
+//	        // and then make assertions.
+//
+//	    }
+type PermissionsAPIMock struct {
+	// GetPermissionsFunc mocks the GetPermissions method.
+	GetPermissionsFunc func(s zebedee.Session, email string) (zebedee.Permissions, error)
+
+	// GetPermissionsContextFunc mocks the GetPermissionsContext method.
+	GetPermissionsContextFunc func(ctx context.Context, s zebedee.Session, email string) (zebedee.Permissions, error)
+
+	// SetPermissionsFunc mocks the SetPermissions method.
+	SetPermissionsFunc func(s zebedee.Session, p zebedee.Permissions) error
+
+	// SetPermissionsContextFunc mocks the SetPermissionsContext method.
+	SetPermissionsContextFunc func(ctx context.Context, s zebedee.Session, p zebedee.Permissions) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetPermissions holds details about calls to the GetPermissions method.
+		GetPermissions []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// Email is the email argument value.
+			Email string
+		}
+		// GetPermissionsContext holds details about calls to the GetPermissionsContext method.
+		GetPermissionsContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// Email is the email argument value.
+			Email string
+		}
+		// SetPermissions holds details about calls to the SetPermissions method.
+		SetPermissions []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// P is the p argument value.
+			P zebedee.Permissions
+		}
+		// SetPermissionsContext holds details about calls to the SetPermissionsContext method.
+		SetPermissionsContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// P is the p argument value.
+			P zebedee.Permissions
+		}
+	}
+}
+
+// GetPermissions calls GetPermissionsFunc.
+func (mock *PermissionsAPIMock) GetPermissions(s zebedee.Session, email string) (zebedee.Permissions, error) {
+	if mock.GetPermissionsFunc == nil {
+		panic("PermissionsAPIMock.GetPermissionsFunc: method is nil but PermissionsAPI.GetPermissions was just called")
+	}
+	callInfo := struct {
+		S     zebedee.Session
+		Email string
+	}{
+		S:     s,
+		Email: email,
+	}
+	lockPermissionsAPIMockGetPermissions.Lock()
+	mock.calls.GetPermissions = append(mock.calls.GetPermissions, callInfo)
+	lockPermissionsAPIMockGetPermissions.Unlock()
+	return mock.GetPermissionsFunc(s, email)
+}
+
+// GetPermissionsCalls gets all the calls that were made to GetPermissions.
+// Check the length with:
+//
+//	len(mockedPermissionsAPI.GetPermissionsCalls())
+func (mock *PermissionsAPIMock) GetPermissionsCalls() []struct {
+	S     zebedee.Session
+	Email string
+} {
+	var calls []struct {
+		S     zebedee.Session
+		Email string
+	}
+	lockPermissionsAPIMockGetPermissions.RLock()
+	calls = mock.calls.GetPermissions
+	lockPermissionsAPIMockGetPermissions.RUnlock()
+	return calls
+}
+
+// GetPermissionsContext calls GetPermissionsContextFunc.
+func (mock *PermissionsAPIMock) GetPermissionsContext(ctx context.Context, s zebedee.Session, email string) (zebedee.Permissions, error) {
+	if mock.GetPermissionsContextFunc == nil {
+		panic("PermissionsAPIMock.GetPermissionsContextFunc: method is nil but PermissionsAPI.GetPermissionsContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		S     zebedee.Session
+		Email string
+	}{
+		Ctx:   ctx,
+		S:     s,
+		Email: email,
+	}
+	lockPermissionsAPIMockGetPermissionsContext.Lock()
+	mock.calls.GetPermissionsContext = append(mock.calls.GetPermissionsContext, callInfo)
+	lockPermissionsAPIMockGetPermissionsContext.Unlock()
+	return mock.GetPermissionsContextFunc(ctx, s, email)
+}
+
+// GetPermissionsContextCalls gets all the calls that were made to GetPermissionsContext.
+// Check the length with:
+//
+//	len(mockedPermissionsAPI.GetPermissionsContextCalls())
+func (mock *PermissionsAPIMock) GetPermissionsContextCalls() []struct {
+	Ctx   context.Context
+	S     zebedee.Session
+	Email string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		S     zebedee.Session
+		Email string
+	}
+	lockPermissionsAPIMockGetPermissionsContext.RLock()
+	calls = mock.calls.GetPermissionsContext
+	lockPermissionsAPIMockGetPermissionsContext.RUnlock()
+	return calls
+}
+
+// SetPermissions calls SetPermissionsFunc.
+func (mock *PermissionsAPIMock) SetPermissions(s zebedee.Session, p zebedee.Permissions) error {
+	if mock.SetPermissionsFunc == nil {
+		panic("PermissionsAPIMock.SetPermissionsFunc: method is nil but PermissionsAPI.SetPermissions was just called")
+	}
+	callInfo := struct {
+		S zebedee.Session
+		P zebedee.Permissions
+	}{
+		S: s,
+		P: p,
+	}
+	lockPermissionsAPIMockSetPermissions.Lock()
+	mock.calls.SetPermissions = append(mock.calls.SetPermissions, callInfo)
+	lockPermissionsAPIMockSetPermissions.Unlock()
+	return mock.SetPermissionsFunc(s, p)
+}
+
+// SetPermissionsCalls gets all the calls that were made to SetPermissions.
+// Check the length with:
+//
+//	len(mockedPermissionsAPI.SetPermissionsCalls())
+func (mock *PermissionsAPIMock) SetPermissionsCalls() []struct {
+	S zebedee.Session
+	P zebedee.Permissions
+} {
+	var calls []struct {
+		S zebedee.Session
+		P zebedee.Permissions
+	}
+	lockPermissionsAPIMockSetPermissions.RLock()
+	calls = mock.calls.SetPermissions
+	lockPermissionsAPIMockSetPermissions.RUnlock()
+	return calls
+}
+
+// SetPermissionsContext calls SetPermissionsContextFunc.
+func (mock *PermissionsAPIMock) SetPermissionsContext(ctx context.Context, s zebedee.Session, p zebedee.Permissions) error {
+	if mock.SetPermissionsContextFunc == nil {
+		panic("PermissionsAPIMock.SetPermissionsContextFunc: method is nil but PermissionsAPI.SetPermissionsContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		P   zebedee.Permissions
+	}{
+		Ctx: ctx,
+		S:   s,
+		P:   p,
+	}
+	lockPermissionsAPIMockSetPermissionsContext.Lock()
+	mock.calls.SetPermissionsContext = append(mock.calls.SetPermissionsContext, callInfo)
+	lockPermissionsAPIMockSetPermissionsContext.Unlock()
+	return mock.SetPermissionsContextFunc(ctx, s, p)
+}
+
+// SetPermissionsContextCalls gets all the calls that were made to SetPermissionsContext.
+// Check the length with:
+//
+//	len(mockedPermissionsAPI.SetPermissionsContextCalls())
+func (mock *PermissionsAPIMock) SetPermissionsContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	P   zebedee.Permissions
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		P   zebedee.Permissions
+	}
+	lockPermissionsAPIMockSetPermissionsContext.RLock()
+	calls = mock.calls.SetPermissionsContext
+	lockPermissionsAPIMockSetPermissionsContext.RUnlock()
+	return calls
+}
@@ -0,0 +1,410 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package clientmock
+
+import (
+	"context"
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	"io"
+	"sync"
+)
+
+var (
+	lockPublishedContentAPIMockGetPublishedContent          sync.RWMutex
+	lockPublishedContentAPIMockGetPublishedContentContext   sync.RWMutex
+	lockPublishedContentAPIMockGetPublishedContentStream    sync.RWMutex
+	lockPublishedContentAPIMockGetPublishedData             sync.RWMutex
+	lockPublishedContentAPIMockGetPublishedDataContext      sync.RWMutex
+	lockPublishedContentAPIMockListPublishedChildren        sync.RWMutex
+	lockPublishedContentAPIMockListPublishedChildrenContext sync.RWMutex
+)
+
+// Ensure, that PublishedContentAPIMock does implement PublishedContentAPI.
+// If this is not the case, regenerate this file with moq.
+var _ zebedee.PublishedContentAPI = &PublishedContentAPIMock{}
+
+// PublishedContentAPIMock is a mock implementation of PublishedContentAPI.
+//
+//	    func TestSomethingThatUsesPublishedContentAPI(t *testing.T) {
+//
+//	        // make and configure a mocked PublishedContentAPI
+//	        mockedPublishedContentAPI := &PublishedContentAPIMock{
+//	            GetPublishedContentFunc: func(s zebedee.Session, uri string) ([]byte, error) {
+//		               panic("mock out the GetPublishedContent method")
+//	            },
+//	            GetPublishedContentContextFunc: func(ctx context.Context, s zebedee.Session, uri string) ([]byte, error) {
+//		               panic("mock out the GetPublishedContentContext method")
+//	            },
+//	            GetPublishedContentStreamFunc: func(ctx context.Context, s zebedee.Session, uri string) (io.ReadCloser, zebedee.ContentInfo, error) {
+//		               panic("mock out the GetPublishedContentStream method")
+//	            },
+//	            GetPublishedDataFunc: func(s zebedee.Session, uri string) ([]byte, error) {
+//		               panic("mock out the GetPublishedData method")
+//	            },
+//	            GetPublishedDataContextFunc: func(ctx context.Context, s zebedee.Session, uri string) ([]byte, error) {
+//		               panic("mock out the GetPublishedDataContext method")
+//	            },
+//	            ListPublishedChildrenFunc: func(s zebedee.Session, uri string) ([]zebedee.ContentNode, error) {
+//		               panic("mock out the ListPublishedChildren method")
+//	            },
+//	            ListPublishedChildrenContextFunc: func(ctx context.Context, s zebedee.Session, uri string) ([]zebedee.ContentNode, error) {
+//		               panic("mock out the ListPublishedChildrenContext method")
+//	            },
+//	        }
+//
+//	        // use mockedPublishedContentAPI in code that requires PublishedContentAPI
+//	        // and then make assertions.
+//
+//	    }
+type PublishedContentAPIMock struct {
+	// GetPublishedContentFunc mocks the GetPublishedContent method.
+	GetPublishedContentFunc func(s zebedee.Session, uri string) ([]byte, error)
+
+	// GetPublishedContentContextFunc mocks the GetPublishedContentContext method.
+	GetPublishedContentContextFunc func(ctx context.Context, s zebedee.Session, uri string) ([]byte, error)
+
+	// GetPublishedContentStreamFunc mocks the GetPublishedContentStream method.
+	GetPublishedContentStreamFunc func(ctx context.Context, s zebedee.Session, uri string) (io.ReadCloser, zebedee.ContentInfo, error)
+
+	// GetPublishedDataFunc mocks the GetPublishedData method.
+	GetPublishedDataFunc func(s zebedee.Session, uri string) ([]byte, error)
+
+	// GetPublishedDataContextFunc mocks the GetPublishedDataContext method.
+	GetPublishedDataContextFunc func(ctx context.Context, s zebedee.Session, uri string) ([]byte, error)
+
+	// ListPublishedChildrenFunc mocks the ListPublishedChildren method.
+	ListPublishedChildrenFunc func(s zebedee.Session, uri string) ([]zebedee.ContentNode, error)
+
+	// ListPublishedChildrenContextFunc mocks the ListPublishedChildrenContext method.
+	ListPublishedChildrenContextFunc func(ctx context.Context, s zebedee.Session, uri string) ([]zebedee.ContentNode, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetPublishedContent holds details about calls to the GetPublishedContent method.
+		GetPublishedContent []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// URI is the uri argument value.
+			URI string
+		}
+		// GetPublishedContentContext holds details about calls to the GetPublishedContentContext method.
+		GetPublishedContentContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// URI is the uri argument value.
+			URI string
+		}
+		// GetPublishedContentStream holds details about calls to the GetPublishedContentStream method.
+		GetPublishedContentStream []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// URI is the uri argument value.
+			URI string
+		}
+		// GetPublishedData holds details about calls to the GetPublishedData method.
+		GetPublishedData []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// URI is the uri argument value.
+			URI string
+		}
+		// GetPublishedDataContext holds details about calls to the GetPublishedDataContext method.
+		GetPublishedDataContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// URI is the uri argument value.
+			URI string
+		}
+		// ListPublishedChildren holds details about calls to the ListPublishedChildren method.
+		ListPublishedChildren []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// URI is the uri argument value.
+			URI string
+		}
+		// ListPublishedChildrenContext holds details about calls to the ListPublishedChildrenContext method.
+		ListPublishedChildrenContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// URI is the uri argument value.
+			URI string
+		}
+	}
+}
+
+// GetPublishedContent calls GetPublishedContentFunc.
+func (mock *PublishedContentAPIMock) GetPublishedContent(s zebedee.Session, uri string) ([]byte, error) {
+	if mock.GetPublishedContentFunc == nil {
+		panic("PublishedContentAPIMock.GetPublishedContentFunc: method is nil but PublishedContentAPI.GetPublishedContent was just called")
+	}
+	callInfo := struct {
+		S   zebedee.Session
+		URI string
+	}{
+		S:   s,
+		URI: uri,
+	}
+	lockPublishedContentAPIMockGetPublishedContent.Lock()
+	mock.calls.GetPublishedContent = append(mock.calls.GetPublishedContent, callInfo)
+	lockPublishedContentAPIMockGetPublishedContent.Unlock()
+	return mock.GetPublishedContentFunc(s, uri)
+}
+
+// GetPublishedContentCalls gets all the calls that were made to GetPublishedContent.
+// Check the length with:
+//
+//	len(mockedPublishedContentAPI.GetPublishedContentCalls())
+func (mock *PublishedContentAPIMock) GetPublishedContentCalls() []struct {
+	S   zebedee.Session
+	URI string
+} {
+	var calls []struct {
+		S   zebedee.Session
+		URI string
+	}
+	lockPublishedContentAPIMockGetPublishedContent.RLock()
+	calls = mock.calls.GetPublishedContent
+	lockPublishedContentAPIMockGetPublishedContent.RUnlock()
+	return calls
+}
+
+// GetPublishedContentContext calls GetPublishedContentContextFunc.
+func (mock *PublishedContentAPIMock) GetPublishedContentContext(ctx context.Context, s zebedee.Session, uri string) ([]byte, error) {
+	if mock.GetPublishedContentContextFunc == nil {
+		panic("PublishedContentAPIMock.GetPublishedContentContextFunc: method is nil but PublishedContentAPI.GetPublishedContentContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		URI string
+	}{
+		Ctx: ctx,
+		S:   s,
+		URI: uri,
+	}
+	lockPublishedContentAPIMockGetPublishedContentContext.Lock()
+	mock.calls.GetPublishedContentContext = append(mock.calls.GetPublishedContentContext, callInfo)
+	lockPublishedContentAPIMockGetPublishedContentContext.Unlock()
+	return mock.GetPublishedContentContextFunc(ctx, s, uri)
+}
+
+// GetPublishedContentContextCalls gets all the calls that were made to GetPublishedContentContext.
+// Check the length with:
+//
+//	len(mockedPublishedContentAPI.GetPublishedContentContextCalls())
+func (mock *PublishedContentAPIMock) GetPublishedContentContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	URI string
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		URI string
+	}
+	lockPublishedContentAPIMockGetPublishedContentContext.RLock()
+	calls = mock.calls.GetPublishedContentContext
+	lockPublishedContentAPIMockGetPublishedContentContext.RUnlock()
+	return calls
+}
+
+// GetPublishedContentStream calls GetPublishedContentStreamFunc.
+func (mock *PublishedContentAPIMock) GetPublishedContentStream(ctx context.Context, s zebedee.Session, uri string) (io.ReadCloser, zebedee.ContentInfo, error) {
+	if mock.GetPublishedContentStreamFunc == nil {
+		panic("PublishedContentAPIMock.GetPublishedContentStreamFunc: method is nil but PublishedContentAPI.GetPublishedContentStream was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		URI string
+	}{
+		Ctx: ctx,
+		S:   s,
+		URI: uri,
+	}
+	lockPublishedContentAPIMockGetPublishedContentStream.Lock()
+	mock.calls.GetPublishedContentStream = append(mock.calls.GetPublishedContentStream, callInfo)
+	lockPublishedContentAPIMockGetPublishedContentStream.Unlock()
+	return mock.GetPublishedContentStreamFunc(ctx, s, uri)
+}
+
+// GetPublishedContentStreamCalls gets all the calls that were made to GetPublishedContentStream.
+// Check the length with:
+//
+//	len(mockedPublishedContentAPI.GetPublishedContentStreamCalls())
+func (mock *PublishedContentAPIMock) GetPublishedContentStreamCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	URI string
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		URI string
+	}
+	lockPublishedContentAPIMockGetPublishedContentStream.RLock()
+	calls = mock.calls.GetPublishedContentStream
+	lockPublishedContentAPIMockGetPublishedContentStream.RUnlock()
+	return calls
+}
+
+// GetPublishedData calls GetPublishedDataFunc.
+func (mock *PublishedContentAPIMock) GetPublishedData(s zebedee.Session, uri string) ([]byte, error) {
+	if mock.GetPublishedDataFunc == nil {
+		panic("PublishedContentAPIMock.GetPublishedDataFunc: method is nil but PublishedContentAPI.GetPublishedData was just called")
+	}
+	callInfo := struct {
+		S   zebedee.Session
+		URI string
+	}{
+		S:   s,
+		URI: uri,
+	}
+	lockPublishedContentAPIMockGetPublishedData.Lock()
+	mock.calls.GetPublishedData = append(mock.calls.GetPublishedData, callInfo)
+	lockPublishedContentAPIMockGetPublishedData.Unlock()
+	return mock.GetPublishedDataFunc(s, uri)
+}
+
+// GetPublishedDataCalls gets all the calls that were made to GetPublishedData.
+// Check the length with:
+//
+//	len(mockedPublishedContentAPI.GetPublishedDataCalls())
+func (mock *PublishedContentAPIMock) GetPublishedDataCalls() []struct {
+	S   zebedee.Session
+	URI string
+} {
+	var calls []struct {
+		S   zebedee.Session
+		URI string
+	}
+	lockPublishedContentAPIMockGetPublishedData.RLock()
+	calls = mock.calls.GetPublishedData
+	lockPublishedContentAPIMockGetPublishedData.RUnlock()
+	return calls
+}
+
+// GetPublishedDataContext calls GetPublishedDataContextFunc.
+func (mock *PublishedContentAPIMock) GetPublishedDataContext(ctx context.Context, s zebedee.Session, uri string) ([]byte, error) {
+	if mock.GetPublishedDataContextFunc == nil {
+		panic("PublishedContentAPIMock.GetPublishedDataContextFunc: method is nil but PublishedContentAPI.GetPublishedDataContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		URI string
+	}{
+		Ctx: ctx,
+		S:   s,
+		URI: uri,
+	}
+	lockPublishedContentAPIMockGetPublishedDataContext.Lock()
+	mock.calls.GetPublishedDataContext = append(mock.calls.GetPublishedDataContext, callInfo)
+	lockPublishedContentAPIMockGetPublishedDataContext.Unlock()
+	return mock.GetPublishedDataContextFunc(ctx, s, uri)
+}
+
+// GetPublishedDataContextCalls gets all the calls that were made to GetPublishedDataContext.
+// Check the length with:
+//
+//	len(mockedPublishedContentAPI.GetPublishedDataContextCalls())
+func (mock *PublishedContentAPIMock) GetPublishedDataContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	URI string
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		URI string
+	}
+	lockPublishedContentAPIMockGetPublishedDataContext.RLock()
+	calls = mock.calls.GetPublishedDataContext
+	lockPublishedContentAPIMockGetPublishedDataContext.RUnlock()
+	return calls
+}
+
+// ListPublishedChildren calls ListPublishedChildrenFunc.
+func (mock *PublishedContentAPIMock) ListPublishedChildren(s zebedee.Session, uri string) ([]zebedee.ContentNode, error) {
+	if mock.ListPublishedChildrenFunc == nil {
+		panic("PublishedContentAPIMock.ListPublishedChildrenFunc: method is nil but PublishedContentAPI.ListPublishedChildren was just called")
+	}
+	callInfo := struct {
+		S   zebedee.Session
+		URI string
+	}{
+		S:   s,
+		URI: uri,
+	}
+	lockPublishedContentAPIMockListPublishedChildren.Lock()
+	mock.calls.ListPublishedChildren = append(mock.calls.ListPublishedChildren, callInfo)
+	lockPublishedContentAPIMockListPublishedChildren.Unlock()
+	return mock.ListPublishedChildrenFunc(s, uri)
+}
+
+// ListPublishedChildrenCalls gets all the calls that were made to ListPublishedChildren.
+// Check the length with:
+//
+//	len(mockedPublishedContentAPI.ListPublishedChildrenCalls())
+func (mock *PublishedContentAPIMock) ListPublishedChildrenCalls() []struct {
+	S   zebedee.Session
+	URI string
+} {
+	var calls []struct {
+		S   zebedee.Session
+		URI string
+	}
+	lockPublishedContentAPIMockListPublishedChildren.RLock()
+	calls = mock.calls.ListPublishedChildren
+	lockPublishedContentAPIMockListPublishedChildren.RUnlock()
+	return calls
+}
+
+// ListPublishedChildrenContext calls ListPublishedChildrenContextFunc.
+func (mock *PublishedContentAPIMock) ListPublishedChildrenContext(ctx context.Context, s zebedee.Session, uri string) ([]zebedee.ContentNode, error) {
+	if mock.ListPublishedChildrenContextFunc == nil {
+		panic("PublishedContentAPIMock.ListPublishedChildrenContextFunc: method is nil but PublishedContentAPI.ListPublishedChildrenContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		URI string
+	}{
+		Ctx: ctx,
+		S:   s,
+		URI: uri,
+	}
+	lockPublishedContentAPIMockListPublishedChildrenContext.Lock()
+	mock.calls.ListPublishedChildrenContext = append(mock.calls.ListPublishedChildrenContext, callInfo)
+	lockPublishedContentAPIMockListPublishedChildrenContext.Unlock()
+	return mock.ListPublishedChildrenContextFunc(ctx, s, uri)
+}
+
+// ListPublishedChildrenContextCalls gets all the calls that were made to ListPublishedChildrenContext.
+// Check the length with:
+//
+//	len(mockedPublishedContentAPI.ListPublishedChildrenContextCalls())
+func (mock *PublishedContentAPIMock) ListPublishedChildrenContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	URI string
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		URI string
+	}
+	lockPublishedContentAPIMockListPublishedChildrenContext.RLock()
+	calls = mock.calls.ListPublishedChildrenContext
+	lockPublishedContentAPIMockListPublishedChildrenContext.RUnlock()
+	return calls
+}
@@ -0,0 +1,683 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package clientmock
+
+import (
+	"context"
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	"sync"
+)
+
+var (
+	lockTeamsAPIMockAddTeamMember           sync.RWMutex
+	lockTeamsAPIMockAddTeamMemberContext    sync.RWMutex
+	lockTeamsAPIMockCreateTeam              sync.RWMutex
+	lockTeamsAPIMockCreateTeamContext       sync.RWMutex
+	lockTeamsAPIMockDeleteTeam              sync.RWMutex
+	lockTeamsAPIMockDeleteTeamContext       sync.RWMutex
+	lockTeamsAPIMockGetTeam                 sync.RWMutex
+	lockTeamsAPIMockGetTeamContext          sync.RWMutex
+	lockTeamsAPIMockListTeams               sync.RWMutex
+	lockTeamsAPIMockListTeamsContext        sync.RWMutex
+	lockTeamsAPIMockRemoveTeamMember        sync.RWMutex
+	lockTeamsAPIMockRemoveTeamMemberContext sync.RWMutex
+)
+
+// Ensure, that TeamsAPIMock does implement TeamsAPI.
+// If this is not the case, regenerate this file with moq.
+var _ zebedee.TeamsAPI = &TeamsAPIMock{}
+
+// TeamsAPIMock is a mock implementation of TeamsAPI.
+//
+//	    func TestSomethingThatUsesTeamsAPI(t *testing.T) {
+//
+//	        // make and configure a mocked TeamsAPI
+//	        mockedTeamsAPI := &TeamsAPIMock{
+//	            AddTeamMemberFunc: func(s zebedee.Session, teamName string, email string) error {
+//		               panic("mock out the AddTeamMember method")
+//	            },
+//	            AddTeamMemberContextFunc: func(ctx context.Context, s zebedee.Session, teamName string, email string) error {
+//		               panic("mock out the AddTeamMemberContext method")
+//	            },
+//	            CreateTeamFunc: func(s zebedee.Session, teamName string) (bool, error) {
+//		               panic("mock out the CreateTeam method")
+//	            },
+//	            CreateTeamContextFunc: func(ctx context.Context, s zebedee.Session, teamName string) (bool, error) {
+//		               panic("mock out the CreateTeamContext method")
+//	            },
+//	            DeleteTeamFunc: func(s zebedee.Session, teamName string) error {
+//		               panic("mock out the DeleteTeam method")
+//	            },
+//	            DeleteTeamContextFunc: func(ctx context.Context, s zebedee.Session, teamName string) error {
+//		               panic("mock out the DeleteTeamContext method")
+//	            },
+//	            GetTeamFunc: func(s zebedee.Session, teamName string) (zebedee.Team, error) {
+//		               panic("mock out the GetTeam method")
+//	            },
+//	            GetTeamContextFunc: func(ctx context.Context, s zebedee.Session, teamName string) (zebedee.Team, error) {
+//		               panic("mock out the GetTeamContext method")
+//	            },
+//	            ListTeamsFunc: func(s zebedee.Session) (zebedee.TeamsList, error) {
+//		               panic("mock out the ListTeams method")
+//	            },
+//	            ListTeamsContextFunc: func(ctx context.Context, s zebedee.Session) (zebedee.TeamsList, error) {
+//		               panic("mock out the ListTeamsContext method")
+//	            },
+//	            RemoveTeamMemberFunc: func(s zebedee.Session, teamName string, email string) error {
+//		               panic("mock out the RemoveTeamMember method")
+//	            },
+//	            RemoveTeamMemberContextFunc: func(ctx context.Context, s zebedee.Session, teamName string, email string) error {
+//		               panic("mock out the RemoveTeamMemberContext method")
+//	            },
+//	        }
+//
+//	        // use mockedTeamsAPI in code that requires TeamsAPI
+//	        // and then make assertions.
+//
+//	    }
+type TeamsAPIMock struct {
+	// AddTeamMemberFunc mocks the AddTeamMember method.
+	AddTeamMemberFunc func(s zebedee.Session, teamName string, email string) error
+
+	// AddTeamMemberContextFunc mocks the AddTeamMemberContext method.
+	AddTeamMemberContextFunc func(ctx context.Context, s zebedee.Session, teamName string, email string) error
+
+	// CreateTeamFunc mocks the CreateTeam method.
+	CreateTeamFunc func(s zebedee.Session, teamName string) (bool, error)
+
+	// CreateTeamContextFunc mocks the CreateTeamContext method.
+	CreateTeamContextFunc func(ctx context.Context, s zebedee.Session, teamName string) (bool, error)
+
+	// DeleteTeamFunc mocks the DeleteTeam method.
+	DeleteTeamFunc func(s zebedee.Session, teamName string) error
+
+	// DeleteTeamContextFunc mocks the DeleteTeamContext method.
+	DeleteTeamContextFunc func(ctx context.Context, s zebedee.Session, teamName string) error
+
+	// GetTeamFunc mocks the GetTeam method.
+	GetTeamFunc func(s zebedee.Session, teamName string) (zebedee.Team, error)
+
+	// GetTeamContextFunc mocks the GetTeamContext method.
+	GetTeamContextFunc func(ctx context.Context, s zebedee.Session, teamName string) (zebedee.Team, error)
+
+	// ListTeamsFunc mocks the ListTeams method.
+	ListTeamsFunc func(s zebedee.Session) (zebedee.TeamsList, error)
+
+	// ListTeamsContextFunc mocks the ListTeamsContext method.
+	ListTeamsContextFunc func(ctx context.Context, s zebedee.Session) (zebedee.TeamsList, error)
+
+	// RemoveTeamMemberFunc mocks the RemoveTeamMember method.
+	RemoveTeamMemberFunc func(s zebedee.Session, teamName string, email string) error
+
+	// RemoveTeamMemberContextFunc mocks the RemoveTeamMemberContext method.
+	RemoveTeamMemberContextFunc func(ctx context.Context, s zebedee.Session, teamName string, email string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddTeamMember holds details about calls to the AddTeamMember method.
+		AddTeamMember []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// TeamName is the teamName argument value.
+			TeamName string
+			// Email is the email argument value.
+			Email string
+		}
+		// AddTeamMemberContext holds details about calls to the AddTeamMemberContext method.
+		AddTeamMemberContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// TeamName is the teamName argument value.
+			TeamName string
+			// Email is the email argument value.
+			Email string
+		}
+		// CreateTeam holds details about calls to the CreateTeam method.
+		CreateTeam []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// TeamName is the teamName argument value.
+			TeamName string
+		}
+		// CreateTeamContext holds details about calls to the CreateTeamContext method.
+		CreateTeamContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// TeamName is the teamName argument value.
+			TeamName string
+		}
+		// DeleteTeam holds details about calls to the DeleteTeam method.
+		DeleteTeam []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// TeamName is the teamName argument value.
+			TeamName string
+		}
+		// DeleteTeamContext holds details about calls to the DeleteTeamContext method.
+		DeleteTeamContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// TeamName is the teamName argument value.
+			TeamName string
+		}
+		// GetTeam holds details about calls to the GetTeam method.
+		GetTeam []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// TeamName is the teamName argument value.
+			TeamName string
+		}
+		// GetTeamContext holds details about calls to the GetTeamContext method.
+		GetTeamContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// TeamName is the teamName argument value.
+			TeamName string
+		}
+		// ListTeams holds details about calls to the ListTeams method.
+		ListTeams []struct {
+			// S is the s argument value.
+			S zebedee.Session
+		}
+		// ListTeamsContext holds details about calls to the ListTeamsContext method.
+		ListTeamsContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+		}
+		// RemoveTeamMember holds details about calls to the RemoveTeamMember method.
+		RemoveTeamMember []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// TeamName is the teamName argument value.
+			TeamName string
+			// Email is the email argument value.
+			Email string
+		}
+		// RemoveTeamMemberContext holds details about calls to the RemoveTeamMemberContext method.
+		RemoveTeamMemberContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// TeamName is the teamName argument value.
+			TeamName string
+			// Email is the email argument value.
+			Email string
+		}
+	}
+}
+
+// AddTeamMember calls AddTeamMemberFunc.
+func (mock *TeamsAPIMock) AddTeamMember(s zebedee.Session, teamName string, email string) error {
+	if mock.AddTeamMemberFunc == nil {
+		panic("TeamsAPIMock.AddTeamMemberFunc: method is nil but TeamsAPI.AddTeamMember was just called")
+	}
+	callInfo := struct {
+		S        zebedee.Session
+		TeamName string
+		Email    string
+	}{
+		S:        s,
+		TeamName: teamName,
+		Email:    email,
+	}
+	lockTeamsAPIMockAddTeamMember.Lock()
+	mock.calls.AddTeamMember = append(mock.calls.AddTeamMember, callInfo)
+	lockTeamsAPIMockAddTeamMember.Unlock()
+	return mock.AddTeamMemberFunc(s, teamName, email)
+}
+
+// AddTeamMemberCalls gets all the calls that were made to AddTeamMember.
+// Check the length with:
+//
+//	len(mockedTeamsAPI.AddTeamMemberCalls())
+func (mock *TeamsAPIMock) AddTeamMemberCalls() []struct {
+	S        zebedee.Session
+	TeamName string
+	Email    string
+} {
+	var calls []struct {
+		S        zebedee.Session
+		TeamName string
+		Email    string
+	}
+	lockTeamsAPIMockAddTeamMember.RLock()
+	calls = mock.calls.AddTeamMember
+	lockTeamsAPIMockAddTeamMember.RUnlock()
+	return calls
+}
+
+// AddTeamMemberContext calls AddTeamMemberContextFunc.
+func (mock *TeamsAPIMock) AddTeamMemberContext(ctx context.Context, s zebedee.Session, teamName string, email string) error {
+	if mock.AddTeamMemberContextFunc == nil {
+		panic("TeamsAPIMock.AddTeamMemberContextFunc: method is nil but TeamsAPI.AddTeamMemberContext was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		S        zebedee.Session
+		TeamName string
+		Email    string
+	}{
+		Ctx:      ctx,
+		S:        s,
+		TeamName: teamName,
+		Email:    email,
+	}
+	lockTeamsAPIMockAddTeamMemberContext.Lock()
+	mock.calls.AddTeamMemberContext = append(mock.calls.AddTeamMemberContext, callInfo)
+	lockTeamsAPIMockAddTeamMemberContext.Unlock()
+	return mock.AddTeamMemberContextFunc(ctx, s, teamName, email)
+}
+
+// AddTeamMemberContextCalls gets all the calls that were made to AddTeamMemberContext.
+// Check the length with:
+//
+//	len(mockedTeamsAPI.AddTeamMemberContextCalls())
+func (mock *TeamsAPIMock) AddTeamMemberContextCalls() []struct {
+	Ctx      context.Context
+	S        zebedee.Session
+	TeamName string
+	Email    string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		S        zebedee.Session
+		TeamName string
+		Email    string
+	}
+	lockTeamsAPIMockAddTeamMemberContext.RLock()
+	calls = mock.calls.AddTeamMemberContext
+	lockTeamsAPIMockAddTeamMemberContext.RUnlock()
+	return calls
+}
+
+// CreateTeam calls CreateTeamFunc.
+func (mock *TeamsAPIMock) CreateTeam(s zebedee.Session, teamName string) (bool, error) {
+	if mock.CreateTeamFunc == nil {
+		panic("TeamsAPIMock.CreateTeamFunc: method is nil but TeamsAPI.CreateTeam was just called")
+	}
+	callInfo := struct {
+		S        zebedee.Session
+		TeamName string
+	}{
+		S:        s,
+		TeamName: teamName,
+	}
+	lockTeamsAPIMockCreateTeam.Lock()
+	mock.calls.CreateTeam = append(mock.calls.CreateTeam, callInfo)
+	lockTeamsAPIMockCreateTeam.Unlock()
+	return mock.CreateTeamFunc(s, teamName)
+}
+
+// CreateTeamCalls gets all the calls that were made to CreateTeam.
+// Check the length with:
+//
+//	len(mockedTeamsAPI.CreateTeamCalls())
+func (mock *TeamsAPIMock) CreateTeamCalls() []struct {
+	S        zebedee.Session
+	TeamName string
+} {
+	var calls []struct {
+		S        zebedee.Session
+		TeamName string
+	}
+	lockTeamsAPIMockCreateTeam.RLock()
+	calls = mock.calls.CreateTeam
+	lockTeamsAPIMockCreateTeam.RUnlock()
+	return calls
+}
+
+// CreateTeamContext calls CreateTeamContextFunc.
+func (mock *TeamsAPIMock) CreateTeamContext(ctx context.Context, s zebedee.Session, teamName string) (bool, error) {
+	if mock.CreateTeamContextFunc == nil {
+		panic("TeamsAPIMock.CreateTeamContextFunc: method is nil but TeamsAPI.CreateTeamContext was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		S        zebedee.Session
+		TeamName string
+	}{
+		Ctx:      ctx,
+		S:        s,
+		TeamName: teamName,
+	}
+	lockTeamsAPIMockCreateTeamContext.Lock()
+	mock.calls.CreateTeamContext = append(mock.calls.CreateTeamContext, callInfo)
+	lockTeamsAPIMockCreateTeamContext.Unlock()
+	return mock.CreateTeamContextFunc(ctx, s, teamName)
+}
+
+// CreateTeamContextCalls gets all the calls that were made to CreateTeamContext.
+// Check the length with:
+//
+//	len(mockedTeamsAPI.CreateTeamContextCalls())
+func (mock *TeamsAPIMock) CreateTeamContextCalls() []struct {
+	Ctx      context.Context
+	S        zebedee.Session
+	TeamName string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		S        zebedee.Session
+		TeamName string
+	}
+	lockTeamsAPIMockCreateTeamContext.RLock()
+	calls = mock.calls.CreateTeamContext
+	lockTeamsAPIMockCreateTeamContext.RUnlock()
+	return calls
+}
+
+// DeleteTeam calls DeleteTeamFunc.
+func (mock *TeamsAPIMock) DeleteTeam(s zebedee.Session, teamName string) error {
+	if mock.DeleteTeamFunc == nil {
+		panic("TeamsAPIMock.DeleteTeamFunc: method is nil but TeamsAPI.DeleteTeam was just called")
+	}
+	callInfo := struct {
+		S        zebedee.Session
+		TeamName string
+	}{
+		S:        s,
+		TeamName: teamName,
+	}
+	lockTeamsAPIMockDeleteTeam.Lock()
+	mock.calls.DeleteTeam = append(mock.calls.DeleteTeam, callInfo)
+	lockTeamsAPIMockDeleteTeam.Unlock()
+	return mock.DeleteTeamFunc(s, teamName)
+}
+
+// DeleteTeamCalls gets all the calls that were made to DeleteTeam.
+// Check the length with:
+//
+//	len(mockedTeamsAPI.DeleteTeamCalls())
+func (mock *TeamsAPIMock) DeleteTeamCalls() []struct {
+	S        zebedee.Session
+	TeamName string
+} {
+	var calls []struct {
+		S        zebedee.Session
+		TeamName string
+	}
+	lockTeamsAPIMockDeleteTeam.RLock()
+	calls = mock.calls.DeleteTeam
+	lockTeamsAPIMockDeleteTeam.RUnlock()
+	return calls
+}
+
+// DeleteTeamContext calls DeleteTeamContextFunc.
+func (mock *TeamsAPIMock) DeleteTeamContext(ctx context.Context, s zebedee.Session, teamName string) error {
+	if mock.DeleteTeamContextFunc == nil {
+		panic("TeamsAPIMock.DeleteTeamContextFunc: method is nil but TeamsAPI.DeleteTeamContext was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		S        zebedee.Session
+		TeamName string
+	}{
+		Ctx:      ctx,
+		S:        s,
+		TeamName: teamName,
+	}
+	lockTeamsAPIMockDeleteTeamContext.Lock()
+	mock.calls.DeleteTeamContext = append(mock.calls.DeleteTeamContext, callInfo)
+	lockTeamsAPIMockDeleteTeamContext.Unlock()
+	return mock.DeleteTeamContextFunc(ctx, s, teamName)
+}
+
+// DeleteTeamContextCalls gets all the calls that were made to DeleteTeamContext.
+// Check the length with:
+//
+//	len(mockedTeamsAPI.DeleteTeamContextCalls())
+func (mock *TeamsAPIMock) DeleteTeamContextCalls() []struct {
+	Ctx      context.Context
+	S        zebedee.Session
+	TeamName string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		S        zebedee.Session
+		TeamName string
+	}
+	lockTeamsAPIMockDeleteTeamContext.RLock()
+	calls = mock.calls.DeleteTeamContext
+	lockTeamsAPIMockDeleteTeamContext.RUnlock()
+	return calls
+}
+
+// GetTeam calls GetTeamFunc.
+func (mock *TeamsAPIMock) GetTeam(s zebedee.Session, teamName string) (zebedee.Team, error) {
+	if mock.GetTeamFunc == nil {
+		panic("TeamsAPIMock.GetTeamFunc: method is nil but TeamsAPI.GetTeam was just called")
+	}
+	callInfo := struct {
+		S        zebedee.Session
+		TeamName string
+	}{
+		S:        s,
+		TeamName: teamName,
+	}
+	lockTeamsAPIMockGetTeam.Lock()
+	mock.calls.GetTeam = append(mock.calls.GetTeam, callInfo)
+	lockTeamsAPIMockGetTeam.Unlock()
+	return mock.GetTeamFunc(s, teamName)
+}
+
+// GetTeamCalls gets all the calls that were made to GetTeam.
+// Check the length with:
+//
+//	len(mockedTeamsAPI.GetTeamCalls())
+func (mock *TeamsAPIMock) GetTeamCalls() []struct {
+	S        zebedee.Session
+	TeamName string
+} {
+	var calls []struct {
+		S        zebedee.Session
+		TeamName string
+	}
+	lockTeamsAPIMockGetTeam.RLock()
+	calls = mock.calls.GetTeam
+	lockTeamsAPIMockGetTeam.RUnlock()
+	return calls
+}
+
+// GetTeamContext calls GetTeamContextFunc.
+func (mock *TeamsAPIMock) GetTeamContext(ctx context.Context, s zebedee.Session, teamName string) (zebedee.Team, error) {
+	if mock.GetTeamContextFunc == nil {
+		panic("TeamsAPIMock.GetTeamContextFunc: method is nil but TeamsAPI.GetTeamContext was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		S        zebedee.Session
+		TeamName string
+	}{
+		Ctx:      ctx,
+		S:        s,
+		TeamName: teamName,
+	}
+	lockTeamsAPIMockGetTeamContext.Lock()
+	mock.calls.GetTeamContext = append(mock.calls.GetTeamContext, callInfo)
+	lockTeamsAPIMockGetTeamContext.Unlock()
+	return mock.GetTeamContextFunc(ctx, s, teamName)
+}
+
+// GetTeamContextCalls gets all the calls that were made to GetTeamContext.
+// Check the length with:
+//
+//	len(mockedTeamsAPI.GetTeamContextCalls())
+func (mock *TeamsAPIMock) GetTeamContextCalls() []struct {
+	Ctx      context.Context
+	S        zebedee.Session
+	TeamName string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		S        zebedee.Session
+		TeamName string
+	}
+	lockTeamsAPIMockGetTeamContext.RLock()
+	calls = mock.calls.GetTeamContext
+	lockTeamsAPIMockGetTeamContext.RUnlock()
+	return calls
+}
+
+// ListTeams calls ListTeamsFunc.
+func (mock *TeamsAPIMock) ListTeams(s zebedee.Session) (zebedee.TeamsList, error) {
+	if mock.ListTeamsFunc == nil {
+		panic("TeamsAPIMock.ListTeamsFunc: method is nil but TeamsAPI.ListTeams was just called")
+	}
+	callInfo := struct {
+		S zebedee.Session
+	}{
+		S: s,
+	}
+	lockTeamsAPIMockListTeams.Lock()
+	mock.calls.ListTeams = append(mock.calls.ListTeams, callInfo)
+	lockTeamsAPIMockListTeams.Unlock()
+	return mock.ListTeamsFunc(s)
+}
+
+// ListTeamsCalls gets all the calls that were made to ListTeams.
+// Check the length with:
+//
+//	len(mockedTeamsAPI.ListTeamsCalls())
+func (mock *TeamsAPIMock) ListTeamsCalls() []struct {
+	S zebedee.Session
+} {
+	var calls []struct {
+		S zebedee.Session
+	}
+	lockTeamsAPIMockListTeams.RLock()
+	calls = mock.calls.ListTeams
+	lockTeamsAPIMockListTeams.RUnlock()
+	return calls
+}
+
+// ListTeamsContext calls ListTeamsContextFunc.
+func (mock *TeamsAPIMock) ListTeamsContext(ctx context.Context, s zebedee.Session) (zebedee.TeamsList, error) {
+	if mock.ListTeamsContextFunc == nil {
+		panic("TeamsAPIMock.ListTeamsContextFunc: method is nil but TeamsAPI.ListTeamsContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+	}{
+		Ctx: ctx,
+		S:   s,
+	}
+	lockTeamsAPIMockListTeamsContext.Lock()
+	mock.calls.ListTeamsContext = append(mock.calls.ListTeamsContext, callInfo)
+	lockTeamsAPIMockListTeamsContext.Unlock()
+	return mock.ListTeamsContextFunc(ctx, s)
+}
+
+// ListTeamsContextCalls gets all the calls that were made to ListTeamsContext.
+// Check the length with:
+//
+//	len(mockedTeamsAPI.ListTeamsContextCalls())
+func (mock *TeamsAPIMock) ListTeamsContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+	}
+	lockTeamsAPIMockListTeamsContext.RLock()
+	calls = mock.calls.ListTeamsContext
+	lockTeamsAPIMockListTeamsContext.RUnlock()
+	return calls
+}
+
+// RemoveTeamMember calls RemoveTeamMemberFunc.
+func (mock *TeamsAPIMock) RemoveTeamMember(s zebedee.Session, teamName string, email string) error {
+	if mock.RemoveTeamMemberFunc == nil {
+		panic("TeamsAPIMock.RemoveTeamMemberFunc: method is nil but TeamsAPI.RemoveTeamMember was just called")
+	}
+	callInfo := struct {
+		S        zebedee.Session
+		TeamName string
+		Email    string
+	}{
+		S:        s,
+		TeamName: teamName,
+		Email:    email,
+	}
+	lockTeamsAPIMockRemoveTeamMember.Lock()
+	mock.calls.RemoveTeamMember = append(mock.calls.RemoveTeamMember, callInfo)
+	lockTeamsAPIMockRemoveTeamMember.Unlock()
+	return mock.RemoveTeamMemberFunc(s, teamName, email)
+}
+
+// RemoveTeamMemberCalls gets all the calls that were made to RemoveTeamMember.
+// Check the length with:
+//
+//	len(mockedTeamsAPI.RemoveTeamMemberCalls())
+func (mock *TeamsAPIMock) RemoveTeamMemberCalls() []struct {
+	S        zebedee.Session
+	TeamName string
+	Email    string
+} {
+	var calls []struct {
+		S        zebedee.Session
+		TeamName string
+		Email    string
+	}
+	lockTeamsAPIMockRemoveTeamMember.RLock()
+	calls = mock.calls.RemoveTeamMember
+	lockTeamsAPIMockRemoveTeamMember.RUnlock()
+	return calls
+}
+
+// RemoveTeamMemberContext calls RemoveTeamMemberContextFunc.
+func (mock *TeamsAPIMock) RemoveTeamMemberContext(ctx context.Context, s zebedee.Session, teamName string, email string) error {
+	if mock.RemoveTeamMemberContextFunc == nil {
+		panic("TeamsAPIMock.RemoveTeamMemberContextFunc: method is nil but TeamsAPI.RemoveTeamMemberContext was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		S        zebedee.Session
+		TeamName string
+		Email    string
+	}{
+		Ctx:      ctx,
+		S:        s,
+		TeamName: teamName,
+		Email:    email,
+	}
+	lockTeamsAPIMockRemoveTeamMemberContext.Lock()
+	mock.calls.RemoveTeamMemberContext = append(mock.calls.RemoveTeamMemberContext, callInfo)
+	lockTeamsAPIMockRemoveTeamMemberContext.Unlock()
+	return mock.RemoveTeamMemberContextFunc(ctx, s, teamName, email)
+}
+
+// RemoveTeamMemberContextCalls gets all the calls that were made to RemoveTeamMemberContext.
+// Check the length with:
+//
+//	len(mockedTeamsAPI.RemoveTeamMemberContextCalls())
+func (mock *TeamsAPIMock) RemoveTeamMemberContextCalls() []struct {
+	Ctx      context.Context
+	S        zebedee.Session
+	TeamName string
+	Email    string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		S        zebedee.Session
+		TeamName string
+		Email    string
+	}
+	lockTeamsAPIMockRemoveTeamMemberContext.RLock()
+	calls = mock.calls.RemoveTeamMemberContext
+	lockTeamsAPIMockRemoveTeamMemberContext.RUnlock()
+	return calls
+}
@@ -0,0 +1,553 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package clientmock
+
+import (
+	"context"
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	"sync"
+)
+
+var (
+	lockUsersAPIMockCreateUser         sync.RWMutex
+	lockUsersAPIMockCreateUserContext  sync.RWMutex
+	lockUsersAPIMockDeleteUser         sync.RWMutex
+	lockUsersAPIMockDeleteUserContext  sync.RWMutex
+	lockUsersAPIMockGetUser            sync.RWMutex
+	lockUsersAPIMockGetUserContext     sync.RWMutex
+	lockUsersAPIMockGetUsers           sync.RWMutex
+	lockUsersAPIMockGetUsersContext    sync.RWMutex
+	lockUsersAPIMockSetPassword        sync.RWMutex
+	lockUsersAPIMockSetPasswordContext sync.RWMutex
+)
+
+// Ensure, that UsersAPIMock does implement UsersAPI.
+// If this is not the case, regenerate this file with moq.
+var _ zebedee.UsersAPI = &UsersAPIMock{}
+
+// UsersAPIMock is a mock implementation of UsersAPI.
+//
+//	    func TestSomethingThatUsesUsersAPI(t *testing.T) {
+//
+//	        // make and configure a mocked UsersAPI
+//	        mockedUsersAPI := &UsersAPIMock{
+//	            CreateUserFunc: func(s zebedee.Session, u zebedee.User) (zebedee.User, error) {
+//		               panic("mock out the CreateUser method")
+//	            },
+//	            CreateUserContextFunc: func(ctx context.Context, s zebedee.Session, u zebedee.User) (zebedee.User, error) {
+//		               panic("mock out the CreateUserContext method")
+//	            },
+//	            DeleteUserFunc: func(s zebedee.Session, email string) error {
+//		               panic("mock out the DeleteUser method")
+//	            },
+//	            DeleteUserContextFunc: func(ctx context.Context, s zebedee.Session, email string) error {
+//		               panic("mock out the DeleteUserContext method")
+//	            },
+//	            GetUserFunc: func(s zebedee.Session, email string) (zebedee.User, error) {
+//		               panic("mock out the GetUser method")
+//	            },
+//	            GetUserContextFunc: func(ctx context.Context, s zebedee.Session, email string) (zebedee.User, error) {
+//		               panic("mock out the GetUserContext method")
+//	            },
+//	            GetUsersFunc: func(s zebedee.Session) ([]zebedee.User, error) {
+//		               panic("mock out the GetUsers method")
+//	            },
+//	            GetUsersContextFunc: func(ctx context.Context, s zebedee.Session) ([]zebedee.User, error) {
+//		               panic("mock out the GetUsersContext method")
+//	            },
+//	            SetPasswordFunc: func(s zebedee.Session, c zebedee.Credentials) error {
+//		               panic("mock out the SetPassword method")
+//	            },
+//	            SetPasswordContextFunc: func(ctx context.Context, s zebedee.Session, c zebedee.Credentials) error {
+//		               panic("mock out the SetPasswordContext method")
+//	            },
+//	        }
+//
+//	        // use mockedUsersAPI in code that requires UsersAPI
+//	        // and then make assertions.
+//
+//	    }
+type UsersAPIMock struct {
+	// CreateUserFunc mocks the CreateUser method.
+	CreateUserFunc func(s zebedee.Session, u zebedee.User) (zebedee.User, error)
+
+	// CreateUserContextFunc mocks the CreateUserContext method.
+	CreateUserContextFunc func(ctx context.Context, s zebedee.Session, u zebedee.User) (zebedee.User, error)
+
+	// DeleteUserFunc mocks the DeleteUser method.
+	DeleteUserFunc func(s zebedee.Session, email string) error
+
+	// DeleteUserContextFunc mocks the DeleteUserContext method.
+	DeleteUserContextFunc func(ctx context.Context, s zebedee.Session, email string) error
+
+	// GetUserFunc mocks the GetUser method.
+	GetUserFunc func(s zebedee.Session, email string) (zebedee.User, error)
+
+	// GetUserContextFunc mocks the GetUserContext method.
+	GetUserContextFunc func(ctx context.Context, s zebedee.Session, email string) (zebedee.User, error)
+
+	// GetUsersFunc mocks the GetUsers method.
+	GetUsersFunc func(s zebedee.Session) ([]zebedee.User, error)
+
+	// GetUsersContextFunc mocks the GetUsersContext method.
+	GetUsersContextFunc func(ctx context.Context, s zebedee.Session) ([]zebedee.User, error)
+
+	// SetPasswordFunc mocks the SetPassword method.
+	SetPasswordFunc func(s zebedee.Session, c zebedee.Credentials) error
+
+	// SetPasswordContextFunc mocks the SetPasswordContext method.
+	SetPasswordContextFunc func(ctx context.Context, s zebedee.Session, c zebedee.Credentials) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateUser holds details about calls to the CreateUser method.
+		CreateUser []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// U is the u argument value.
+			U zebedee.User
+		}
+		// CreateUserContext holds details about calls to the CreateUserContext method.
+		CreateUserContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// U is the u argument value.
+			U zebedee.User
+		}
+		// DeleteUser holds details about calls to the DeleteUser method.
+		DeleteUser []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// Email is the email argument value.
+			Email string
+		}
+		// DeleteUserContext holds details about calls to the DeleteUserContext method.
+		DeleteUserContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// Email is the email argument value.
+			Email string
+		}
+		// GetUser holds details about calls to the GetUser method.
+		GetUser []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// Email is the email argument value.
+			Email string
+		}
+		// GetUserContext holds details about calls to the GetUserContext method.
+		GetUserContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// Email is the email argument value.
+			Email string
+		}
+		// GetUsers holds details about calls to the GetUsers method.
+		GetUsers []struct {
+			// S is the s argument value.
+			S zebedee.Session
+		}
+		// GetUsersContext holds details about calls to the GetUsersContext method.
+		GetUsersContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+		}
+		// SetPassword holds details about calls to the SetPassword method.
+		SetPassword []struct {
+			// S is the s argument value.
+			S zebedee.Session
+			// C is the c argument value.
+			C zebedee.Credentials
+		}
+		// SetPasswordContext holds details about calls to the SetPasswordContext method.
+		SetPasswordContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S zebedee.Session
+			// C is the c argument value.
+			C zebedee.Credentials
+		}
+	}
+}
+
+// CreateUser calls CreateUserFunc.
+func (mock *UsersAPIMock) CreateUser(s zebedee.Session, u zebedee.User) (zebedee.User, error) {
+	if mock.CreateUserFunc == nil {
+		panic("UsersAPIMock.CreateUserFunc: method is nil but UsersAPI.CreateUser was just called")
+	}
+	callInfo := struct {
+		S zebedee.Session
+		U zebedee.User
+	}{
+		S: s,
+		U: u,
+	}
+	lockUsersAPIMockCreateUser.Lock()
+	mock.calls.CreateUser = append(mock.calls.CreateUser, callInfo)
+	lockUsersAPIMockCreateUser.Unlock()
+	return mock.CreateUserFunc(s, u)
+}
+
+// CreateUserCalls gets all the calls that were made to CreateUser.
+// Check the length with:
+//
+//	len(mockedUsersAPI.CreateUserCalls())
+func (mock *UsersAPIMock) CreateUserCalls() []struct {
+	S zebedee.Session
+	U zebedee.User
+} {
+	var calls []struct {
+		S zebedee.Session
+		U zebedee.User
+	}
+	lockUsersAPIMockCreateUser.RLock()
+	calls = mock.calls.CreateUser
+	lockUsersAPIMockCreateUser.RUnlock()
+	return calls
+}
+
+// CreateUserContext calls CreateUserContextFunc.
+func (mock *UsersAPIMock) CreateUserContext(ctx context.Context, s zebedee.Session, u zebedee.User) (zebedee.User, error) {
+	if mock.CreateUserContextFunc == nil {
+		panic("UsersAPIMock.CreateUserContextFunc: method is nil but UsersAPI.CreateUserContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		U   zebedee.User
+	}{
+		Ctx: ctx,
+		S:   s,
+		U:   u,
+	}
+	lockUsersAPIMockCreateUserContext.Lock()
+	mock.calls.CreateUserContext = append(mock.calls.CreateUserContext, callInfo)
+	lockUsersAPIMockCreateUserContext.Unlock()
+	return mock.CreateUserContextFunc(ctx, s, u)
+}
+
+// CreateUserContextCalls gets all the calls that were made to CreateUserContext.
+// Check the length with:
+//
+//	len(mockedUsersAPI.CreateUserContextCalls())
+func (mock *UsersAPIMock) CreateUserContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	U   zebedee.User
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		U   zebedee.User
+	}
+	lockUsersAPIMockCreateUserContext.RLock()
+	calls = mock.calls.CreateUserContext
+	lockUsersAPIMockCreateUserContext.RUnlock()
+	return calls
+}
+
+// DeleteUser calls DeleteUserFunc.
+func (mock *UsersAPIMock) DeleteUser(s zebedee.Session, email string) error {
+	if mock.DeleteUserFunc == nil {
+		panic("UsersAPIMock.DeleteUserFunc: method is nil but UsersAPI.DeleteUser was just called")
+	}
+	callInfo := struct {
+		S     zebedee.Session
+		Email string
+	}{
+		S:     s,
+		Email: email,
+	}
+	lockUsersAPIMockDeleteUser.Lock()
+	mock.calls.DeleteUser = append(mock.calls.DeleteUser, callInfo)
+	lockUsersAPIMockDeleteUser.Unlock()
+	return mock.DeleteUserFunc(s, email)
+}
+
+// DeleteUserCalls gets all the calls that were made to DeleteUser.
+// Check the length with:
+//
+//	len(mockedUsersAPI.DeleteUserCalls())
+func (mock *UsersAPIMock) DeleteUserCalls() []struct {
+	S     zebedee.Session
+	Email string
+} {
+	var calls []struct {
+		S     zebedee.Session
+		Email string
+	}
+	lockUsersAPIMockDeleteUser.RLock()
+	calls = mock.calls.DeleteUser
+	lockUsersAPIMockDeleteUser.RUnlock()
+	return calls
+}
+
+// DeleteUserContext calls DeleteUserContextFunc.
+func (mock *UsersAPIMock) DeleteUserContext(ctx context.Context, s zebedee.Session, email string) error {
+	if mock.DeleteUserContextFunc == nil {
+		panic("UsersAPIMock.DeleteUserContextFunc: method is nil but UsersAPI.DeleteUserContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		S     zebedee.Session
+		Email string
+	}{
+		Ctx:   ctx,
+		S:     s,
+		Email: email,
+	}
+	lockUsersAPIMockDeleteUserContext.Lock()
+	mock.calls.DeleteUserContext = append(mock.calls.DeleteUserContext, callInfo)
+	lockUsersAPIMockDeleteUserContext.Unlock()
+	return mock.DeleteUserContextFunc(ctx, s, email)
+}
+
+// DeleteUserContextCalls gets all the calls that were made to DeleteUserContext.
+// Check the length with:
+//
+//	len(mockedUsersAPI.DeleteUserContextCalls())
+func (mock *UsersAPIMock) DeleteUserContextCalls() []struct {
+	Ctx   context.Context
+	S     zebedee.Session
+	Email string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		S     zebedee.Session
+		Email string
+	}
+	lockUsersAPIMockDeleteUserContext.RLock()
+	calls = mock.calls.DeleteUserContext
+	lockUsersAPIMockDeleteUserContext.RUnlock()
+	return calls
+}
+
+// GetUser calls GetUserFunc.
+func (mock *UsersAPIMock) GetUser(s zebedee.Session, email string) (zebedee.User, error) {
+	if mock.GetUserFunc == nil {
+		panic("UsersAPIMock.GetUserFunc: method is nil but UsersAPI.GetUser was just called")
+	}
+	callInfo := struct {
+		S     zebedee.Session
+		Email string
+	}{
+		S:     s,
+		Email: email,
+	}
+	lockUsersAPIMockGetUser.Lock()
+	mock.calls.GetUser = append(mock.calls.GetUser, callInfo)
+	lockUsersAPIMockGetUser.Unlock()
+	return mock.GetUserFunc(s, email)
+}
+
+// GetUserCalls gets all the calls that were made to GetUser.
+// Check the length with:
+//
+//	len(mockedUsersAPI.GetUserCalls())
+func (mock *UsersAPIMock) GetUserCalls() []struct {
+	S     zebedee.Session
+	Email string
+} {
+	var calls []struct {
+		S     zebedee.Session
+		Email string
+	}
+	lockUsersAPIMockGetUser.RLock()
+	calls = mock.calls.GetUser
+	lockUsersAPIMockGetUser.RUnlock()
+	return calls
+}
+
+// GetUserContext calls GetUserContextFunc.
+func (mock *UsersAPIMock) GetUserContext(ctx context.Context, s zebedee.Session, email string) (zebedee.User, error) {
+	if mock.GetUserContextFunc == nil {
+		panic("UsersAPIMock.GetUserContextFunc: method is nil but UsersAPI.GetUserContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		S     zebedee.Session
+		Email string
+	}{
+		Ctx:   ctx,
+		S:     s,
+		Email: email,
+	}
+	lockUsersAPIMockGetUserContext.Lock()
+	mock.calls.GetUserContext = append(mock.calls.GetUserContext, callInfo)
+	lockUsersAPIMockGetUserContext.Unlock()
+	return mock.GetUserContextFunc(ctx, s, email)
+}
+
+// GetUserContextCalls gets all the calls that were made to GetUserContext.
+// Check the length with:
+//
+//	len(mockedUsersAPI.GetUserContextCalls())
+func (mock *UsersAPIMock) GetUserContextCalls() []struct {
+	Ctx   context.Context
+	S     zebedee.Session
+	Email string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		S     zebedee.Session
+		Email string
+	}
+	lockUsersAPIMockGetUserContext.RLock()
+	calls = mock.calls.GetUserContext
+	lockUsersAPIMockGetUserContext.RUnlock()
+	return calls
+}
+
+// GetUsers calls GetUsersFunc.
+func (mock *UsersAPIMock) GetUsers(s zebedee.Session) ([]zebedee.User, error) {
+	if mock.GetUsersFunc == nil {
+		panic("UsersAPIMock.GetUsersFunc: method is nil but UsersAPI.GetUsers was just called")
+	}
+	callInfo := struct {
+		S zebedee.Session
+	}{
+		S: s,
+	}
+	lockUsersAPIMockGetUsers.Lock()
+	mock.calls.GetUsers = append(mock.calls.GetUsers, callInfo)
+	lockUsersAPIMockGetUsers.Unlock()
+	return mock.GetUsersFunc(s)
+}
+
+// GetUsersCalls gets all the calls that were made to GetUsers.
+// Check the length with:
+//
+//	len(mockedUsersAPI.GetUsersCalls())
+func (mock *UsersAPIMock) GetUsersCalls() []struct {
+	S zebedee.Session
+} {
+	var calls []struct {
+		S zebedee.Session
+	}
+	lockUsersAPIMockGetUsers.RLock()
+	calls = mock.calls.GetUsers
+	lockUsersAPIMockGetUsers.RUnlock()
+	return calls
+}
+
+// GetUsersContext calls GetUsersContextFunc.
+func (mock *UsersAPIMock) GetUsersContext(ctx context.Context, s zebedee.Session) ([]zebedee.User, error) {
+	if mock.GetUsersContextFunc == nil {
+		panic("UsersAPIMock.GetUsersContextFunc: method is nil but UsersAPI.GetUsersContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+	}{
+		Ctx: ctx,
+		S:   s,
+	}
+	lockUsersAPIMockGetUsersContext.Lock()
+	mock.calls.GetUsersContext = append(mock.calls.GetUsersContext, callInfo)
+	lockUsersAPIMockGetUsersContext.Unlock()
+	return mock.GetUsersContextFunc(ctx, s)
+}
+
+// GetUsersContextCalls gets all the calls that were made to GetUsersContext.
+// Check the length with:
+//
+//	len(mockedUsersAPI.GetUsersContextCalls())
+func (mock *UsersAPIMock) GetUsersContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+	}
+	lockUsersAPIMockGetUsersContext.RLock()
+	calls = mock.calls.GetUsersContext
+	lockUsersAPIMockGetUsersContext.RUnlock()
+	return calls
+}
+
+// SetPassword calls SetPasswordFunc.
+func (mock *UsersAPIMock) SetPassword(s zebedee.Session, c zebedee.Credentials) error {
+	if mock.SetPasswordFunc == nil {
+		panic("UsersAPIMock.SetPasswordFunc: method is nil but UsersAPI.SetPassword was just called")
+	}
+	callInfo := struct {
+		S zebedee.Session
+		C zebedee.Credentials
+	}{
+		S: s,
+		C: c,
+	}
+	lockUsersAPIMockSetPassword.Lock()
+	mock.calls.SetPassword = append(mock.calls.SetPassword, callInfo)
+	lockUsersAPIMockSetPassword.Unlock()
+	return mock.SetPasswordFunc(s, c)
+}
+
+// SetPasswordCalls gets all the calls that were made to SetPassword.
+// Check the length with:
+//
+//	len(mockedUsersAPI.SetPasswordCalls())
+func (mock *UsersAPIMock) SetPasswordCalls() []struct {
+	S zebedee.Session
+	C zebedee.Credentials
+} {
+	var calls []struct {
+		S zebedee.Session
+		C zebedee.Credentials
+	}
+	lockUsersAPIMockSetPassword.RLock()
+	calls = mock.calls.SetPassword
+	lockUsersAPIMockSetPassword.RUnlock()
+	return calls
+}
+
+// SetPasswordContext calls SetPasswordContextFunc.
+func (mock *UsersAPIMock) SetPasswordContext(ctx context.Context, s zebedee.Session, c zebedee.Credentials) error {
+	if mock.SetPasswordContextFunc == nil {
+		panic("UsersAPIMock.SetPasswordContextFunc: method is nil but UsersAPI.SetPasswordContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   zebedee.Session
+		C   zebedee.Credentials
+	}{
+		Ctx: ctx,
+		S:   s,
+		C:   c,
+	}
+	lockUsersAPIMockSetPasswordContext.Lock()
+	mock.calls.SetPasswordContext = append(mock.calls.SetPasswordContext, callInfo)
+	lockUsersAPIMockSetPasswordContext.Unlock()
+	return mock.SetPasswordContextFunc(ctx, s, c)
+}
+
+// SetPasswordContextCalls gets all the calls that were made to SetPasswordContext.
+// Check the length with:
+//
+//	len(mockedUsersAPI.SetPasswordContextCalls())
+func (mock *UsersAPIMock) SetPasswordContextCalls() []struct {
+	Ctx context.Context
+	S   zebedee.Session
+	C   zebedee.Credentials
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   zebedee.Session
+		C   zebedee.Credentials
+	}
+	lockUsersAPIMockSetPasswordContext.RLock()
+	calls = mock.calls.SetPasswordContext
+	lockUsersAPIMockSetPasswordContext.RUnlock()
+	return calls
+}
@@ -3,6 +3,7 @@ package zebedee
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 const (
@@ -38,6 +39,8 @@ type Credentials struct {
 type Session struct {
 	Email string `json:"email"`
 	ID    string `json:"id"`
+	// provider opens the session and re-authenticates when it expires, for sessions returned by a SessionProvider.
+	provider *SessionProvider
 }
 
 // Permissions is the model representing user's CMS permissions
@@ -174,42 +177,62 @@ type CollectionDatasetVersion struct {
 	LastEditedBy  string `json:"lastEditedBy"`
 }
 
+// Name return the name of the publish type used by Zebedee.
 func (pt PublishType) Name() string {
 	switch pt {
-	case 0:
+	case Manual:
 		return "manual"
-	default:
+	case Scheduled:
 		return "scheduled"
+	default:
+		return "unknown"
 	}
 }
 
+// ValueOf return the publish type with the name provided, defaulting to Scheduled.
 func (pt PublishType) ValueOf(val string) PublishType {
-	switch val {
+	t, err := ParsePublishType(val)
+	if err != nil {
+		return Scheduled
+	}
+	return t
+}
+
+// ParsePublishType return the publish type with the name provided, ignoring case.
+func ParsePublishType(val string) (PublishType, error) {
+	switch strings.ToLower(val) {
 	case Manual.Name():
-		return Manual
+		return Manual, nil
+	case Scheduled.Name():
+		return Scheduled, nil
 	default:
-		return Scheduled
+		return Manual, fmt.Errorf("invalid PublishType value %q", val)
 	}
 }
 
 func (pt PublishType) MarshalJSON() ([]byte, error) {
+	if pt != Manual && pt != Scheduled {
+		return nil, fmt.Errorf("JSON marshaling error invalid PublishType value %d", pt)
+	}
 	return json.Marshal(pt.Name())
 }
 
 func (pt *PublishType) UnmarshalJSON(data []byte) error {
-	var raw string
+	var raw *string
 	if err := json.Unmarshal(data, &raw); err != nil {
 		return err
 	}
 
-	switch raw {
-	case Manual.Name():
-		*pt = Manual
-	case Scheduled.Name():
-		*pt = Scheduled
-	default:
-		return fmt.Errorf("JSON unmarshing error invalid PublishType value %q", raw)
+	// a null type leaves the value unchanged
+	if raw == nil {
+		return nil
+	}
+
+	t, err := ParsePublishType(*raw)
+	if err != nil {
+		return fmt.Errorf("JSON unmarshing error %w", err)
 	}
 
+	*pt = t
 	return nil
 }
@@ -0,0 +1,162 @@
+package zebedee
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	dphttp "github.com/ONSdigital/dp-net/v2/http"
+)
+
+// DefaultTimeout is the HTTP client timeout used by NewClientWithOptions when no HttpClient is provided.
+const DefaultTimeout = time.Second * 10
+
+// RequestInterceptor is called with each outgoing request before it is sent to Zebedee.
+// Returning an error aborts the request and the error is returned to the caller.
+type RequestInterceptor func(req *http.Request) error
+
+// ResponseInterceptor is called with each response received from Zebedee before its status is checked.
+// Returning an error closes the response body and the error is returned to the caller.
+type ResponseInterceptor func(resp *http.Response) error
+
+// Option configures the Client created by NewClientWithOptions.
+type Option func(z *zebedeeClient)
+
+// WithHttpClient sets the HttpClient used to send requests to Zebedee. A dp-net client retries every request that
+// fails with a 5xx or 409 status by default, so when a RetryPolicy is used its retries should be turned off with
+// SetMaxRetries(0), otherwise requests the policy does not retry are still repeated.
+func WithHttpClient(httpCli HttpClient) Option {
+	return func(z *zebedeeClient) {
+		z.HttpClient = httpCli
+	}
+}
+
+// WithBasePath sets a path prefix added between the host and each endpoint, e.g. "/zebedee".
+func WithBasePath(basePath string) Option {
+	return func(z *zebedeeClient) {
+		basePath = strings.Trim(basePath, "/")
+		if basePath == "" {
+			z.basePath = ""
+			return
+		}
+		z.basePath = "/" + basePath
+	}
+}
+
+// WithHeader sets a static header sent with every request.
+// Headers managed by the client (content-type and the Florence auth token) take precedence.
+func WithHeader(key, value string) Option {
+	return func(z *zebedeeClient) {
+		z.headers.Set(key, value)
+	}
+}
+
+// WithHeaders sets static headers sent with every request.
+func WithHeaders(headers http.Header) Option {
+	return func(z *zebedeeClient) {
+		for key, values := range headers {
+			z.headers.Del(key)
+			for _, v := range values {
+				z.headers.Add(key, v)
+			}
+		}
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return WithHeader("User-Agent", userAgent)
+}
+
+// WithRequestInterceptor adds a RequestInterceptor. Interceptors are called in the order they are added.
+func WithRequestInterceptor(interceptor RequestInterceptor) Option {
+	return func(z *zebedeeClient) {
+		z.requestInterceptors = append(z.requestInterceptors, interceptor)
+	}
+}
+
+// WithResponseInterceptor adds a ResponseInterceptor. Interceptors are called in the order they are added.
+func WithResponseInterceptor(interceptor ResponseInterceptor) Option {
+	return func(z *zebedeeClient) {
+		z.responseInterceptors = append(z.responseInterceptors, interceptor)
+	}
+}
+
+// WithTimeout sets a default timeout for each request. It is only applied when the request context has no deadline of
+// its own, and is also used as the timeout of the default HttpClient when WithHttpClient is not provided.
+func WithTimeout(timeout time.Duration) Option {
+	return func(z *zebedeeClient) {
+		z.timeout = timeout
+	}
+}
+
+// WithPageValidation enables validation of page JSON with ValidatePage before UpdateCollectionContent sends it to
+// Zebedee. Invalid content is rejected with a *PageValidationError without sending a request.
+func WithPageValidation() Option {
+	return func(z *zebedeeClient) {
+		z.validatePages = true
+	}
+}
+
+// NewClientWithOptions create a new Client configured by the provided options.
+// If no HttpClient option is given a dp-net client is created with its own retries turned off, so requests are only
+// retried by the RetryPolicy.
+func NewClientWithOptions(host string, opts ...Option) Client {
+	z := &zebedeeClient{
+		Host:    strings.TrimSuffix(host, "/"),
+		headers: make(http.Header),
+	}
+
+	for _, opt := range opts {
+		opt(z)
+	}
+
+	if z.HttpClient == nil {
+		timeout := DefaultTimeout
+		if z.timeout > 0 {
+			timeout = z.timeout
+		}
+		httpCli := dphttp.ClientWithTimeout(nil, timeout)
+		httpCli.SetMaxRetries(0)
+		z.HttpClient = httpCli
+	}
+
+	return z
+}
+
+// applyHeaders set the configured static headers on the request
+func (z *zebedeeClient) applyHeaders(req *http.Request) {
+	for key, values := range z.headers {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
+}
+
+// withTimeout apply the default timeout to the request if its context does not already have a deadline.
+// The returned cancel func must be called once the response has been consumed.
+func (z *zebedeeClient) withTimeout(req *http.Request) (*http.Request, context.CancelFunc) {
+	if z.timeout <= 0 {
+		return req, func() {}
+	}
+
+	if _, ok := req.Context().Deadline(); ok {
+		return req, func() {}
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), z.timeout)
+	return req.WithContext(ctx), cancel
+}
+
+// cancelOnClose releases the request context when the response body is closed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
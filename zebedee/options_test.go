@@ -0,0 +1,187 @@
+package zebedee
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/dp-net/v2/request"
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee/mock"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_NewClientWithOptions(t *testing.T) {
+	session := newSession()
+
+	Convey("Given a client configured with a base path, headers and a user agent", t, func() {
+		httpClient := mockHttpResponse(http.StatusOK, `{"id": "collectionID"}`)
+		zebedeeClient := NewClientWithOptions(host+"/",
+			WithHttpClient(httpClient),
+			WithBasePath("zebedee/"),
+			WithHeader("X-Request-Source", "publishing-job"),
+			WithHeaders(http.Header{"X-Correlation-Id": []string{"abc123"}}),
+			WithUserAgent("dp-publishing-job/1.0"),
+		)
+
+		Convey("When GetCollectionByID is called", func() {
+			_, err := zebedeeClient.GetCollectionByID(session, collectionId)
+			So(err, ShouldBeNil)
+
+			Convey("Then the request is sent to the host and base path with the configured headers", func() {
+				So(httpClient.DoCalls(), ShouldHaveLength, 1)
+
+				req := httpClient.DoCalls()[0].Req
+				So(req.URL.String(), ShouldEqual, host+"/zebedee/collection/"+collectionId)
+				So(req.Header.Get("X-Request-Source"), ShouldEqual, "publishing-job")
+				So(req.Header.Get("X-Correlation-Id"), ShouldEqual, "abc123")
+				So(req.Header.Get("User-Agent"), ShouldEqual, "dp-publishing-job/1.0")
+				So(req.Header.Get(request.FlorenceHeaderKey), ShouldEqual, session.ID)
+				So(req.Header.Get("content-type"), ShouldEqual, "application/json")
+			})
+		})
+	})
+
+	Convey("Given a static header that clashes with the auth token header", t, func() {
+		httpClient := mockHttpResponse(http.StatusOK, `true`)
+		zebedeeClient := NewClientWithOptions(host,
+			WithHttpClient(httpClient),
+			WithHeader(request.FlorenceHeaderKey, "static-token"),
+		)
+
+		Convey("When a request is sent", func() {
+			err := zebedeeClient.ApproveCollection(session, collectionId)
+			So(err, ShouldBeNil)
+
+			Convey("Then the session token takes precedence", func() {
+				req := httpClient.DoCalls()[0].Req
+				So(req.Header.Values(request.FlorenceHeaderKey), ShouldResemble, []string{session.ID})
+			})
+		})
+	})
+
+	Convey("Given no HttpClient option", t, func() {
+		cli := NewClientWithOptions(host)
+
+		Convey("Then a default HttpClient is created", func() {
+			So(cli.(*zebedeeClient).HttpClient, ShouldNotBeNil)
+		})
+	})
+}
+
+func Test_NewClientWithOptions_Interceptors(t *testing.T) {
+	session := newSession()
+
+	Convey("Given a client with request and response interceptors", t, func() {
+		var calls []string
+		httpClient := mockHttpResponse(http.StatusOK, `true`)
+		zebedeeClient := NewClientWithOptions(host,
+			WithHttpClient(httpClient),
+			WithRequestInterceptor(func(req *http.Request) error {
+				calls = append(calls, "request 1")
+				req.Header.Set("X-Hook", "set")
+				return nil
+			}),
+			WithRequestInterceptor(func(req *http.Request) error {
+				calls = append(calls, "request 2")
+				return nil
+			}),
+			WithResponseInterceptor(func(resp *http.Response) error {
+				calls = append(calls, "response "+resp.Request.Method)
+				return nil
+			}),
+		)
+
+		Convey("When a request is sent", func() {
+			err := zebedeeClient.PublishCollection(session, collectionId)
+			So(err, ShouldBeNil)
+
+			Convey("Then the interceptors are called in order", func() {
+				So(calls, ShouldResemble, []string{"request 1", "request 2", "response POST"})
+				So(httpClient.DoCalls()[0].Req.Header.Get("X-Hook"), ShouldEqual, "set")
+			})
+		})
+	})
+
+	Convey("Given a request interceptor that returns an error", t, func() {
+		expectedErr := errors.New("request blocked")
+		httpClient := mockHttpResponse(http.StatusOK, `true`)
+		zebedeeClient := NewClientWithOptions(host,
+			WithHttpClient(httpClient),
+			WithRequestInterceptor(func(req *http.Request) error {
+				return expectedErr
+			}),
+		)
+
+		Convey("When a request is sent", func() {
+			err := zebedeeClient.PublishCollection(session, collectionId)
+
+			Convey("Then the request is not sent and the error is returned", func() {
+				So(err, ShouldEqual, expectedErr)
+				So(httpClient.DoCalls(), ShouldHaveLength, 0)
+			})
+		})
+	})
+
+	Convey("Given a response interceptor that returns an error", t, func() {
+		expectedErr := errors.New("response rejected")
+		httpClient := mockHttpResponse(http.StatusOK, `true`)
+		zebedeeClient := NewClientWithOptions(host,
+			WithHttpClient(httpClient),
+			WithResponseInterceptor(func(resp *http.Response) error {
+				return expectedErr
+			}),
+		)
+
+		Convey("When a request is sent", func() {
+			err := zebedeeClient.PublishCollection(session, collectionId)
+
+			Convey("Then the error is returned", func() {
+				So(err, ShouldEqual, expectedErr)
+				So(httpClient.DoCalls(), ShouldHaveLength, 1)
+			})
+		})
+	})
+}
+
+func Test_NewClientWithOptions_Timeout(t *testing.T) {
+	session := newSession()
+
+	Convey("Given a client with a default timeout", t, func() {
+		var deadline time.Time
+		var hasDeadline bool
+		httpClient := &mock.HttpClientMock{
+			DoFunc: func(ctx context.Context, req *http.Request) (*http.Response, error) {
+				deadline, hasDeadline = ctx.Deadline()
+				return mockHttpResponse(http.StatusOK, `true`).DoFunc(ctx, req)
+			},
+		}
+		zebedeeClient := NewClientWithOptions(host, WithHttpClient(httpClient), WithTimeout(time.Minute))
+
+		Convey("When a request is sent without a deadline", func() {
+			err := zebedeeClient.PublishCollection(session, collectionId)
+			So(err, ShouldBeNil)
+
+			Convey("Then the default timeout is applied", func() {
+				So(hasDeadline, ShouldBeTrue)
+				So(time.Until(deadline), ShouldBeBetween, 0, time.Minute)
+			})
+		})
+
+		Convey("When a request is sent with its own deadline", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+			defer cancel()
+			expected, _ := ctx.Deadline()
+
+			err := zebedeeClient.PublishCollectionContext(ctx, session, collectionId)
+			So(err, ShouldBeNil)
+
+			Convey("Then the caller's deadline is kept", func() {
+				So(hasDeadline, ShouldBeTrue)
+				So(deadline, ShouldEqual, expected)
+			})
+		})
+	})
+}
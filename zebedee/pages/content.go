@@ -0,0 +1,73 @@
+package pages
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+)
+
+// ErrMissingURI is returned when updating a page without a URI.
+var ErrMissingURI = errors.New("page uri is missing")
+
+// DataURI return the URI of the JSON file holding the page with the URI provided.
+func DataURI(uri string) string {
+	if strings.HasSuffix(uri, ".json") {
+		return uri
+	}
+	return path.Join("/", uri, "data.json")
+}
+
+// GetPage return the page at the URI from the collection, decoded into the model for its type.
+func GetPage(ctx context.Context, cli zebedee.ContentAPI, s zebedee.Session, collectionID, uri string) (Page, error) {
+	b, err := cli.GetContentContext(ctx, s, collectionID, DataURI(uri))
+	if err != nil {
+		return nil, err
+	}
+	return Decode(b)
+}
+
+// GetPageAs return the page at the URI from the collection as the model type provided, e.g.
+//
+//	bulletin, err := pages.GetPageAs[*pages.Bulletin](ctx, cli, s, collectionID, uri)
+func GetPageAs[T Page](ctx context.Context, cli zebedee.ContentAPI, s zebedee.Session, collectionID, uri string) (T, error) {
+	var typed T
+
+	p, err := GetPage(ctx, cli, s, collectionID, uri)
+	if err != nil {
+		return typed, err
+	}
+
+	typed, ok := p.(T)
+	if !ok {
+		return typed, fmt.Errorf("page at %s is a %s page not %T", uri, p.PageType(), typed)
+	}
+	return typed, nil
+}
+
+// Validate check the page with zebedee.ValidatePage, returning the problems found.
+func Validate(p Page) ([]zebedee.FieldError, error) {
+	b, err := Encode(p)
+	if err != nil {
+		return nil, err
+	}
+	return zebedee.ValidatePage(DataURI(p.PageURI()), json.RawMessage(b)), nil
+}
+
+// UpdatePage add or update the page in the collection at the page URI.
+func UpdatePage(ctx context.Context, cli zebedee.CollectionsAPI, s zebedee.Session, collectionID string, p Page) error {
+	if p.PageURI() == "" {
+		return ErrMissingURI
+	}
+
+	b, err := Encode(p)
+	if err != nil {
+		return err
+	}
+
+	return cli.UpdateCollectionContentContext(ctx, s, collectionID, DataURI(p.PageURI()), json.RawMessage(b))
+}
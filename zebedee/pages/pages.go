@@ -0,0 +1,306 @@
+// Package pages provides Go models of the common ONS page types stored in Zebedee, with a decoder which returns the
+// model matching the page type and helpers to get and update pages in a collection.
+//
+// Fields which are not part of the models are kept when a page is decoded and written back when it is encoded, so
+// updating a page read from Zebedee does not lose any content. This applies to the nested objects of a page too, e.g.
+// the filename of a chart link or an extra field of a section.
+package pages
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Type is the type of an ONS page, held in the type field of the page JSON.
+type Type string
+
+// Page types with models in this package.
+const (
+	TypeStaticPage         Type = "static_page"
+	TypeBulletin           Type = "bulletin"
+	TypeArticle            Type = "article"
+	TypeDatasetLandingPage Type = "dataset_landing_page"
+	TypeTimeseries         Type = "timeseries"
+)
+
+// ErrMissingType is returned when decoding page JSON without a type field.
+var ErrMissingType = errors.New("page type is missing")
+
+// Page is implemented by all the page models in this package.
+type Page interface {
+	PageType() Type
+	PageURI() string
+	base() *Base
+}
+
+// Base holds the fields common to all pages.
+type Base struct {
+	Type        Type        `json:"type"`
+	URI         string      `json:"uri"`
+	Description Description `json:"description"`
+	Breadcrumb  []Link      `json:"breadcrumb,omitempty"`
+
+	// extra holds the fields of the page JSON that are not part of the model
+	extra map[string]json.RawMessage
+}
+
+// PageType return the type of the page.
+func (b *Base) PageType() Type {
+	return b.Type
+}
+
+// PageURI return the URI of the page.
+func (b *Base) PageURI() string {
+	return b.URI
+}
+
+func (b *Base) base() *Base {
+	return b
+}
+
+// Description is the description of a page, used for its metadata and in search results.
+type Description struct {
+	Title             string   `json:"title"`
+	Edition           string   `json:"edition,omitempty"`
+	Summary           string   `json:"summary,omitempty"`
+	Keywords          []string `json:"keywords,omitempty"`
+	MetaDescription   string   `json:"metaDescription,omitempty"`
+	NationalStatistic bool     `json:"nationalStatistic,omitempty"`
+	LatestRelease     bool     `json:"latestRelease,omitempty"`
+	Contact           *Contact `json:"contact,omitempty"`
+	ReleaseDate       string   `json:"releaseDate,omitempty"`
+	NextRelease       string   `json:"nextRelease,omitempty"`
+	Language          string   `json:"language,omitempty"`
+	DatasetID         string   `json:"datasetId,omitempty"`
+	CDID              string   `json:"cdid,omitempty"`
+	Unit              string   `json:"unit,omitempty"`
+	PreUnit           string   `json:"preUnit,omitempty"`
+	Source            string   `json:"source,omitempty"`
+
+	// extra holds the fields of the description JSON that are not part of the model
+	extra map[string]json.RawMessage
+}
+
+// Contact details for the team responsible for a page.
+type Contact struct {
+	Name      string `json:"name,omitempty"`
+	Email     string `json:"email,omitempty"`
+	Telephone string `json:"telephone,omitempty"`
+
+	// extra holds the fields of the contact JSON that are not part of the model
+	extra map[string]json.RawMessage
+}
+
+// Link is a reference to another page, used for breadcrumbs and related links.
+type Link struct {
+	URI   string `json:"uri"`
+	Title string `json:"title,omitempty"`
+
+	// extra holds the fields of the link JSON that are not part of the model, e.g. the filename of a chart
+	extra map[string]json.RawMessage
+}
+
+// Section is a titled section of markdown content.
+type Section struct {
+	Title    string `json:"title,omitempty"`
+	Markdown string `json:"markdown"`
+
+	// extra holds the fields of the section JSON that are not part of the model
+	extra map[string]json.RawMessage
+}
+
+// Generic is a page of a type without a model in this package. Only the common fields are modelled.
+type Generic struct {
+	Base
+}
+
+var models = map[Type]func() Page{
+	TypeStaticPage:         func() Page { return &StaticPage{} },
+	TypeBulletin:           func() Page { return &Bulletin{} },
+	TypeArticle:            func() Page { return &Article{} },
+	TypeDatasetLandingPage: func() Page { return &DatasetLandingPage{} },
+	TypeTimeseries:         func() Page { return &Timeseries{} },
+}
+
+// Decode the page JSON into the model for its type. Pages of a type without a model are returned as *Generic.
+func Decode(b []byte) (Page, error) {
+	var fields struct {
+		Type Type `json:"type"`
+	}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, fmt.Errorf("invalid page json: %w", err)
+	}
+	if fields.Type == "" {
+		return nil, ErrMissingType
+	}
+
+	newPage, ok := models[fields.Type]
+	if !ok {
+		newPage = func() Page { return &Generic{} }
+	}
+
+	p := newPage()
+	if err := json.Unmarshal(b, p); err != nil {
+		return nil, fmt.Errorf("invalid %s page json: %w", fields.Type, err)
+	}
+
+	extra, err := extraFields(b, p)
+	if err != nil {
+		return nil, err
+	}
+	p.base().extra = extra
+
+	return p, nil
+}
+
+// Encode the page as JSON, including any fields not part of the model which were read when the page was decoded.
+func Encode(p Page) ([]byte, error) {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return mergeFields(b, p.base().extra)
+}
+
+// UnmarshalJSON decode the description, keeping any fields not part of the model.
+func (d *Description) UnmarshalJSON(b []byte) error {
+	var v description
+	extra, err := unmarshalFields(b, &v)
+	if err != nil {
+		return err
+	}
+
+	*d = Description(v)
+	d.extra = extra
+	return nil
+}
+
+// MarshalJSON encode the description, including any fields not part of the model which were read when it was decoded.
+func (d Description) MarshalJSON() ([]byte, error) {
+	return marshalFields(description(d), d.extra)
+}
+
+// UnmarshalJSON decode the contact, keeping any fields not part of the model.
+func (c *Contact) UnmarshalJSON(b []byte) error {
+	var v contact
+	extra, err := unmarshalFields(b, &v)
+	if err != nil {
+		return err
+	}
+
+	*c = Contact(v)
+	c.extra = extra
+	return nil
+}
+
+// MarshalJSON encode the contact, including any fields not part of the model which were read when it was decoded.
+func (c Contact) MarshalJSON() ([]byte, error) {
+	return marshalFields(contact(c), c.extra)
+}
+
+// UnmarshalJSON decode the link, keeping any fields not part of the model.
+func (l *Link) UnmarshalJSON(b []byte) error {
+	var v link
+	extra, err := unmarshalFields(b, &v)
+	if err != nil {
+		return err
+	}
+
+	*l = Link(v)
+	l.extra = extra
+	return nil
+}
+
+// MarshalJSON encode the link, including any fields not part of the model which were read when it was decoded.
+func (l Link) MarshalJSON() ([]byte, error) {
+	return marshalFields(link(l), l.extra)
+}
+
+// UnmarshalJSON decode the section, keeping any fields not part of the model.
+func (s *Section) UnmarshalJSON(b []byte) error {
+	var v section
+	extra, err := unmarshalFields(b, &v)
+	if err != nil {
+		return err
+	}
+
+	*s = Section(v)
+	s.extra = extra
+	return nil
+}
+
+// MarshalJSON encode the section, including any fields not part of the model which were read when it was decoded.
+func (s Section) MarshalJSON() ([]byte, error) {
+	return marshalFields(section(s), s.extra)
+}
+
+// These types have the fields of the nested models without their JSON methods
+type (
+	description Description
+	contact     Contact
+	link        Link
+	section     Section
+)
+
+// unmarshalFields decode the JSON object into the model, returning the fields which are not part of it
+func unmarshalFields(b []byte, model interface{}) (map[string]json.RawMessage, error) {
+	if err := json.Unmarshal(b, model); err != nil {
+		return nil, err
+	}
+	return extraFields(b, model)
+}
+
+// marshalFields encode the model, merging in the extra fields read when it was decoded
+func marshalFields(model interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	b, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+	return mergeFields(b, extra)
+}
+
+// extraFields return the fields of the JSON object which are not written when the model is encoded
+func extraFields(b []byte, model interface{}) (map[string]json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+
+	encoded, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+
+	var known map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &known); err != nil {
+		return nil, err
+	}
+
+	for k := range known {
+		delete(fields, k)
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return fields, nil
+}
+
+// mergeFields add the extra fields to the encoded JSON object, where it does not already contain them
+func mergeFields(b []byte, extra map[string]json.RawMessage) ([]byte, error) {
+	if len(extra) == 0 {
+		return b, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+
+	for k, v := range extra {
+		if _, ok := fields[k]; !ok {
+			fields[k] = v
+		}
+	}
+	return json.Marshal(fields)
+}
@@ -0,0 +1,221 @@
+package pages
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee/zebedeetest"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const bulletinJSON = `{
+	"type": "bulletin",
+	"uri": "/economy/grossdomesticproductgdp/bulletins/gdp/latest",
+	"description": {
+		"title": "GDP first quarterly estimate",
+		"edition": "January to March 2021",
+		"nationalStatistic": true,
+		"_abstract": "kept"
+	},
+	"breadcrumb": [{"uri": "/"}, {"uri": "/economy"}],
+	"sections": [{"title": "Main points", "markdown": "GDP grew"}],
+	"relatedBulletins": [{"uri": "/economy/bulletins/previous"}],
+	"versions": [{"uri": "/economy/bulletins/gdp/v1", "correctionNotice": "kept"}]
+}`
+
+const articleJSON = `{
+	"type": "article",
+	"uri": "/economy/inflationandpriceindices/articles/shoppingprices/2021-06-01",
+	"description": {
+		"title": "Shopping prices comparison tool",
+		"releaseDate": "2021-06-01T06:00:00.000Z",
+		"nationalStatistic": false,
+		"contact": {"name": "Prices team", "email": "cpi@ons.gov.uk", "telephone": "+44 1633 456900", "_note": "kept"}
+	},
+	"breadcrumb": [{"uri": "/"}, {"uri": "/economy", "_order": 1}],
+	"sections": [{"title": "Introduction", "markdown": "Prices rose", "_collapsed": true}],
+	"accordion": [{"title": "Background", "markdown": "", "_hidden": false}],
+	"charts": [{"title": "Figure 1", "filename": "d0f2a5c3", "uri": "/economy/articles/shoppingprices/2021-06-01/d0f2a5c3"}],
+	"tables": [{"title": "Table 1", "filename": "a1b2c3d4", "uri": "/economy/articles/shoppingprices/2021-06-01/a1b2c3d4"}],
+	"relatedData": [{"uri": "/economy/datasets/consumerpriceinflation"}],
+	"alerts": [],
+	"pdfTable": []
+}`
+
+const timeseriesJSON = `{
+	"type": "timeseries",
+	"uri": "/economy/inflationandpriceindices/timeseries/d7bt/mm23",
+	"description": {"title": "CPI INDEX 00: ALL ITEMS 2015=100", "cdid": "D7BT"},
+	"years": [{"date": "2020", "value": "108.9", "year": "2020", "label": "2020", "sourceDataset": "MM23"}]
+}`
+
+func TestDecode(t *testing.T) {
+	Convey("Given bulletin page JSON", t, func() {
+		Convey("When it is decoded", func() {
+			p, err := Decode([]byte(bulletinJSON))
+			So(err, ShouldBeNil)
+
+			Convey("Then a bulletin model is returned", func() {
+				b, ok := p.(*Bulletin)
+				So(ok, ShouldBeTrue)
+				So(b.PageType(), ShouldEqual, TypeBulletin)
+				So(b.Description.Title, ShouldEqual, "GDP first quarterly estimate")
+				So(b.Description.NationalStatistic, ShouldBeTrue)
+				So(b.Breadcrumb, ShouldResemble, []Link{{URI: "/"}, {URI: "/economy"}})
+				So(b.Sections, ShouldResemble, []Section{{Title: "Main points", Markdown: "GDP grew"}})
+			})
+
+			Convey("Then encoding it again keeps the fields which are not modelled", func() {
+				b, err := Encode(p)
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqualJSON, bulletinJSON)
+			})
+
+			Convey("Then changes to the model are encoded", func() {
+				p.(*Bulletin).Description.Title = "GDP second estimate"
+
+				b, err := Encode(p)
+				So(err, ShouldBeNil)
+
+				var decoded map[string]interface{}
+				So(json.Unmarshal(b, &decoded), ShouldBeNil)
+				description := decoded["description"].(map[string]interface{})
+				So(description["title"], ShouldEqual, "GDP second estimate")
+				So(description["_abstract"], ShouldEqual, "kept")
+			})
+		})
+	})
+
+	Convey("Given page JSON with fields which are not modelled in its nested objects", t, func() {
+		for _, raw := range []string{bulletinWithNestedFieldsJSON, articleJSON, timeseriesJSON} {
+			p, err := Decode([]byte(raw))
+			So(err, ShouldBeNil)
+
+			Convey(fmt.Sprintf("Then encoding the %s keeps them", p.PageType()), func() {
+				b, err := Encode(p)
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqualJSON, raw)
+			})
+		}
+
+		Convey("Then changes to a nested object keep its other fields", func() {
+			p, err := Decode([]byte(articleJSON))
+			So(err, ShouldBeNil)
+
+			a := p.(*Article)
+			So(a.Charts[0].Title, ShouldEqual, "Figure 1")
+			a.Charts[0].Title = "Figure 1: prices"
+
+			b, err := Encode(p)
+			So(err, ShouldBeNil)
+
+			var decoded struct {
+				Charts []map[string]string `json:"charts"`
+			}
+			So(json.Unmarshal(b, &decoded), ShouldBeNil)
+			So(decoded.Charts[0], ShouldResemble, map[string]string{
+				"title":    "Figure 1: prices",
+				"filename": "d0f2a5c3",
+				"uri":      "/economy/articles/shoppingprices/2021-06-01/d0f2a5c3",
+			})
+		})
+	})
+
+	Convey("Given page JSON of a type without a model", t, func() {
+		raw := `{"type":"release","uri":"/releases/gdp","description":{"title":"GDP"},"markdown":["x"]}`
+
+		Convey("Then it is decoded as a generic page which encodes back to the same JSON", func() {
+			p, err := Decode([]byte(raw))
+			So(err, ShouldBeNil)
+			So(p, ShouldHaveSameTypeAs, &Generic{})
+			So(p.PageType(), ShouldEqual, Type("release"))
+
+			b, err := Encode(p)
+			So(err, ShouldBeNil)
+			So(string(b), ShouldEqualJSON, raw)
+		})
+	})
+
+	Convey("Given page JSON without a type", t, func() {
+		Convey("Then ErrMissingType is returned", func() {
+			_, err := Decode([]byte(`{"uri":"/a"}`))
+			So(err, ShouldEqual, ErrMissingType)
+		})
+	})
+}
+
+const bulletinWithNestedFieldsJSON = `{
+	"type": "bulletin",
+	"uri": "/economy/grossdomesticproductgdp/bulletins/gdp/latest",
+	"description": {"title": "GDP first quarterly estimate", "contact": {"name": "GDP team", "email": "gdp@ons.gov.uk"}},
+	"sections": [{"title": "Main points", "markdown": "GDP grew", "_id": "main-points"}],
+	"charts": [{"title": "Figure 1", "filename": "4c8e1f2a", "uri": "/economy/bulletins/gdp/latest/4c8e1f2a"}],
+	"images": [{"title": "Map", "filename": "9b3d", "uri": "/economy/bulletins/gdp/latest/9b3d"}],
+	"equations": [{"title": "GDP", "filename": "e1", "uri": "/economy/bulletins/gdp/latest/e1"}]
+}`
+
+func TestDataURI(t *testing.T) {
+	Convey("Then the data.json file is added to page URIs", t, func() {
+		So(DataURI("/economy/gdp"), ShouldEqual, "/economy/gdp/data.json")
+		So(DataURI("/economy/gdp/"), ShouldEqual, "/economy/gdp/data.json")
+		So(DataURI("/economy/gdp/data.json"), ShouldEqual, "/economy/gdp/data.json")
+	})
+}
+
+func TestValidate(t *testing.T) {
+	Convey("Given a page without a title", t, func() {
+		page := NewStaticPage("/about/contactus", "")
+
+		Convey("Then the missing title is reported", func() {
+			problems, err := Validate(page)
+			So(err, ShouldBeNil)
+			So(problems, ShouldResemble, []zebedee.FieldError{{Field: "description.title", Message: "is required"}})
+		})
+	})
+}
+
+func TestGetAndUpdatePage(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("Given a collection in Zebedee", t, func() {
+		fake := zebedeetest.NewServer()
+		defer fake.Close()
+
+		fake.AddUser(zebedee.User{Email: "editor@ons.gov.uk"}, "password", zebedee.Permissions{Editor: true})
+		s, _ := fake.NewSession("editor@ons.gov.uk")
+		cli := zebedee.NewClient(fake.URL, fake.HttpClient())
+
+		c, err := cli.CreateCollection(s, zebedee.NewCollection("pages"))
+		So(err, ShouldBeNil)
+
+		Convey("When a page is updated", func() {
+			page := NewStaticPage("/about/contactus", "Contact us")
+			page.Markdown = []string{"Email us"}
+			So(UpdatePage(ctx, cli, s, c.ID, page), ShouldBeNil)
+
+			Convey("Then the page can be read back as its model", func() {
+				got, err := GetPageAs[*StaticPage](ctx, cli, s, c.ID, "/about/contactus")
+				So(err, ShouldBeNil)
+				So(got.Description.Title, ShouldEqual, "Contact us")
+				So(got.Markdown, ShouldResemble, []string{"Email us"})
+			})
+
+			Convey("Then reading it as a different model returns an error", func() {
+				_, err := GetPageAs[*Bulletin](ctx, cli, s, c.ID, "/about/contactus")
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When a page without a URI is updated", func() {
+			err := UpdatePage(ctx, cli, s, c.ID, &StaticPage{Base: Base{Type: TypeStaticPage}})
+
+			Convey("Then ErrMissingURI is returned", func() {
+				So(errors.Is(err, ErrMissingURI), ShouldBeTrue)
+			})
+		})
+	})
+}
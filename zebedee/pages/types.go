@@ -0,0 +1,136 @@
+package pages
+
+import "encoding/json"
+
+// StaticPage is a page of markdown content, e.g. the about us pages.
+type StaticPage struct {
+	Base
+	Markdown     []string `json:"markdown,omitempty"`
+	Links        []Link   `json:"links,omitempty"`
+	RelatedLinks []Link   `json:"relatedLinks,omitempty"`
+	Downloads    []Link   `json:"downloads,omitempty"`
+}
+
+// Bulletin is a statistical bulletin.
+type Bulletin struct {
+	Base
+	Sections         []Section `json:"sections,omitempty"`
+	Accordion        []Section `json:"accordion,omitempty"`
+	RelatedBulletins []Link    `json:"relatedBulletins,omitempty"`
+	RelatedData      []Link    `json:"relatedData,omitempty"`
+	Links            []Link    `json:"links,omitempty"`
+	Charts           []Link    `json:"charts,omitempty"`
+	Tables           []Link    `json:"tables,omitempty"`
+	Images           []Link    `json:"images,omitempty"`
+	Equations        []Link    `json:"equations,omitempty"`
+}
+
+// Article is an article, e.g. an analysis of a topic.
+type Article struct {
+	Base
+	Sections        []Section `json:"sections,omitempty"`
+	Accordion       []Section `json:"accordion,omitempty"`
+	RelatedArticles []Link    `json:"relatedArticles,omitempty"`
+	RelatedData     []Link    `json:"relatedData,omitempty"`
+	Links           []Link    `json:"links,omitempty"`
+	Charts          []Link    `json:"charts,omitempty"`
+	Tables          []Link    `json:"tables,omitempty"`
+	Images          []Link    `json:"images,omitempty"`
+	Equations       []Link    `json:"equations,omitempty"`
+}
+
+// DatasetLandingPage is the landing page for a dataset, linking to each of its editions.
+type DatasetLandingPage struct {
+	Base
+	Section            *Section `json:"section,omitempty"`
+	Notes              *Section `json:"notes,omitempty"`
+	Datasets           []Link   `json:"datasets,omitempty"`
+	RelatedDatasets    []Link   `json:"relatedDatasets,omitempty"`
+	RelatedDocuments   []Link   `json:"relatedDocuments,omitempty"`
+	RelatedMethodology []Link   `json:"relatedMethodology,omitempty"`
+	RelatedLinks       []Link   `json:"links,omitempty"`
+}
+
+// Timeseries is a single time series, with its values for each year, quarter and month.
+type Timeseries struct {
+	Base
+	Years              []TimeseriesValue `json:"years,omitempty"`
+	Quarters           []TimeseriesValue `json:"quarters,omitempty"`
+	Months             []TimeseriesValue `json:"months,omitempty"`
+	Section            *Section          `json:"section,omitempty"`
+	Notes              []string          `json:"notes,omitempty"`
+	SourceDatasets     []Link            `json:"sourceDatasets,omitempty"`
+	RelatedDatasets    []Link            `json:"relatedDatasets,omitempty"`
+	RelatedDocuments   []Link            `json:"relatedDocuments,omitempty"`
+	RelatedData        []Link            `json:"relatedData,omitempty"`
+	RelatedMethodology []Link            `json:"relatedMethodology,omitempty"`
+}
+
+// TimeseriesValue is the value of a time series for a single period.
+type TimeseriesValue struct {
+	Date          string `json:"date"`
+	Value         string `json:"value"`
+	Year          string `json:"year,omitempty"`
+	Quarter       string `json:"quarter,omitempty"`
+	Month         string `json:"month,omitempty"`
+	SourceDataset string `json:"sourceDataset,omitempty"`
+	UpdateDate    string `json:"updateDate,omitempty"`
+
+	// extra holds the fields of the value JSON that are not part of the model
+	extra map[string]json.RawMessage
+}
+
+// UnmarshalJSON decode the time series value, keeping any fields not part of the model.
+func (t *TimeseriesValue) UnmarshalJSON(b []byte) error {
+	var v timeseriesValue
+	extra, err := unmarshalFields(b, &v)
+	if err != nil {
+		return err
+	}
+
+	*t = TimeseriesValue(v)
+	t.extra = extra
+	return nil
+}
+
+// MarshalJSON encode the time series value, including any fields not part of the model which were read when it was
+// decoded.
+func (t TimeseriesValue) MarshalJSON() ([]byte, error) {
+	return marshalFields(timeseriesValue(t), t.extra)
+}
+
+// timeseriesValue has the fields of TimeseriesValue without its JSON methods
+type timeseriesValue TimeseriesValue
+
+// NewStaticPage create a static page at the URI with the title provided.
+func NewStaticPage(uri, title string) *StaticPage {
+	return &StaticPage{Base: newBase(TypeStaticPage, uri, title)}
+}
+
+// NewBulletin create a bulletin at the URI with the title provided.
+func NewBulletin(uri, title string) *Bulletin {
+	return &Bulletin{Base: newBase(TypeBulletin, uri, title)}
+}
+
+// NewArticle create an article at the URI with the title provided.
+func NewArticle(uri, title string) *Article {
+	return &Article{Base: newBase(TypeArticle, uri, title)}
+}
+
+// NewDatasetLandingPage create a dataset landing page at the URI with the title provided.
+func NewDatasetLandingPage(uri, title string) *DatasetLandingPage {
+	return &DatasetLandingPage{Base: newBase(TypeDatasetLandingPage, uri, title)}
+}
+
+// NewTimeseries create a time series at the URI with the title provided.
+func NewTimeseries(uri, title string) *Timeseries {
+	return &Timeseries{Base: newBase(TypeTimeseries, uri, title)}
+}
+
+func newBase(t Type, uri, title string) Base {
+	return Base{
+		Type:        t,
+		URI:         uri,
+		Description: Description{Title: title},
+	}
+}
@@ -0,0 +1,61 @@
+package zebedee
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// ContentNode is a published page returned when listing the children of a URI.
+type ContentNode struct {
+	URI         string                   `json:"uri"`
+	Type        string                   `json:"type"`
+	Description ContentDetailDescription `json:"description"`
+}
+
+// GetPublishedContent returns the published content at the URI, e.g. "/economy/gdp/data.json" or a CSV file.
+func (z *zebedeeClient) GetPublishedContent(s Session, uri string) ([]byte, error) {
+	return z.GetPublishedContentContext(context.Background(), s, uri)
+}
+
+// GetPublishedContentContext returns the published content at the URI, bound to the provided context.
+func (z *zebedeeClient) GetPublishedContentContext(ctx context.Context, s Session, uri string) ([]byte, error) {
+	return readStream(z.GetPublishedContentStream(ctx, s, uri))
+}
+
+// GetPublishedContentStream returns a reader for the published content at the URI along with its metadata.
+// The caller must close the reader.
+func (z *zebedeeClient) GetPublishedContentStream(ctx context.Context, s Session, uri string) (io.ReadCloser, ContentInfo, error) {
+	return z.getStream(ctx, s, newEndpoint("resource").query("uri", uri).String())
+}
+
+// GetPublishedData returns the published page JSON for the page URI, e.g. "/economy/gdp".
+func (z *zebedeeClient) GetPublishedData(s Session, uri string) ([]byte, error) {
+	return z.GetPublishedDataContext(context.Background(), s, uri)
+}
+
+// GetPublishedDataContext returns the published page JSON for the page URI, bound to the provided context.
+func (z *zebedeeClient) GetPublishedDataContext(ctx context.Context, s Session, uri string) ([]byte, error) {
+	return readStream(z.getStream(ctx, s, newEndpoint("data").query("uri", uri).String()))
+}
+
+// ListPublishedChildren returns the published pages directly below the page URI.
+func (z *zebedeeClient) ListPublishedChildren(s Session, uri string) ([]ContentNode, error) {
+	return z.ListPublishedChildrenContext(context.Background(), s, uri)
+}
+
+// ListPublishedChildrenContext returns the published pages directly below the page URI, bound to the provided context.
+func (z *zebedeeClient) ListPublishedChildrenContext(ctx context.Context, s Session, uri string) ([]ContentNode, error) {
+	endpoint := newEndpoint("taxonomy").query("uri", uri).query("depth", "1").String()
+	req, err := z.newAuthenticatedRequest(ctx, endpoint, s, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []ContentNode
+	if err = z.requestObject(req, http.StatusOK, &nodes); err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
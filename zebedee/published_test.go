@@ -0,0 +1,121 @@
+package zebedee
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/ONSdigital/dp-net/v2/request"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_GetPublishedContent(t *testing.T) {
+	session := newSession()
+
+	Convey("Given a mock HTTP client that returns published content", t, func() {
+		httpClient := mockHttpResponse(http.StatusOK, pageContent)
+		zebedeeClient := NewClient(host, httpClient)
+
+		Convey("When GetPublishedContent is called", func() {
+			res, err := zebedeeClient.GetPublishedContent(session, "/economy/gdp/data.json")
+
+			Convey("Then the resource endpoint is requested with the session", func() {
+				So(err, ShouldBeNil)
+				So(string(res), ShouldEqual, pageContent)
+
+				req := httpClient.DoCalls()[0].Req
+				So(req.Method, ShouldEqual, http.MethodGet)
+				So(req.URL.String(), ShouldEqual, host+"/resource?uri=/economy/gdp/data.json")
+				So(req.Header.Get(request.FlorenceHeaderKey), ShouldEqual, session.ID)
+			})
+		})
+
+		Convey("When GetPublishedContentStream is called", func() {
+			body, _, err := zebedeeClient.GetPublishedContentStream(context.Background(), session, "/economy/gdp/data.csv")
+			So(err, ShouldBeNil)
+			defer body.Close()
+
+			Convey("Then the content can be read from the returned reader", func() {
+				b, err := io.ReadAll(body)
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, pageContent)
+			})
+		})
+	})
+
+	Convey("Given nothing is published at the URI", t, func() {
+		zebedeeClient := NewClient(host, mockHttpResponse(http.StatusNotFound, `{"message":"Content not found"}`))
+
+		Convey("Then GetPublishedContent returns a not found error", func() {
+			res, err := zebedeeClient.GetPublishedContent(session, "/economy/gdp/data.json")
+			So(errors.Is(err, ErrNotFound), ShouldBeTrue)
+			So(res, ShouldBeNil)
+		})
+	})
+}
+
+func Test_GetPublishedData(t *testing.T) {
+	session := newSession()
+
+	Convey("Given a mock HTTP client that returns published page JSON", t, func() {
+		httpClient := mockHttpResponse(http.StatusOK, pageContent)
+		zebedeeClient := NewClient(host, httpClient)
+
+		Convey("When GetPublishedData is called", func() {
+			res, err := zebedeeClient.GetPublishedData(session, "/economy/gdp")
+
+			Convey("Then the data endpoint is requested for the page URI", func() {
+				So(err, ShouldBeNil)
+				So(string(res), ShouldEqual, pageContent)
+
+				req := httpClient.DoCalls()[0].Req
+				So(req.Method, ShouldEqual, http.MethodGet)
+				So(req.URL.String(), ShouldEqual, host+"/data?uri=/economy/gdp")
+				So(req.Header.Get(request.FlorenceHeaderKey), ShouldEqual, session.ID)
+			})
+		})
+	})
+
+	Convey("Given the session has expired", t, func() {
+		zebedeeClient := NewClient(host, mockHttpResponse(http.StatusUnauthorized, ""))
+
+		Convey("Then GetPublishedData returns an unauthorized error", func() {
+			_, err := zebedeeClient.GetPublishedData(session, "/economy/gdp")
+			So(errors.Is(err, ErrUnauthorized), ShouldBeTrue)
+		})
+	})
+}
+
+func Test_ListPublishedChildren(t *testing.T) {
+	session := newSession()
+
+	Convey("Given a mock HTTP client that returns the child pages", t, func() {
+		body := `[{"uri":"/economy/gdp","type":"taxonomy_landing_page","description":{"title":"GDP"}},` +
+			`{"uri":"/economy/inflation","type":"taxonomy_landing_page","description":{"title":"Inflation"}}]`
+		httpClient := mockHttpResponse(http.StatusOK, body)
+		zebedeeClient := NewClient(host, httpClient)
+
+		Convey("When ListPublishedChildren is called", func() {
+			nodes, err := zebedeeClient.ListPublishedChildren(session, "/economy")
+
+			Convey("Then the taxonomy endpoint is requested one level deep", func() {
+				So(err, ShouldBeNil)
+
+				req := httpClient.DoCalls()[0].Req
+				So(req.URL.String(), ShouldEqual, fmt.Sprintf("%s/taxonomy?uri=/economy&depth=1", host))
+				So(req.Header.Get(request.FlorenceHeaderKey), ShouldEqual, session.ID)
+			})
+
+			Convey("Then the child pages are returned", func() {
+				So(nodes, ShouldResemble, []ContentNode{
+					{URI: "/economy/gdp", Type: "taxonomy_landing_page", Description: ContentDetailDescription{Title: "GDP"}},
+					{URI: "/economy/inflation", Type: "taxonomy_landing_page", Description: ContentDetailDescription{Title: "Inflation"}},
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,208 @@
+package zebedee
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// PublishStep is a step of the pipeline run by the Publisher.
+type PublishStep string
+
+// Publisher steps, in the order they are run. The load step reads the current state of the collection and cleanup
+// deletes the collection after a failure.
+const (
+	StepCreate   PublishStep = "create"
+	StepLoad     PublishStep = "load"
+	StepUpdate   PublishStep = "update"
+	StepComplete PublishStep = "complete"
+	StepReview   PublishStep = "review"
+	StepApprove  PublishStep = "approve"
+	StepPublish  PublishStep = "publish"
+	StepCleanup  PublishStep = "cleanup"
+)
+
+// ContentItem is a page of content to add to a collection.
+type ContentItem struct {
+	URI     string
+	Content interface{}
+}
+
+// PublishProgress is passed to the Publisher progress callback after each step.
+type PublishProgress struct {
+	Step         PublishStep
+	CollectionID string
+	// URI of the content item for the update, complete and review steps.
+	URI string
+	// Skipped is true if the step was not needed as the collection was already in the required state.
+	Skipped bool
+	// Err is the error the step failed with, if any.
+	Err error
+}
+
+// PublishError is returned by the Publisher when a step fails.
+type PublishError struct {
+	Step         PublishStep
+	CollectionID string
+	URI          string
+	Err          error
+}
+
+func (err *PublishError) Error() string {
+	if err.URI != "" {
+		return fmt.Sprintf("publisher %s step failed for %s in collection %s: %v", err.Step, err.URI, err.CollectionID, err.Err)
+	}
+	return fmt.Sprintf("publisher %s step failed for collection %s: %v", err.Step, err.CollectionID, err.Err)
+}
+
+func (err *PublishError) Unwrap() error {
+	return err.Err
+}
+
+// Publisher drives a collection through the whole content lifecycle: it creates the collection, adds the content,
+// completes it as the editor, reviews it as the reviewer, approves the collection and publishes it.
+//
+// Running the Publisher for a collection that already exists (the spec has an ID) resumes from the current state of
+// the collection. Content already in the collection is not uploaded again and steps which have already been done are
+// skipped.
+type Publisher struct {
+	Client CollectionsAPI
+	// Editor session used to create the collection, add and complete content, approve and publish.
+	Editor Session
+	// Reviewer session used to review content. This must be a different user to the editor.
+	Reviewer Session
+	// CleanupOnFailure deletes the collection if a step fails. Only collections created by the Publisher are deleted.
+	CleanupOnFailure bool
+	// OnProgress is called after each step if set.
+	OnProgress func(PublishProgress)
+}
+
+// NewPublisher create a new Publisher using the editor and reviewer sessions provided.
+func NewPublisher(cli CollectionsAPI, editor, reviewer Session) *Publisher {
+	return &Publisher{
+		Client:   cli,
+		Editor:   editor,
+		Reviewer: reviewer,
+	}
+}
+
+// Publish run the pipeline for the collection spec and content items, returning the final collection description.
+// Manual collections are published once approved, scheduled collections are left approved for Zebedee to publish at the
+// publish date.
+func (p *Publisher) Publish(ctx context.Context, spec CollectionDescription, items []ContentItem) (CollectionDescription, error) {
+	id := spec.ID
+	created := false
+
+	if id == "" {
+		desc, err := p.Client.CreateCollectionContext(ctx, p.Editor, spec)
+		if err != nil {
+			return desc, p.fail(ctx, &PublishError{Step: StepCreate, Err: err}, false)
+		}
+		id = desc.ID
+		created = true
+	}
+	p.progress(PublishProgress{Step: StepCreate, CollectionID: id, Skipped: !created})
+
+	if err := p.run(ctx, id, items); err != nil {
+		return CollectionDescription{}, p.fail(ctx, err, created)
+	}
+
+	return p.Client.GetCollectionByIDContext(ctx, p.Editor, id)
+}
+
+// run the steps after the collection has been created, skipping any which have already been done
+func (p *Publisher) run(ctx context.Context, id string, items []ContentItem) *PublishError {
+	w, err := NewWorkflow(ctx, p.Client, p.Editor, id)
+	if err != nil {
+		return &PublishError{Step: StepLoad, CollectionID: id, Err: err}
+	}
+
+	for _, item := range items {
+		_, exists := w.State().Content[item.URI]
+		err := p.step(StepUpdate, id, item.URI, exists, func() error {
+			return w.UpdateContent(ctx, p.Editor, item.URI, item.Content)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, item := range items {
+		done := w.State().Content[item.URI] != ContentInProgress
+		err := p.step(StepComplete, id, item.URI, done, func() error {
+			return w.CompleteContent(ctx, p.Editor, item.URI)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, item := range items {
+		done := w.State().Content[item.URI] != ContentComplete
+		err := p.step(StepReview, id, item.URI, done, func() error {
+			return w.ReviewContent(ctx, p.Reviewer, item.URI)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	approved := w.State().ApprovalStatus == ApprovalComplete || w.State().Published
+	if err := p.step(StepApprove, id, "", approved, func() error {
+		return w.Approve(ctx, p.Editor)
+	}); err != nil {
+		return err
+	}
+
+	// the type of the collection stored in Zebedee is used, as the spec of a resumed collection may only have its ID
+	if w.State().Type == Scheduled {
+		return nil
+	}
+
+	return p.step(StepPublish, id, "", w.State().Published, func() error {
+		return w.Publish(ctx, p.Editor)
+	})
+}
+
+// step run the step unless it has already been done, reporting the progress
+func (p *Publisher) step(step PublishStep, id, uri string, done bool, execute func() error) *PublishError {
+	if !done {
+		if err := execute(); err != nil {
+			return &PublishError{Step: step, CollectionID: id, URI: uri, Err: err}
+		}
+	}
+
+	p.progress(PublishProgress{Step: step, CollectionID: id, URI: uri, Skipped: done})
+	return nil
+}
+
+// cleanupTimeout is the time allowed to delete the collection after a step fails
+const cleanupTimeout = 30 * time.Second
+
+// fail report the failed step and delete the collection if required
+func (p *Publisher) fail(ctx context.Context, pubErr *PublishError, created bool) error {
+	p.progress(PublishProgress{Step: pubErr.Step, CollectionID: pubErr.CollectionID, URI: pubErr.URI, Err: pubErr.Err})
+
+	if !p.CleanupOnFailure || !created {
+		return pubErr
+	}
+
+	// the step may have failed as the context was cancelled, so the collection is deleted regardless
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cleanupTimeout)
+	defer cancel()
+
+	cleanupErr := p.Client.DeleteCollectionContext(ctx, p.Editor, pubErr.CollectionID)
+	p.progress(PublishProgress{Step: StepCleanup, CollectionID: pubErr.CollectionID, Err: cleanupErr})
+	if cleanupErr != nil {
+		return errors.Join(pubErr, &PublishError{Step: StepCleanup, CollectionID: pubErr.CollectionID, Err: cleanupErr})
+	}
+
+	return pubErr
+}
+
+func (p *Publisher) progress(pr PublishProgress) {
+	if p.OnProgress != nil {
+		p.OnProgress(pr)
+	}
+}
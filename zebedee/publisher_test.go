@@ -0,0 +1,158 @@
+package zebedee_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPublisher(t *testing.T) {
+	ctx := context.Background()
+	items := []zebedee.ContentItem{
+		{URI: "/about/data.json", Content: newPage("About")},
+		{URI: "/about/contact/data.json", Content: newPage("Contact")},
+	}
+
+	Convey("Given a publisher with an editor and reviewer session", t, func() {
+		fake, cli, editor, reviewer := newFake()
+		defer fake.Close()
+
+		var progress []zebedee.PublishProgress
+		p := zebedee.NewPublisher(cli, editor, reviewer)
+		p.OnProgress = func(pr zebedee.PublishProgress) {
+			progress = append(progress, pr)
+		}
+
+		Convey("When a new collection is published", func() {
+			desc, err := p.Publish(ctx, zebedee.NewCollection("About us"), items)
+
+			Convey("Then the content is published", func() {
+				So(err, ShouldBeNil)
+				So(desc.PublishComplete, ShouldBeTrue)
+
+				_, ok := fake.PublishedContent("/about/contact/data.json")
+				So(ok, ShouldBeTrue)
+			})
+
+			Convey("Then progress is reported for each step", func() {
+				steps := make([]zebedee.PublishStep, 0, len(progress))
+				for _, pr := range progress {
+					So(pr.Skipped, ShouldBeFalse)
+					So(pr.CollectionID, ShouldEqual, desc.ID)
+					steps = append(steps, pr.Step)
+				}
+				So(steps, ShouldResemble, []zebedee.PublishStep{
+					zebedee.StepCreate,
+					zebedee.StepUpdate, zebedee.StepUpdate,
+					zebedee.StepComplete, zebedee.StepComplete,
+					zebedee.StepReview, zebedee.StepReview,
+					zebedee.StepApprove,
+					zebedee.StepPublish,
+				})
+			})
+		})
+
+		Convey("When a scheduled collection is published", func() {
+			spec := zebedee.NewCollection("Scheduled")
+			spec.Type = zebedee.Scheduled
+			desc, err := p.Publish(ctx, spec, items)
+
+			Convey("Then the collection is approved and left for Zebedee to publish", func() {
+				So(err, ShouldBeNil)
+				So(desc.ApprovalStatus, ShouldEqual, zebedee.ApprovalComplete)
+				So(desc.PublishComplete, ShouldBeFalse)
+			})
+		})
+
+		Convey("When a partially completed collection is resumed", func() {
+			spec, err := cli.CreateCollection(editor, zebedee.NewCollection("Resumed"))
+			So(err, ShouldBeNil)
+			So(cli.UpdateCollectionContent(editor, spec.ID, items[0].URI, items[0].Content), ShouldBeNil)
+			So(cli.CompleteCollectionContent(editor, spec.ID, items[0].URI), ShouldBeNil)
+
+			desc, err := p.Publish(ctx, spec, items)
+
+			Convey("Then the steps already done are skipped", func() {
+				So(err, ShouldBeNil)
+				So(desc.PublishComplete, ShouldBeTrue)
+
+				var skipped []zebedee.PublishStep
+				for _, pr := range progress {
+					if pr.Skipped {
+						skipped = append(skipped, pr.Step)
+					}
+				}
+				So(skipped, ShouldResemble, []zebedee.PublishStep{zebedee.StepCreate, zebedee.StepUpdate, zebedee.StepComplete})
+			})
+		})
+
+		Convey("When a scheduled collection is resumed with a spec which only has its ID", func() {
+			spec, err := zebedee.NewScheduledCollection("Scheduled", time.Now().Add(time.Hour))
+			So(err, ShouldBeNil)
+			created, err := cli.CreateCollection(editor, spec)
+			So(err, ShouldBeNil)
+
+			resume := zebedee.CollectionDescription{}
+			resume.ID = created.ID
+			desc, err := p.Publish(ctx, resume, items)
+
+			Convey("Then the collection is approved and left for Zebedee to publish", func() {
+				So(err, ShouldBeNil)
+				So(desc.ApprovalStatus, ShouldEqual, zebedee.ApprovalComplete)
+				So(desc.PublishComplete, ShouldBeFalse)
+			})
+		})
+
+		Convey("When a step fails and cleanup was requested", func() {
+			p.Reviewer = editor
+			p.CleanupOnFailure = true
+			_, err := p.Publish(ctx, zebedee.NewCollection("Failed"), items)
+
+			Convey("Then the error identifies the failed step", func() {
+				var pubErr *zebedee.PublishError
+				So(errors.As(err, &pubErr), ShouldBeTrue)
+				So(pubErr.Step, ShouldEqual, zebedee.StepReview)
+				So(pubErr.URI, ShouldEqual, items[0].URI)
+				So(errors.Is(err, zebedee.ErrForbidden), ShouldBeTrue)
+			})
+
+			Convey("Then the collection is deleted", func() {
+				last := progress[len(progress)-1]
+				So(last.Step, ShouldEqual, zebedee.StepCleanup)
+				So(last.Err, ShouldBeNil)
+
+				_, ok := fake.Collection(last.CollectionID)
+				So(ok, ShouldBeFalse)
+			})
+		})
+
+		Convey("When the context is cancelled part way through and cleanup was requested", func() {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			p.CleanupOnFailure = true
+			p.OnProgress = func(pr zebedee.PublishProgress) {
+				progress = append(progress, pr)
+				if pr.Step == zebedee.StepCreate {
+					cancel()
+				}
+			}
+			_, err := p.Publish(ctx, zebedee.NewCollection("Cancelled"), items)
+
+			Convey("Then the collection is still deleted", func() {
+				So(errors.Is(err, context.Canceled), ShouldBeTrue)
+
+				last := progress[len(progress)-1]
+				So(last.Step, ShouldEqual, zebedee.StepCleanup)
+				So(last.Err, ShouldBeNil)
+
+				_, ok := fake.Collection(last.CollectionID)
+				So(ok, ShouldBeFalse)
+			})
+		})
+	})
+}
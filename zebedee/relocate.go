@@ -0,0 +1,358 @@
+package zebedee
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// RelocateOptions are the options for CopyContent and MoveContent.
+type RelocateOptions struct {
+	// Recursive also relocates the pages below the page, both in the collection and published.
+	Recursive bool
+	// Attachments also relocates the files alongside each page, e.g. CSV and PDF downloads. These are the files in the
+	// directory of the page in the collection and the files listed in the downloads of the page.
+	Attachments bool
+}
+
+// Relocation is a single item of content written to a new URI.
+type Relocation struct {
+	From string
+	To   string
+	// Fields are the fields rewritten for the new URI, e.g. "uri" or "breadcrumb".
+	Fields []string
+}
+
+// RelocateReport is the content touched by CopyContent and MoveContent.
+type RelocateReport struct {
+	// Created is the content written to the collection at the new URIs.
+	Created []Relocation
+	// Deleted is the content removed from the collection at the old URIs.
+	Deleted []string
+	// Published is the content at the old URIs which is still published. It is not removed by MoveContent and must be
+	// deleted separately.
+	Published []string
+}
+
+// CopyContent copy the page at the URI to a new URI within the collection, rewriting the uri and breadcrumb fields of
+// the page for the new URI. Links within the page are only rewritten if they refer to content which is relocated with
+// it, so links to the pages below it are left alone unless the copy is recursive. The content at the new URI must not already exist. The report lists the content created,
+// including when an error is returned part way through.
+func CopyContent(ctx context.Context, cli Client, s Session, collectionID, from, to string, opts RelocateOptions) (RelocateReport, error) {
+	return relocate(ctx, cli, s, collectionID, from, to, opts, false)
+}
+
+// MoveContent move the page at the URI to a new URI within the collection. The page is copied as CopyContent and then
+// removed from the collection at the old URI. Published content at the old URI is listed in the report.
+func MoveContent(ctx context.Context, cli Client, s Session, collectionID, from, to string, opts RelocateOptions) (RelocateReport, error) {
+	return relocate(ctx, cli, s, collectionID, from, to, opts, true)
+}
+
+// RenameContent move the page at the URI to a new name in the same directory, e.g. renaming "/about/contact" to
+// "contactus" moves it to "/about/contactus". See MoveContent.
+func RenameContent(ctx context.Context, cli Client, s Session, collectionID, uri, name string, opts RelocateOptions) (RelocateReport, error) {
+	if name == "" || strings.Contains(name, "/") {
+		return RelocateReport{}, fmt.Errorf("%w: %q is not a valid page name", ErrInvalidRelocation, name)
+	}
+	return MoveContent(ctx, cli, s, collectionID, uri, path.Join(path.Dir(pageURIFor(uri)), name), opts)
+}
+
+// relocation is the content relocated by a copy or move, by page URI
+type relocation struct {
+	from, to string
+	// collection is the content in the collection, by content URI
+	collection map[string]bool
+	// relocated is the pages and attachments being relocated, by page URI. Only uri fields which refer to these are
+	// rewritten, so links to pages which are not relocated are left alone.
+	relocated map[string]bool
+}
+
+// relocatedPage is a page read for relocation, with the attachments relocated alongside it
+type relocatedPage struct {
+	uri     string
+	content interface{}
+	files   []string
+}
+
+func relocate(ctx context.Context, cli Client, s Session, collectionID, from, to string, opts RelocateOptions, move bool) (RelocateReport, error) {
+	var report RelocateReport
+
+	r := relocation{from: pageURIFor(from), to: pageURIFor(to), collection: make(map[string]bool), relocated: make(map[string]bool)}
+	if err := r.validate(opts); err != nil {
+		return report, err
+	}
+
+	details, err := cli.GetCollectionDetailsContext(ctx, s, collectionID)
+	if err != nil {
+		return report, err
+	}
+	for uri := range NewCollectionState(details).Content {
+		r.collection[uri] = true
+	}
+
+	pages, err := r.pages(ctx, cli, s, opts)
+	if err != nil {
+		return report, err
+	}
+
+	var items []relocatedPage
+	for _, page := range pages {
+		src := dataURIFor(page)
+
+		var content interface{}
+		if err := cli.GetContentJSONContext(ctx, s, collectionID, src, &content); err != nil {
+			return report, fmt.Errorf("get %s: %w", src, err)
+		}
+
+		item := relocatedPage{uri: page, content: content}
+		r.relocated[page] = true
+		if opts.Attachments {
+			item.files = r.attachments(page, content)
+			for _, file := range item.files {
+				r.relocated[pageURIFor(file)] = true
+			}
+		}
+		items = append(items, item)
+	}
+
+	contentOpts := DefaultContentOptions()
+	contentOpts.OverwriteExisting = false
+
+	var sources []string
+	for _, item := range items {
+		src, dst := dataURIFor(item.uri), dataURIFor(r.rewrite(item.uri))
+
+		fields := r.rewritePage(item.content, r.rewrite(item.uri))
+		if err := cli.UpdateCollectionContentWithOptionsContext(ctx, s, collectionID, dst, item.content, contentOpts); err != nil {
+			return report, fmt.Errorf("update %s: %w", dst, err)
+		}
+		report.Created = append(report.Created, Relocation{From: src, To: dst, Fields: fields})
+		sources = append(sources, src)
+
+		for _, file := range item.files {
+			dst := r.rewrite(file)
+			if err := copyFile(ctx, cli, s, collectionID, file, dst); err != nil {
+				return report, fmt.Errorf("copy %s: %w", file, err)
+			}
+			report.Created = append(report.Created, Relocation{From: file, To: dst})
+			sources = append(sources, file)
+		}
+	}
+
+	if !move {
+		return report, nil
+	}
+
+	for _, src := range sources {
+		if !r.collection[src] {
+			report.Published = append(report.Published, src)
+			continue
+		}
+
+		if err := cli.DeleteCollectionContentContext(ctx, s, collectionID, src); err != nil {
+			return report, fmt.Errorf("delete %s: %w", src, err)
+		}
+		report.Deleted = append(report.Deleted, src)
+	}
+
+	return report, nil
+}
+
+func (r relocation) validate(opts RelocateOptions) error {
+	switch {
+	case r.from == "/" || r.to == "/":
+		return fmt.Errorf("%w: the home page cannot be relocated", ErrInvalidRelocation)
+	case r.from == r.to:
+		return fmt.Errorf("%w: %s is the same as the new uri", ErrInvalidRelocation, r.from)
+	case opts.Recursive && isParentURI(r.from, r.to):
+		return fmt.Errorf("%w: %s cannot be moved below itself", ErrInvalidRelocation, r.from)
+	}
+	return nil
+}
+
+// pages return the page URIs to relocate, parents before children
+func (r relocation) pages(ctx context.Context, cli Client, s Session, opts RelocateOptions) ([]string, error) {
+	found := map[string]bool{r.from: true}
+	if opts.Recursive {
+		for uri := range r.collection {
+			if path.Base(uri) == "data.json" && isParentURI(r.from, path.Dir(uri)) {
+				found[path.Dir(uri)] = true
+			}
+		}
+
+		if err := addPublishedChildren(ctx, cli, s, r.from, found); err != nil {
+			return nil, err
+		}
+	}
+
+	pages := make([]string, 0, len(found))
+	for uri := range found {
+		pages = append(pages, uri)
+	}
+	sort.Strings(pages)
+	return pages, nil
+}
+
+// addPublishedChildren add the published pages below the URI to the pages found
+func addPublishedChildren(ctx context.Context, cli PublishedContentAPI, s Session, uri string, found map[string]bool) error {
+	children, err := cli.ListPublishedChildrenContext(ctx, s, uri)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, c := range children {
+		child := pageURIFor(c.URI)
+		if found[child] || !isParentURI(uri, child) {
+			continue
+		}
+		found[child] = true
+		if err := addPublishedChildren(ctx, cli, s, child, found); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attachments return the URIs of the files alongside the page, sorted
+func (r relocation) attachments(page string, content interface{}) []string {
+	found := make(map[string]bool)
+	for uri := range r.collection {
+		if path.Dir(uri) == page && path.Base(uri) != "data.json" {
+			found[uri] = true
+		}
+	}
+
+	if p, ok := content.(map[string]interface{}); ok {
+		downloads, _ := p["downloads"].([]interface{})
+		for _, d := range downloads {
+			download, _ := d.(map[string]interface{})
+			if file, _ := download["file"].(string); file != "" && !strings.Contains(file, "/") {
+				found[path.Join(page, file)] = true
+			}
+		}
+	}
+
+	files := make([]string, 0, len(found))
+	for uri := range found {
+		files = append(files, uri)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// rewrite return the URI with the old page URI replaced by the new
+func (r relocation) rewrite(uri string) string {
+	if uri == r.from {
+		return r.to
+	}
+	if isParentURI(r.from, uri) {
+		return r.to + strings.TrimPrefix(uri, r.from)
+	}
+	return uri
+}
+
+// rewritePage update the uri fields within the relocated content and rebuild the breadcrumb for the new page URI,
+// returning the fields changed
+func (r relocation) rewritePage(content interface{}, newURI string) []string {
+	var fields []string
+	p, ok := content.(map[string]interface{})
+	if !ok {
+		return fields
+	}
+
+	fields = r.rewriteURIs(p, "", fields)
+
+	if raw, exists := p["breadcrumb"]; exists {
+		old, _ := raw.([]interface{})
+		crumbs := make([]interface{}, 0)
+		for _, parent := range parentURIs(newURI) {
+			crumbs = append(crumbs, breadcrumbFor(parent, old))
+		}
+		if b1, b2 := formatValue(old), formatValue(crumbs); b1 != b2 {
+			p["breadcrumb"] = crumbs
+			fields = append(fields, "breadcrumb")
+		}
+	}
+
+	return fields
+}
+
+// rewriteURIs update each uri field within the value which refers to the relocated pages or attachments, other than
+// within the breadcrumb
+func (r relocation) rewriteURIs(v interface{}, field string, fields []string) []string {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			childField := k
+			if field != "" {
+				childField = field + "." + k
+			}
+
+			if k == "breadcrumb" && field == "" {
+				continue
+			}
+
+			if uri, ok := t[k].(string); ok && k == "uri" {
+				if rewritten := r.rewrite(uri); rewritten != uri && r.relocated[pageURIFor(uri)] {
+					t[k] = rewritten
+					fields = append(fields, childField)
+				}
+				continue
+			}
+
+			fields = r.rewriteURIs(t[k], childField, fields)
+		}
+
+	case []interface{}:
+		for i, item := range t {
+			fields = r.rewriteURIs(item, fmt.Sprintf("%s[%d]", field, i), fields)
+		}
+	}
+
+	return fields
+}
+
+// parentURIs return the URIs of the parents of the page, starting with the home page
+func parentURIs(uri string) []string {
+	parents := []string{"/"}
+	segments := strings.Split(strings.Trim(uri, "/"), "/")
+	for i := 1; i < len(segments); i++ {
+		parents = append(parents, "/"+strings.Join(segments[:i], "/"))
+	}
+	return parents
+}
+
+// breadcrumbFor return the existing breadcrumb for the URI, keeping its title, or a new breadcrumb
+func breadcrumbFor(uri string, existing []interface{}) interface{} {
+	for _, c := range existing {
+		crumb, _ := c.(map[string]interface{})
+		if u, _ := crumb["uri"].(string); u == uri {
+			return crumb
+		}
+	}
+	return map[string]interface{}{"uri": uri}
+}
+
+// copyFile stream the file from one URI to another within the collection, failing with a conflict if a file already
+// exists at the new URI
+func copyFile(ctx context.Context, cli ContentAPI, s Session, collectionID, from, to string) error {
+	body, info, err := cli.GetContentStream(ctx, s, collectionID, from)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	return cli.UploadFileWithOptionsContext(ctx, s, collectionID, to, body, info.ContentType, ContentOptions{OverwriteExisting: false})
+}
@@ -0,0 +1,148 @@
+package zebedee_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const contactPage = `{
+	"type": "static_page",
+	"uri": "/about/contact",
+	"description": {"title": "Contact"},
+	"breadcrumb": [{"uri": "/", "title": "Home"}, {"uri": "/about", "title": "About"}],
+	"links": [{"title": "Team", "uri": "/about/contact/team"}, {"title": "Census", "uri": "/census"}],
+	"downloads": [{"title": "Contacts", "file": "contacts.csv"}]
+}`
+
+func TestMoveContent(t *testing.T) {
+	ctx := context.Background()
+	opts := zebedee.RelocateOptions{Recursive: true, Attachments: true}
+
+	Convey("Given a collection with a page and a file, and a published page below it", t, func() {
+		fake, cli, editor, _ := newFake()
+		defer fake.Close()
+
+		fake.SetPublishedContent("/about/contact/team/data.json", []byte(`{"type":"static_page","uri":"/about/contact/team","description":{"title":"Team"}}`))
+
+		desc, err := cli.CreateCollection(editor, zebedee.NewCollection("Contact"))
+		So(err, ShouldBeNil)
+		So(cli.UpdateCollectionContent(editor, desc.ID, "/about/contact/data.json", json.RawMessage(contactPage)), ShouldBeNil)
+		So(cli.UploadFile(editor, desc.ID, "/about/contact/contacts.csv", strings.NewReader("name,email\n"), "text/csv"), ShouldBeNil)
+
+		Convey("When the page is moved recursively with its attachments", func() {
+			report, err := zebedee.MoveContent(ctx, cli, editor, desc.ID, "/about/contact", "/help/contact", opts)
+			So(err, ShouldBeNil)
+
+			Convey("Then the report lists the content created with the fields rewritten", func() {
+				So(report.Created, ShouldResemble, []zebedee.Relocation{
+					{From: "/about/contact/data.json", To: "/help/contact/data.json", Fields: []string{"links[0].uri", "uri", "breadcrumb"}},
+					{From: "/about/contact/contacts.csv", To: "/help/contact/contacts.csv"},
+					{From: "/about/contact/team/data.json", To: "/help/contact/team/data.json", Fields: []string{"uri"}},
+				})
+			})
+
+			Convey("Then the content in the collection is deleted and the published content is reported", func() {
+				So(report.Deleted, ShouldResemble, []string{"/about/contact/data.json", "/about/contact/contacts.csv"})
+				So(report.Published, ShouldResemble, []string{"/about/contact/team/data.json"})
+
+				_, err := cli.GetContent(editor, desc.ID, "/about/contact/data.json")
+				So(errors.Is(err, zebedee.ErrNotFound), ShouldBeTrue)
+			})
+
+			Convey("Then the moved page has the new uri and breadcrumb", func() {
+				var page map[string]interface{}
+				So(cli.GetContentJSON(editor, desc.ID, "/help/contact/data.json", &page), ShouldBeNil)
+				So(page["uri"], ShouldEqual, "/help/contact")
+				So(page["breadcrumb"], ShouldResemble, []interface{}{
+					map[string]interface{}{"uri": "/", "title": "Home"},
+					map[string]interface{}{"uri": "/help"},
+				})
+			})
+
+			Convey("Then the attachment is moved", func() {
+				b, err := cli.GetContent(editor, desc.ID, "/help/contact/contacts.csv")
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, "name,email\n")
+			})
+		})
+
+		Convey("When the page is moved on its own", func() {
+			report, err := zebedee.MoveContent(ctx, cli, editor, desc.ID, "/about/contact", "/help/contact", zebedee.RelocateOptions{})
+			So(err, ShouldBeNil)
+
+			Convey("Then only the uri and breadcrumb of the page are rewritten", func() {
+				So(report.Created, ShouldResemble, []zebedee.Relocation{
+					{From: "/about/contact/data.json", To: "/help/contact/data.json", Fields: []string{"uri", "breadcrumb"}},
+				})
+			})
+
+			Convey("Then the link to the page below it is left alone", func() {
+				var page map[string]interface{}
+				So(cli.GetContentJSON(editor, desc.ID, "/help/contact/data.json", &page), ShouldBeNil)
+				So(page["links"].([]interface{})[0], ShouldResemble, map[string]interface{}{"title": "Team", "uri": "/about/contact/team"})
+			})
+		})
+
+		Convey("When the page is copied on its own", func() {
+			report, err := zebedee.CopyContent(ctx, cli, editor, desc.ID, "/about/contact/data.json", "/about/contactus", zebedee.RelocateOptions{})
+			So(err, ShouldBeNil)
+
+			Convey("Then only the page is created and nothing is deleted", func() {
+				So(report.Created, ShouldHaveLength, 1)
+				So(report.Created[0].To, ShouldEqual, "/about/contactus/data.json")
+				So(report.Deleted, ShouldBeEmpty)
+
+				_, err := cli.GetContent(editor, desc.ID, "/about/contact/data.json")
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When the page is renamed", func() {
+			report, err := zebedee.RenameContent(ctx, cli, editor, desc.ID, "/about/contact", "contactus", zebedee.RelocateOptions{})
+
+			Convey("Then it is moved within the same directory", func() {
+				So(err, ShouldBeNil)
+				So(report.Created[0].To, ShouldEqual, "/about/contactus/data.json")
+				So(report.Deleted, ShouldResemble, []string{"/about/contact/data.json"})
+			})
+		})
+
+		Convey("When the page is copied over existing content", func() {
+			_, err := zebedee.CopyContent(ctx, cli, editor, desc.ID, "/about/contact/team", "/about/contact", zebedee.RelocateOptions{})
+
+			Convey("Then a conflict error is returned", func() {
+				So(errors.Is(err, zebedee.ErrConflict), ShouldBeTrue)
+			})
+		})
+
+		Convey("When the page is copied with its attachments over an existing file", func() {
+			So(cli.UploadFile(editor, desc.ID, "/about/contactus/contacts.csv", strings.NewReader("existing\n"), "text/csv"), ShouldBeNil)
+
+			report, err := zebedee.CopyContent(ctx, cli, editor, desc.ID, "/about/contact", "/about/contactus", zebedee.RelocateOptions{Attachments: true})
+
+			Convey("Then a conflict error is returned and the existing file is not overwritten", func() {
+				So(errors.Is(err, zebedee.ErrConflict), ShouldBeTrue)
+				So(report.Created, ShouldHaveLength, 1)
+				So(report.Created[0].To, ShouldEqual, "/about/contactus/data.json")
+
+				b, err := cli.GetContent(editor, desc.ID, "/about/contactus/contacts.csv")
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, "existing\n")
+			})
+		})
+
+		Convey("When the page is moved recursively below itself", func() {
+			_, err := zebedee.MoveContent(ctx, cli, editor, desc.ID, "/about/contact", "/about/contact/old", opts)
+
+			Convey("Then an invalid relocation error is returned", func() {
+				So(errors.Is(err, zebedee.ErrInvalidRelocation), ShouldBeTrue)
+			})
+		})
+	})
+}
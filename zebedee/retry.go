@@ -0,0 +1,270 @@
+package zebedee
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how requests to Zebedee are retried when they fail with a transient error.
+// Zero values are replaced with the corresponding value from DefaultRetryPolicy.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts made, including the first request.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts. It does not cap a delay requested by a Retry-After header.
+	MaxBackoff time.Duration
+	// Multiplier is applied to the backoff after each attempt.
+	Multiplier float64
+	// Jitter is the fraction (0 to 1) of each backoff that is randomised.
+	Jitter float64
+	// RetryableStatuses are the response status codes that are retried.
+	RetryableStatuses []int
+	// RetryNonIdempotent allows requests that are not idempotent, such as PublishCollection, to be retried.
+	// Use AllowRetry to opt in for a single call instead.
+	RetryNonIdempotent bool
+	// IsIdempotent overrides the classification of which requests are safe to retry.
+	IsIdempotent func(req *http.Request) bool
+	// OnAttempt is called after every attempt.
+	OnAttempt func(a Attempt)
+}
+
+// Attempt describes a single attempt at sending a request, passed to RetryPolicy.OnAttempt.
+type Attempt struct {
+	Number     int
+	Request    *http.Request
+	StatusCode int
+	Err        error
+	WillRetry  bool
+	Delay      time.Duration
+}
+
+type retryContextKey int
+
+const (
+	allowRetryKey retryContextKey = iota
+	idempotentKey
+)
+
+// DefaultRetryPolicy returns the retry policy used by WithRetryPolicy for any unset fields.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond * 200,
+		MaxBackoff:     time.Second * 5,
+		Multiplier:     2,
+		Jitter:         0.5,
+		RetryableStatuses: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		IsIdempotent: IsIdempotent,
+	}
+}
+
+// WithRetryPolicy enables retries of failed requests using the provided policy.
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(z *zebedeeClient) {
+		def := DefaultRetryPolicy()
+		if p.MaxAttempts <= 0 {
+			p.MaxAttempts = def.MaxAttempts
+		}
+		if p.InitialBackoff <= 0 {
+			p.InitialBackoff = def.InitialBackoff
+		}
+		if p.MaxBackoff <= 0 {
+			p.MaxBackoff = def.MaxBackoff
+		}
+		if p.Multiplier < 1 {
+			p.Multiplier = def.Multiplier
+		}
+		if p.Jitter < 0 || p.Jitter > 1 {
+			p.Jitter = def.Jitter
+		}
+		if p.RetryableStatuses == nil {
+			p.RetryableStatuses = def.RetryableStatuses
+		}
+		if p.IsIdempotent == nil {
+			p.IsIdempotent = def.IsIdempotent
+		}
+		z.retryPolicy = &p
+	}
+}
+
+// AllowRetry returns a context that allows requests made with it to be retried even if they are not idempotent.
+func AllowRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, allowRetryKey, true)
+}
+
+// IsIdempotent is the default idempotency classification. GET, HEAD, OPTIONS and PUT requests are idempotent, as are
+// the POST requests the client marks as safe to repeat, such as SetPermissions and UpdateCollectionContent.
+func IsIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut:
+		return true
+	}
+
+	idempotent, _ := req.Context().Value(idempotentKey).(bool)
+	return idempotent
+}
+
+// markIdempotent flag a request that is safe to repeat regardless of its HTTP method
+func markIdempotent(req *http.Request) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), idempotentKey, true))
+}
+
+// doWithRetry send the request, retrying according to the client retry policy.
+func (z *zebedeeClient) doWithRetry(req *http.Request) (*http.Response, error) {
+	p := z.retryPolicy
+	if p == nil {
+		return z.HttpClient.Do(req.Context(), req)
+	}
+
+	retryable := p.canRetry(req)
+	ctx := req.Context()
+
+	for attempt := 1; ; attempt++ {
+		resp, err := z.HttpClient.Do(ctx, req)
+
+		a := Attempt{Number: attempt, Request: req, Err: err}
+		if resp != nil {
+			a.StatusCode = resp.StatusCode
+		}
+		a.WillRetry = retryable && attempt < p.MaxAttempts && p.shouldRetry(ctx, resp, err)
+		if a.WillRetry {
+			a.Delay = p.backoff(attempt, resp)
+		}
+
+		if p.OnAttempt != nil {
+			p.OnAttempt(a)
+		}
+
+		if !a.WillRetry {
+			return resp, err
+		}
+
+		if resp != nil {
+			_ = discardResponse(resp)
+			resp.Body.Close()
+		}
+
+		if err = sleep(ctx, a.Delay); err != nil {
+			return nil, err
+		}
+
+		if req, err = rewind(req); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// canRetry return true if the request may be sent more than once
+func (p *RetryPolicy) canRetry(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	if p.RetryNonIdempotent || p.IsIdempotent(req) {
+		return true
+	}
+
+	allowed, _ := req.Context().Value(allowRetryKey).(bool)
+	return allowed
+}
+
+// shouldRetry return true if the result of an attempt is a transient failure
+func (p *RetryPolicy) shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	for _, status := range p.RetryableStatuses {
+		if resp.StatusCode == status {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff return the delay before the next attempt, honouring any Retry-After header in the response
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return d
+		}
+	}
+
+	d := float64(p.InitialBackoff)
+	for i := 1; i < attempt; i++ {
+		d *= p.Multiplier
+	}
+	if d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+
+	d -= d * p.Jitter * rand.Float64() //nolint:gosec // jitter does not need a secure random source
+	return time.Duration(d)
+}
+
+// parseRetryAfter parse a Retry-After header value given in either seconds or as an HTTP date
+func parseRetryAfter(val string) (time.Duration, bool) {
+	if val == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(val); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(val); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
+
+// sleep wait for the duration or until the context is done
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// rewind return a copy of the request with a fresh body so it can be sent again
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	r := req.Clone(req.Context())
+	r.Body = body
+	return r, nil
+}
@@ -0,0 +1,339 @@
+package zebedee
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee/mock"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type mockResponse struct {
+	status  int
+	body    string
+	headers http.Header
+	err     error
+}
+
+func Test_RetryPolicy_IdempotentRequests(t *testing.T) {
+	session := newSession()
+
+	Convey("Given Zebedee returns a 503 before succeeding", t, func() {
+		httpClient := mockHttpResponseSequence(
+			mockResponse{status: http.StatusServiceUnavailable},
+			mockResponse{status: http.StatusBadGateway},
+			mockResponse{status: http.StatusOK, body: `{"id":"collectionID"}`},
+		)
+		var attempts []Attempt
+		zebedeeClient := NewClientWithOptions(host,
+			WithHttpClient(httpClient),
+			WithRetryPolicy(RetryPolicy{
+				InitialBackoff: time.Millisecond,
+				OnAttempt: func(a Attempt) {
+					attempts = append(attempts, a)
+				},
+			}),
+		)
+
+		Convey("When GetCollectionByID is called", func() {
+			desc, err := zebedeeClient.GetCollectionByID(session, collectionId)
+
+			Convey("Then the request is retried until it succeeds", func() {
+				So(err, ShouldBeNil)
+				So(desc.ID, ShouldEqual, collectionId)
+				So(httpClient.DoCalls(), ShouldHaveLength, 3)
+			})
+
+			Convey("Then each attempt is reported to the hook", func() {
+				So(attempts, ShouldHaveLength, 3)
+				So(attempts[0].Number, ShouldEqual, 1)
+				So(attempts[0].StatusCode, ShouldEqual, http.StatusServiceUnavailable)
+				So(attempts[0].WillRetry, ShouldBeTrue)
+				So(attempts[1].StatusCode, ShouldEqual, http.StatusBadGateway)
+				So(attempts[1].WillRetry, ShouldBeTrue)
+				So(attempts[2].StatusCode, ShouldEqual, http.StatusOK)
+				So(attempts[2].WillRetry, ShouldBeFalse)
+			})
+		})
+	})
+
+	Convey("Given Zebedee keeps returning a 503", t, func() {
+		httpClient := mockHttpResponseSequence(mockResponse{status: http.StatusServiceUnavailable})
+		zebedeeClient := NewClientWithOptions(host,
+			WithHttpClient(httpClient),
+			WithRetryPolicy(RetryPolicy{MaxAttempts: 4, InitialBackoff: time.Millisecond}),
+		)
+
+		Convey("When GetCollections is called", func() {
+			_, err := zebedeeClient.GetCollections(session)
+
+			Convey("Then the request is attempted MaxAttempts times and the last error returned", func() {
+				So(httpClient.DoCalls(), ShouldHaveLength, 4)
+
+				var apiErr *APIError
+				So(errors.As(err, &apiErr), ShouldBeTrue)
+				So(apiErr.ActualStatus, ShouldEqual, http.StatusServiceUnavailable)
+			})
+		})
+	})
+
+	Convey("Given Zebedee returns a status that is not retryable", t, func() {
+		httpClient := mockHttpResponseSequence(mockResponse{status: http.StatusNotFound})
+		zebedeeClient := NewClientWithOptions(host,
+			WithHttpClient(httpClient),
+			WithRetryPolicy(RetryPolicy{InitialBackoff: time.Millisecond}),
+		)
+
+		Convey("When GetCollectionByID is called", func() {
+			_, err := zebedeeClient.GetCollectionByID(session, collectionId)
+
+			Convey("Then the request is not retried", func() {
+				So(err, ShouldNotBeNil)
+				So(httpClient.DoCalls(), ShouldHaveLength, 1)
+			})
+		})
+	})
+
+	Convey("Given the HTTP client returns a transport error before succeeding", t, func() {
+		httpClient := mockHttpResponseSequence(
+			mockResponse{err: errors.New("connection reset")},
+			mockResponse{status: http.StatusOK, body: `true`},
+		)
+		zebedeeClient := NewClientWithOptions(host,
+			WithHttpClient(httpClient),
+			WithRetryPolicy(RetryPolicy{InitialBackoff: time.Millisecond}),
+		)
+
+		Convey("When UpdateCollectionContent is called", func() {
+			err := zebedeeClient.UpdateCollectionContent(session, collectionId, uri, getContent())
+
+			Convey("Then the request is retried with the same body", func() {
+				So(err, ShouldBeNil)
+				So(httpClient.DoCalls(), ShouldHaveLength, 2)
+
+				body, _ := io.ReadAll(httpClient.DoCalls()[1].Req.Body)
+				So(string(body), ShouldEqual, pageContent)
+			})
+		})
+	})
+}
+
+func Test_RetryPolicy_NonIdempotentRequests(t *testing.T) {
+	session := newSession()
+
+	Convey("Given Zebedee returns a 503 before succeeding", t, func() {
+		responses := []mockResponse{
+			{status: http.StatusServiceUnavailable},
+			{status: http.StatusOK, body: `true`},
+		}
+
+		Convey("When PublishCollection is called with the default policy", func() {
+			httpClient := mockHttpResponseSequence(responses...)
+			zebedeeClient := NewClientWithOptions(host,
+				WithHttpClient(httpClient),
+				WithRetryPolicy(RetryPolicy{InitialBackoff: time.Millisecond}),
+			)
+			err := zebedeeClient.PublishCollection(session, collectionId)
+
+			Convey("Then the request is not retried", func() {
+				So(err, ShouldNotBeNil)
+				So(httpClient.DoCalls(), ShouldHaveLength, 1)
+			})
+		})
+
+		Convey("When PublishCollection is called with a context that allows retries", func() {
+			httpClient := mockHttpResponseSequence(responses...)
+			zebedeeClient := NewClientWithOptions(host,
+				WithHttpClient(httpClient),
+				WithRetryPolicy(RetryPolicy{InitialBackoff: time.Millisecond}),
+			)
+			err := zebedeeClient.PublishCollectionContext(AllowRetry(context.Background()), session, collectionId)
+
+			Convey("Then the request is retried", func() {
+				So(err, ShouldBeNil)
+				So(httpClient.DoCalls(), ShouldHaveLength, 2)
+			})
+		})
+
+		Convey("When ApproveCollection is called with a policy that retries non idempotent requests", func() {
+			httpClient := mockHttpResponseSequence(responses...)
+			zebedeeClient := NewClientWithOptions(host,
+				WithHttpClient(httpClient),
+				WithRetryPolicy(RetryPolicy{InitialBackoff: time.Millisecond, RetryNonIdempotent: true}),
+			)
+			err := zebedeeClient.ApproveCollection(session, collectionId)
+
+			Convey("Then the request is retried", func() {
+				So(err, ShouldBeNil)
+				So(httpClient.DoCalls(), ShouldHaveLength, 2)
+			})
+		})
+	})
+}
+
+func Test_RetryPolicy_RetryAfter(t *testing.T) {
+	session := newSession()
+
+	Convey("Given Zebedee returns a 429 with a Retry-After header", t, func() {
+		httpClient := mockHttpResponseSequence(
+			mockResponse{status: http.StatusTooManyRequests, headers: http.Header{"Retry-After": []string{"0"}}},
+			mockResponse{status: http.StatusOK, body: `[]`},
+		)
+		var delays []time.Duration
+		zebedeeClient := NewClientWithOptions(host,
+			WithHttpClient(httpClient),
+			WithRetryPolicy(RetryPolicy{
+				InitialBackoff: time.Hour,
+				OnAttempt: func(a Attempt) {
+					delays = append(delays, a.Delay)
+				},
+			}),
+		)
+
+		Convey("When GetUsers is called", func() {
+			_, err := zebedeeClient.GetUsers(session)
+
+			Convey("Then the Retry-After delay is used instead of the backoff", func() {
+				So(err, ShouldBeNil)
+				So(httpClient.DoCalls(), ShouldHaveLength, 2)
+				So(delays[0], ShouldEqual, 0)
+			})
+		})
+	})
+
+	Convey("Given a context that is cancelled while waiting to retry", t, func() {
+		httpClient := mockHttpResponseSequence(mockResponse{status: http.StatusServiceUnavailable})
+		ctx, cancel := context.WithCancel(context.Background())
+		zebedeeClient := NewClientWithOptions(host,
+			WithHttpClient(httpClient),
+			WithRetryPolicy(RetryPolicy{
+				InitialBackoff: time.Hour,
+				OnAttempt: func(a Attempt) {
+					cancel()
+				},
+			}),
+		)
+
+		Convey("When GetUsersContext is called", func() {
+			_, err := zebedeeClient.GetUsersContext(ctx, session)
+
+			Convey("Then the context error is returned", func() {
+				So(errors.Is(err, context.Canceled), ShouldBeTrue)
+				So(httpClient.DoCalls(), ShouldHaveLength, 1)
+			})
+		})
+	})
+}
+
+func Test_parseRetryAfter(t *testing.T) {
+	Convey("parseRetryAfter handles seconds, HTTP dates and invalid values", t, func() {
+		d, ok := parseRetryAfter("5")
+		So(ok, ShouldBeTrue)
+		So(d, ShouldEqual, time.Second*5)
+
+		d, ok = parseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+		So(ok, ShouldBeTrue)
+		So(d, ShouldBeBetween, time.Second*55, time.Minute)
+
+		_, ok = parseRetryAfter("")
+		So(ok, ShouldBeFalse)
+
+		_, ok = parseRetryAfter("soon")
+		So(ok, ShouldBeFalse)
+
+		_, ok = parseRetryAfter("-1")
+		So(ok, ShouldBeFalse)
+	})
+}
+
+func Test_RetryPolicy_backoff(t *testing.T) {
+	Convey("Given a retry policy without jitter", t, func() {
+		p := RetryPolicy{InitialBackoff: time.Second, MaxBackoff: time.Second * 5, Multiplier: 2}
+
+		Convey("Then the backoff grows exponentially up to the maximum", func() {
+			So(p.backoff(1, nil), ShouldEqual, time.Second)
+			So(p.backoff(2, nil), ShouldEqual, time.Second*2)
+			So(p.backoff(3, nil), ShouldEqual, time.Second*4)
+			So(p.backoff(4, nil), ShouldEqual, time.Second*5)
+		})
+	})
+
+	Convey("Given a retry policy with jitter", t, func() {
+		p := RetryPolicy{InitialBackoff: time.Second, MaxBackoff: time.Second * 5, Multiplier: 2, Jitter: 0.5}
+
+		Convey("Then the backoff is randomised within the jitter range", func() {
+			for i := 0; i < 20; i++ {
+				So(p.backoff(2, nil), ShouldBeBetweenOrEqual, time.Second, time.Second*2)
+			}
+		})
+	})
+}
+
+// mockHttpResponseSequence return a mock HTTP client that returns each response in turn, repeating the last one.
+func mockHttpResponseSequence(responses ...mockResponse) *mock.HttpClientMock {
+	calls := 0
+	return &mock.HttpClientMock{
+		DoFunc: func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			r := responses[len(responses)-1]
+			if calls < len(responses) {
+				r = responses[calls]
+			}
+			calls++
+
+			if r.err != nil {
+				return nil, r.err
+			}
+
+			recorder := httptest.NewRecorder()
+			for k, v := range r.headers {
+				recorder.Header()[k] = v
+			}
+			recorder.Code = r.status
+			recorder.Body = bytes.NewBufferString(r.body)
+			res := recorder.Result()
+			res.Request = req
+			return res, nil
+		},
+	}
+}
+
+func Test_RetryPolicy_DefaultHttpClient(t *testing.T) {
+	session := newSession()
+
+	Convey("Given Zebedee always returns a 503 and a client using the default HttpClient", t, func() {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		zebedeeClient := NewClientWithOptions(server.URL, WithRetryPolicy(RetryPolicy{InitialBackoff: time.Millisecond}))
+
+		Convey("When PublishCollection is called", func() {
+			err := zebedeeClient.PublishCollection(session, collectionId)
+
+			Convey("Then the request is sent once", func() {
+				So(err, ShouldNotBeNil)
+				So(atomic.LoadInt32(&requests), ShouldEqual, 1)
+			})
+		})
+
+		Convey("When GetCollectionByID is called", func() {
+			_, err := zebedeeClient.GetCollectionByID(session, collectionId)
+
+			Convey("Then the request is only retried by the policy", func() {
+				So(err, ShouldNotBeNil)
+				So(atomic.LoadInt32(&requests), ShouldEqual, 3)
+			})
+		})
+	})
+}
@@ -0,0 +1,127 @@
+package zebedee
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// publishDateLayouts are the layouts accepted by ParsePublishDate, CollectionDateFMT first
+var publishDateLayouts = []string{CollectionDateFMT, time.RFC3339Nano}
+
+// FormatPublishDate format the time as a collection publish date, converting it to UTC.
+func FormatPublishDate(t time.Time) string {
+	return t.UTC().Format(CollectionDateFMT)
+}
+
+// ParsePublishDate parse a collection publish date, returning the time in UTC.
+func ParsePublishDate(s string) (time.Time, error) {
+	for _, layout := range publishDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: %q is not in the format %s", ErrInvalidPublishDate, s, CollectionDateFMT)
+}
+
+// PublishTime return the publish date of the collection as a time in UTC.
+func (c collectionBase) PublishTime() (time.Time, error) {
+	return ParsePublishDate(c.PublishDate)
+}
+
+// NewScheduledCollection create a new collection description scheduled to publish at the time provided, which must be
+// in the future.
+func NewScheduledCollection(name string, t time.Time) (CollectionDescription, error) {
+	if err := validateSchedule(t); err != nil {
+		return CollectionDescription{}, err
+	}
+
+	desc := NewCollection(name)
+	desc.Type = Scheduled
+	desc.PublishDate = FormatPublishDate(t)
+	return desc, nil
+}
+
+// Reschedule update the collection to publish at the time provided, which must be in the future. Manual collections
+// are changed to scheduled.
+func Reschedule(ctx context.Context, cli CollectionsAPI, s Session, id string, t time.Time) error {
+	if err := validateSchedule(t); err != nil {
+		return err
+	}
+
+	desc, err := cli.GetCollectionByIDContext(ctx, s, id)
+	if err != nil {
+		return err
+	}
+
+	desc.Type = Scheduled
+	desc.PublishDate = FormatPublishDate(t)
+	return cli.UpdateCollectionContext(ctx, s, desc)
+}
+
+// ListScheduled return the scheduled collections which have not been published with a publish date from the start time
+// (inclusive) up to the end time (exclusive), ordered by publish date. A zero end time has no upper limit.
+func ListScheduled(ctx context.Context, cli CollectionsAPI, s Session, from, to time.Time) ([]CollectionDescription, error) {
+	collections, err := cli.GetCollectionsContext(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+
+	type scheduled struct {
+		desc CollectionDescription
+		at   time.Time
+	}
+
+	matches := make([]scheduled, 0)
+	for _, desc := range collections {
+		if desc.Type != Scheduled || desc.PublishComplete {
+			continue
+		}
+
+		at, err := desc.PublishTime()
+		if err != nil || at.Before(from) || (!to.IsZero() && !at.Before(to)) {
+			continue
+		}
+
+		matches = append(matches, scheduled{desc: desc, at: at})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].at.Before(matches[j].at)
+	})
+
+	list := make([]CollectionDescription, 0, len(matches))
+	for _, m := range matches {
+		list = append(list, m.desc)
+	}
+	return list, nil
+}
+
+// validateSchedule return an error if the time is not in the future
+func validateSchedule(t time.Time) error {
+	if t.IsZero() {
+		return fmt.Errorf("%w: publish date is required", ErrInvalidPublishDate)
+	}
+	if !t.After(time.Now()) {
+		return fmt.Errorf("%w: %s is not in the future", ErrInvalidPublishDate, FormatPublishDate(t))
+	}
+	return nil
+}
+
+// validateCopiedSchedule return an error if the collection is scheduled with a publish date copied from another
+// collection which is not in the future, as it would be published as soon as it is approved
+func validateCopiedSchedule(spec CollectionDescription) error {
+	if spec.Type != Scheduled {
+		return nil
+	}
+
+	t, err := spec.PublishTime()
+	if err != nil {
+		return err
+	}
+	if err := validateSchedule(t); err != nil {
+		return fmt.Errorf("%w, set a new publish date", err)
+	}
+	return nil
+}
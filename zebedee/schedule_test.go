@@ -0,0 +1,178 @@
+package zebedee
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_ParsePublishDate(t *testing.T) {
+	Convey("Given a publish date in the collection date format", t, func() {
+		date := "2021-06-01T09:30:00.000Z"
+
+		Convey("Then it is parsed as UTC and formats back to the same value", func() {
+			parsed, err := ParsePublishDate(date)
+			So(err, ShouldBeNil)
+			So(parsed, ShouldEqual, time.Date(2021, 6, 1, 9, 30, 0, 0, time.UTC))
+			So(FormatPublishDate(parsed), ShouldEqual, date)
+		})
+	})
+
+	Convey("Given a publish date with a time zone offset", t, func() {
+		date := "2021-06-01T10:30:00+01:00"
+
+		Convey("Then it is normalised to UTC", func() {
+			parsed, err := ParsePublishDate(date)
+			So(err, ShouldBeNil)
+			So(FormatPublishDate(parsed), ShouldEqual, "2021-06-01T09:30:00.000Z")
+		})
+	})
+
+	Convey("Given an invalid publish date", t, func() {
+		Convey("Then an invalid publish date error is returned", func() {
+			_, err := ParsePublishDate("01/06/2021")
+			So(errors.Is(err, ErrInvalidPublishDate), ShouldBeTrue)
+		})
+	})
+}
+
+func Test_NewScheduledCollection(t *testing.T) {
+	Convey("Given a publish time in the future in a local time zone", t, func() {
+		loc := time.FixedZone("BST", 60*60)
+		at := time.Now().Add(time.Hour).In(loc).Truncate(time.Millisecond)
+
+		Convey("When NewScheduledCollection is called", func() {
+			desc, err := NewScheduledCollection("scheduled", at)
+
+			Convey("Then the collection is scheduled for the time in UTC", func() {
+				So(err, ShouldBeNil)
+				So(desc.Type, ShouldEqual, Scheduled)
+				So(desc.PublishDate, ShouldEndWith, "Z")
+
+				publishTime, err := desc.PublishTime()
+				So(err, ShouldBeNil)
+				So(publishTime.Equal(at), ShouldBeTrue)
+			})
+
+			Convey("Then the publish type and date round trip through JSON", func() {
+				b, err := json.Marshal(desc)
+				So(err, ShouldBeNil)
+
+				var decoded CollectionDescription
+				So(json.Unmarshal(b, &decoded), ShouldBeNil)
+				So(decoded.Type, ShouldEqual, Scheduled)
+				So(decoded.PublishDate, ShouldEqual, desc.PublishDate)
+			})
+		})
+	})
+
+	Convey("Given a publish time in the past", t, func() {
+		Convey("Then an invalid publish date error is returned", func() {
+			_, err := NewScheduledCollection("scheduled", time.Now().Add(-time.Minute))
+			So(errors.Is(err, ErrInvalidPublishDate), ShouldBeTrue)
+		})
+	})
+}
+
+func Test_PublishType_JSON(t *testing.T) {
+	Convey("Given publish types encoded by Zebedee", t, func() {
+		cases := map[string]PublishType{
+			`"manual"`:    Manual,
+			`"scheduled"`: Scheduled,
+			`"SCHEDULED"`: Scheduled,
+		}
+
+		Convey("Then they are decoded to the matching publish type", func() {
+			for raw, expected := range cases {
+				pt := Manual
+				So(json.Unmarshal([]byte(raw), &pt), ShouldBeNil)
+				So(pt, ShouldEqual, expected)
+			}
+		})
+
+		Convey("Then a null publish type leaves the value unchanged", func() {
+			pt := Scheduled
+			So(json.Unmarshal([]byte(`null`), &pt), ShouldBeNil)
+			So(pt, ShouldEqual, Scheduled)
+		})
+
+		Convey("Then an unknown publish type cannot be encoded", func() {
+			_, err := json.Marshal(PublishType(7))
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func Test_ListScheduled(t *testing.T) {
+	body := `[
+		{"id":"late","type":"scheduled","publishDate":"2021-06-03T09:30:00.000Z"},
+		{"id":"manual","type":"manual","publishDate":"2021-06-02T09:30:00.000Z"},
+		{"id":"early","type":"scheduled","publishDate":"2021-06-02T09:30:00.000Z"},
+		{"id":"published","type":"scheduled","publishDate":"2021-06-02T09:30:00.000Z","publishComplete":true},
+		{"id":"outside","type":"scheduled","publishDate":"2021-06-05T09:30:00.000Z"}
+	]`
+
+	Convey("Given a list of collections", t, func() {
+		cli := NewClient(host, mockHttpResponse(http.StatusOK, body))
+		from := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2021, 6, 4, 0, 0, 0, 0, time.UTC)
+
+		Convey("When ListScheduled is called", func() {
+			list, err := ListScheduled(context.Background(), cli, newSession(), from, to)
+
+			Convey("Then the unpublished scheduled collections in the range are returned in publish order", func() {
+				So(err, ShouldBeNil)
+				So(list, ShouldHaveLength, 2)
+				So(list[0].ID, ShouldEqual, "early")
+				So(list[1].ID, ShouldEqual, "late")
+			})
+		})
+	})
+}
+
+func Test_Reschedule(t *testing.T) {
+	Convey("Given a manual collection", t, func() {
+		httpClient := mockHttpResponseSequence(
+			mockResponse{status: http.StatusOK, body: `{"id":"collectionID","name":"test","type":"manual"}`},
+			mockResponse{status: http.StatusOK, body: `{}`},
+		)
+		cli := NewClient(host, httpClient)
+		at := time.Now().Add(24 * time.Hour)
+
+		Convey("When Reschedule is called", func() {
+			err := Reschedule(context.Background(), cli, newSession(), collectionId, at)
+
+			Convey("Then the collection is updated to be scheduled at the time provided", func() {
+				So(err, ShouldBeNil)
+				So(httpClient.DoCalls(), ShouldHaveLength, 2)
+
+				req := httpClient.DoCalls()[1].Req
+				So(req.Method, ShouldEqual, http.MethodPut)
+
+				b, err := io.ReadAll(req.Body)
+				So(err, ShouldBeNil)
+
+				var desc CollectionDescription
+				So(json.Unmarshal(b, &desc), ShouldBeNil)
+				So(desc.Name, ShouldEqual, "test")
+				So(desc.Type, ShouldEqual, Scheduled)
+				So(desc.PublishDate, ShouldEqual, FormatPublishDate(at))
+			})
+		})
+
+		Convey("When Reschedule is called with a time in the past", func() {
+			err := Reschedule(context.Background(), cli, newSession(), collectionId, time.Now().Add(-time.Hour))
+
+			Convey("Then an error is returned without a request to Zebedee", func() {
+				So(errors.Is(err, ErrInvalidPublishDate), ShouldBeTrue)
+				So(httpClient.DoCalls(), ShouldBeEmpty)
+			})
+		})
+	})
+}
@@ -0,0 +1,154 @@
+package zebedee
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/ONSdigital/dp-net/v2/request"
+)
+
+// TokenSource return a new auth token, e.g. a service JWT, used by a SessionProvider.
+type TokenSource func(ctx context.Context) (string, error)
+
+// SessionProvider opens a session when it is first used and opens a new one when Zebedee rejects it as expired, so
+// long-running jobs are not interrupted by the session timing out. The Session it returns can be passed to any Client
+// method in place of a fixed Session. A SessionProvider is safe for concurrent use.
+type SessionProvider struct {
+	cli   AuthAPI
+	creds Credentials
+	src   TokenSource
+
+	mu sync.Mutex
+	id string
+}
+
+type sessionContextKey int
+
+const providerKey sessionContextKey = iota
+
+// NewSessionProvider create a SessionProvider which logs in with the credentials provided.
+func NewSessionProvider(cli AuthAPI, c Credentials) *SessionProvider {
+	return &SessionProvider{cli: cli, creds: c}
+}
+
+// NewTokenSessionProvider create a SessionProvider which uses auth tokens from the source provided.
+func NewTokenSessionProvider(src TokenSource) *SessionProvider {
+	return &SessionProvider{src: src}
+}
+
+// Session return a session backed by the provider. No request is made until the session is used, and each request
+// uses the latest session opened by the provider.
+func (p *SessionProvider) Session() Session {
+	return Session{Email: p.creds.Email, provider: p}
+}
+
+// Open return the current session, opening a new one if no session is open. Use Open to check the credentials before
+// the session is first used.
+func (p *SessionProvider) Open(ctx context.Context) (Session, error) {
+	id, err := p.token(ctx)
+	if err != nil {
+		return Session{}, err
+	}
+	return Session{Email: p.creds.Email, ID: id, provider: p}, nil
+}
+
+// token return the current session ID, opening a new session if none is open
+func (p *SessionProvider) token(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.id != "" {
+		return p.id, nil
+	}
+	return p.openLocked(ctx)
+}
+
+// refresh open a new session to replace the expired session ID provided. If another request has already replaced it
+// the new session is returned without logging in again.
+func (p *SessionProvider) refresh(ctx context.Context, expired string) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.id != "" && p.id != expired {
+		return p.id, nil
+	}
+	return p.openLocked(ctx)
+}
+
+func (p *SessionProvider) openLocked(ctx context.Context) (string, error) {
+	var id string
+	if p.src != nil {
+		token, err := p.src(ctx)
+		if err != nil {
+			return "", err
+		}
+		id = token
+	} else {
+		s, err := p.cli.OpenSessionContext(ctx, p.creds)
+		if err != nil {
+			return "", err
+		}
+		id = s.ID
+	}
+
+	p.id = id
+	return id, nil
+}
+
+// isZero return true if the session has no ID and is not backed by a SessionProvider, which opens the session when it
+// is first used
+func (s Session) isZero() bool {
+	return s.ID == "" && s.provider == nil
+}
+
+// authenticate set the auth header of the request for the session. Requests for sessions from a SessionProvider
+// carry the provider in their context so they can be re-authenticated.
+func authenticate(req *http.Request, s Session) (*http.Request, error) {
+	if s.provider == nil {
+		req.Header.Set(request.FlorenceHeaderKey, s.ID)
+		return req, nil
+	}
+
+	id, err := s.provider.token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set(request.FlorenceHeaderKey, id)
+	return req.WithContext(context.WithValue(req.Context(), providerKey, s.provider)), nil
+}
+
+// doAuthenticated send the request, opening a new session and sending it once more if Zebedee rejects the session
+// of a SessionProvider as unauthorized. The request is not repeated if its body cannot be read again.
+func (z *zebedeeClient) doAuthenticated(req *http.Request) (*http.Response, error) {
+	resp, err := z.doWithRetry(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	p, ok := req.Context().Value(providerKey).(*SessionProvider)
+	if !ok || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+		return resp, nil
+	}
+
+	retry, err := rewind(req)
+	if err != nil {
+		return resp, nil
+	}
+
+	_ = discardResponse(resp)
+	resp.Body.Close()
+
+	id, err := p.refresh(req.Context(), req.Header.Get(request.FlorenceHeaderKey))
+	if err != nil {
+		return nil, err
+	}
+
+	if retry == req {
+		retry = req.Clone(req.Context())
+	}
+	retry.Header.Set(request.FlorenceHeaderKey, id)
+
+	return z.doWithRetry(retry)
+}
@@ -0,0 +1,114 @@
+package zebedee_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSessionProvider(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("Given a session provider with valid credentials", t, func() {
+		fake, cli, _, _ := newFake()
+		defer fake.Close()
+
+		provider := zebedee.NewSessionProvider(cli, zebedee.Credentials{Email: "editor@ons.gov.uk", Password: "password"})
+		s := provider.Session()
+
+		Convey("Then the session is opened when it is first used", func() {
+			So(s.ID, ShouldBeEmpty)
+
+			desc, err := cli.CreateCollection(s, zebedee.NewCollection("Census"))
+			So(err, ShouldBeNil)
+			So(desc.ID, ShouldNotBeEmpty)
+		})
+
+		Convey("When the session expires", func() {
+			opened, err := provider.Open(ctx)
+			So(err, ShouldBeNil)
+
+			fake.ExpireSessions()
+
+			Convey("Then requests log in again and are sent once more", func() {
+				desc, err := cli.CreateCollection(s, zebedee.NewCollection("Census"))
+				So(err, ShouldBeNil)
+
+				fake.ExpireSessions()
+				So(cli.UploadFile(s, desc.ID, "/about/data.csv", strings.NewReader("a,b\n"), "text/csv"), ShouldBeNil)
+
+				reopened, err := provider.Open(ctx)
+				So(err, ShouldBeNil)
+				So(reopened.ID, ShouldNotEqual, opened.ID)
+				So(reopened.Email, ShouldEqual, "editor@ons.gov.uk")
+			})
+
+			Convey("Then an upload which cannot be read again is not sent again", func() {
+				err := cli.UploadFile(s, "census", "/about/data.csv", io.MultiReader(strings.NewReader("a,b\n")), "text/csv")
+				So(errors.Is(err, zebedee.ErrUnauthorized), ShouldBeTrue)
+			})
+
+			Convey("Then a session copied without the provider is still rejected", func() {
+				_, err := cli.GetCollections(zebedee.Session{Email: opened.Email, ID: opened.ID})
+				So(errors.Is(err, zebedee.ErrUnauthorized), ShouldBeTrue)
+			})
+		})
+	})
+
+	Convey("Given a session provider with invalid credentials", t, func() {
+		fake, cli, _, _ := newFake()
+		defer fake.Close()
+
+		provider := zebedee.NewSessionProvider(cli, zebedee.Credentials{Email: "editor@ons.gov.uk", Password: "wrong"})
+
+		Convey("Then requests return an unauthorized error", func() {
+			_, err := cli.GetCollections(provider.Session())
+			So(errors.Is(err, zebedee.ErrUnauthorized), ShouldBeTrue)
+		})
+	})
+
+	Convey("Given a session provider with a token source used concurrently", t, func() {
+		fake, cli, _, _ := newFake()
+		defer fake.Close()
+
+		var tokens int32
+		provider := zebedee.NewTokenSessionProvider(func(ctx context.Context) (string, error) {
+			atomic.AddInt32(&tokens, 1)
+			s, _ := fake.NewSession("editor@ons.gov.uk")
+			return s.ID, nil
+		})
+		s := provider.Session()
+
+		_, err := cli.GetCollections(s)
+		So(err, ShouldBeNil)
+
+		Convey("When the session expires during concurrent requests", func() {
+			fake.ExpireSessions()
+
+			var wg sync.WaitGroup
+			errs := make([]error, 10)
+			for i := range errs {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					_, errs[i] = cli.GetCollections(s)
+				}(i)
+			}
+			wg.Wait()
+
+			Convey("Then every request succeeds with a single new token", func() {
+				for _, err := range errs {
+					So(err, ShouldBeNil)
+				}
+				So(atomic.LoadInt32(&tokens), ShouldEqual, 2)
+			})
+		})
+	})
+}
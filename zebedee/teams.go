@@ -1,14 +1,19 @@
 package zebedee
 
 import (
-	"fmt"
+	"context"
 	"net/http"
 )
 
 // AddTeamMember add a CMS user to the specified team
 func (z *zebedeeClient) AddTeamMember(s Session, teamName, email string) error {
-	uri := fmt.Sprintf("/teams/%s?email=%s", teamName, email)
-	req, err := z.newAuthenticatedRequest(uri, s.ID, http.MethodPost, nil)
+	return z.AddTeamMemberContext(context.Background(), s, teamName, email)
+}
+
+// AddTeamMemberContext add a CMS user to the specified team, bound to the provided context
+func (z *zebedeeClient) AddTeamMemberContext(ctx context.Context, s Session, teamName, email string) error {
+	uri := newEndpoint("teams", teamName).query("email", email).String()
+	req, err := z.newAuthenticatedRequest(ctx, uri, s, http.MethodPost, nil)
 	if err != nil {
 		return err
 	}
@@ -18,8 +23,13 @@ func (z *zebedeeClient) AddTeamMember(s Session, teamName, email string) error {
 
 // RemoveTeamMember remove a user from the specific team
 func (z *zebedeeClient) RemoveTeamMember(s Session, teamName, email string) error {
-	uri := fmt.Sprintf("/teams/%s?email=%s", teamName, email)
-	req, err := z.newAuthenticatedRequest(uri, s.ID, http.MethodDelete, nil)
+	return z.RemoveTeamMemberContext(context.Background(), s, teamName, email)
+}
+
+// RemoveTeamMemberContext remove a user from the specific team, bound to the provided context
+func (z *zebedeeClient) RemoveTeamMemberContext(ctx context.Context, s Session, teamName, email string) error {
+	uri := newEndpoint("teams", teamName).query("email", email).String()
+	req, err := z.newAuthenticatedRequest(ctx, uri, s, http.MethodDelete, nil)
 	if err != nil {
 		return err
 	}
@@ -29,8 +39,13 @@ func (z *zebedeeClient) RemoveTeamMember(s Session, teamName, email string) erro
 
 // CreateTeam create a new team
 func (z *zebedeeClient) CreateTeam(s Session, teamName string) (bool, error) {
-	uri := fmt.Sprintf("/teams/%s", teamName)
-	req, err := z.newAuthenticatedRequest(uri, s.ID, http.MethodPost, nil)
+	return z.CreateTeamContext(context.Background(), s, teamName)
+}
+
+// CreateTeamContext create a new team, bound to the provided context
+func (z *zebedeeClient) CreateTeamContext(ctx context.Context, s Session, teamName string) (bool, error) {
+	uri := newEndpoint("teams", teamName).String()
+	req, err := z.newAuthenticatedRequest(ctx, uri, s, http.MethodPost, nil)
 	if err != nil {
 		return false, err
 	}
@@ -45,8 +60,13 @@ func (z *zebedeeClient) CreateTeam(s Session, teamName string) (bool, error) {
 
 // DeleteTeam delete a team
 func (z *zebedeeClient) DeleteTeam(s Session, teamName string) error {
-	uri := fmt.Sprintf("/teams/%s", teamName)
-	req, err := z.newAuthenticatedRequest(uri, s.ID, http.MethodDelete, nil)
+	return z.DeleteTeamContext(context.Background(), s, teamName)
+}
+
+// DeleteTeamContext delete a team, bound to the provided context
+func (z *zebedeeClient) DeleteTeamContext(ctx context.Context, s Session, teamName string) error {
+	uri := newEndpoint("teams", teamName).String()
+	req, err := z.newAuthenticatedRequest(ctx, uri, s, http.MethodDelete, nil)
 	if err != nil {
 		return err
 	}
@@ -56,8 +76,13 @@ func (z *zebedeeClient) DeleteTeam(s Session, teamName string) error {
 
 // ListTeams return a list of the current teams in the CMS
 func (z *zebedeeClient) ListTeams(s Session) (TeamsList, error) {
+	return z.ListTeamsContext(context.Background(), s)
+}
+
+// ListTeamsContext return a list of the current teams in the CMS, bound to the provided context
+func (z *zebedeeClient) ListTeamsContext(ctx context.Context, s Session) (TeamsList, error) {
 	var teams TeamsList
-	req, err := z.newAuthenticatedRequest("/teams", s.ID, http.MethodGet, nil)
+	req, err := z.newAuthenticatedRequest(ctx, "/teams", s, http.MethodGet, nil)
 	if err != nil {
 		return teams, err
 	}
@@ -71,9 +96,14 @@ func (z *zebedeeClient) ListTeams(s Session) (TeamsList, error) {
 
 // GetTeam return the team with the specified name.
 func (z *zebedeeClient) GetTeam(s Session, teamName string) (Team, error) {
+	return z.GetTeamContext(context.Background(), s, teamName)
+}
+
+// GetTeamContext return the team with the specified name, bound to the provided context.
+func (z *zebedeeClient) GetTeamContext(ctx context.Context, s Session, teamName string) (Team, error) {
 	var team Team
-	uri := fmt.Sprintf("/teams/%s", teamName)
-	req, err := z.newAuthenticatedRequest(uri, s.ID, http.MethodGet, nil)
+	uri := newEndpoint("teams", teamName).String()
+	req, err := z.newAuthenticatedRequest(ctx, uri, s, http.MethodGet, nil)
 	if err != nil {
 		return team, err
 	}
@@ -0,0 +1,49 @@
+package zebedee
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// queryEscaper restores the characters that do not need escaping in a query value, keeping content URIs readable.
+var queryEscaper = strings.NewReplacer("%2F", "/")
+
+// endpoint builds a Zebedee request URI, escaping each path segment and query parameter.
+// Query parameters are kept in the order they are added.
+type endpoint struct {
+	segments []string
+	params   []string
+}
+
+// newEndpoint create an endpoint from unescaped path segments, e.g. newEndpoint("teams", teamName) => /teams/{teamName}
+func newEndpoint(segments ...string) *endpoint {
+	return &endpoint{segments: segments}
+}
+
+// query add a query parameter to the endpoint
+func (e *endpoint) query(key, value string) *endpoint {
+	e.params = append(e.params, url.QueryEscape(key)+"="+queryEscaper.Replace(url.QueryEscape(value)))
+	return e
+}
+
+// queryBool add a boolean query parameter to the endpoint
+func (e *endpoint) queryBool(key string, value bool) *endpoint {
+	return e.query(key, strconv.FormatBool(value))
+}
+
+// String return the escaped URI
+func (e *endpoint) String() string {
+	var b strings.Builder
+	for _, s := range e.segments {
+		b.WriteString("/")
+		b.WriteString(url.PathEscape(s))
+	}
+
+	if len(e.params) > 0 {
+		b.WriteString("?")
+		b.WriteString(strings.Join(e.params, "&"))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,144 @@
+package zebedee
+
+import (
+	"net/http"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_endpoint(t *testing.T) {
+	Convey("Given an endpoint with path segments and query parameters", t, func() {
+		e := newEndpoint("content", collectionId).
+			query("uri", "/the/uri").
+			queryBool("overwriteExisting", true).
+			queryBool("recursive", false)
+
+		Convey("Then the URI is built with the parameters in the order they were added", func() {
+			So(e.String(), ShouldEqual, "/content/collectionID?uri=/the/uri&overwriteExisting=true&recursive=false")
+		})
+	})
+
+	Convey("Given an endpoint with no query parameters", t, func() {
+		So(newEndpoint("teams").String(), ShouldEqual, "/teams")
+		So(newEndpoint("collection", "abc-123").String(), ShouldEqual, "/collection/abc-123")
+	})
+
+	Convey("Given hostile path segments and query values", t, func() {
+		e := newEndpoint("teams", "team a/b?c#d").
+			query("email", "first+last@ons.gov.uk").
+			query("uri", "/a&b=c#d e%f")
+
+		Convey("Then every reserved character is escaped", func() {
+			So(e.String(), ShouldEqual, "/teams/team%20a%2Fb%3Fc%23d?email=first%2Blast%40ons.gov.uk&uri=/a%26b%3Dc%23d+e%25f")
+		})
+	})
+}
+
+func Test_EscapedRequests(t *testing.T) {
+	session := newSession()
+	email := "first.last+cms@ons.gov.uk"
+	teamName := "Economy & Labour #1/2"
+	contentURI := "/economy/a&b/section?x=1#frag/data.json"
+	id := "collection/../id?x"
+
+	Convey("Given a mocked Zebedee client", t, func() {
+		httpClient := mockHttpResponse(http.StatusOK, `true`)
+		zebedeeClient := NewClient(host, httpClient)
+
+		Convey("When GetUser is called with an email containing a plus", func() {
+			_, _ = zebedeeClient.GetUser(session, email)
+
+			Convey("Then Zebedee receives the original email", func() {
+				req := httpClient.DoCalls()[0].Req
+				So(req.URL.Path, ShouldEqual, "/users")
+				So(req.URL.Query().Get("email"), ShouldEqual, email)
+			})
+		})
+
+		Convey("When GetPermissions is called with an email containing a plus", func() {
+			_, _ = zebedeeClient.GetPermissions(session, email)
+
+			Convey("Then Zebedee receives the original email", func() {
+				req := httpClient.DoCalls()[0].Req
+				So(req.URL.Path, ShouldEqual, "/permission")
+				So(req.URL.Query().Get("email"), ShouldEqual, email)
+			})
+		})
+
+		Convey("When DeleteUser is called with an email containing a plus", func() {
+			_ = zebedeeClient.DeleteUser(session, email)
+
+			Convey("Then Zebedee receives the original email", func() {
+				req := httpClient.DoCalls()[0].Req
+				So(req.URL.Query().Get("email"), ShouldEqual, email)
+			})
+		})
+
+		Convey("When AddTeamMember is called with a team name containing reserved characters", func() {
+			_ = zebedeeClient.AddTeamMember(session, teamName, email)
+
+			Convey("Then the team name is a single escaped path segment", func() {
+				req := httpClient.DoCalls()[0].Req
+				So(req.URL.EscapedPath(), ShouldEqual, "/teams/Economy%20&%20Labour%20%231%2F2")
+				So(req.URL.Fragment, ShouldBeEmpty)
+				So(req.URL.Query().Get("email"), ShouldEqual, email)
+			})
+		})
+
+		Convey("When GetTeam is called with a team name containing spaces", func() {
+			_, _ = zebedeeClient.GetTeam(session, "Economic Statistics")
+
+			Convey("Then the space is escaped", func() {
+				req := httpClient.DoCalls()[0].Req
+				So(req.URL.EscapedPath(), ShouldEqual, "/teams/Economic%20Statistics")
+			})
+		})
+
+		Convey("When UpdateCollectionContent is called with a content URI containing reserved characters", func() {
+			_ = zebedeeClient.UpdateCollectionContent(session, id, contentURI, getContent())
+
+			Convey("Then Zebedee receives the original URI and flags", func() {
+				req := httpClient.DoCalls()[0].Req
+				So(req.URL.EscapedPath(), ShouldEqual, "/content/collection%2F..%2Fid%3Fx")
+				So(req.URL.Fragment, ShouldBeEmpty)
+
+				q := req.URL.Query()
+				So(q.Get("uri"), ShouldEqual, contentURI)
+				So(q.Get("overwriteExisting"), ShouldEqual, "true")
+				So(q.Get("recursive"), ShouldEqual, "false")
+				So(q.Get("validateJson"), ShouldEqual, "true")
+			})
+		})
+
+		Convey("When CompleteCollectionContent is called with a content URI containing reserved characters", func() {
+			_ = zebedeeClient.CompleteCollectionContent(session, collectionId, contentURI)
+
+			Convey("Then Zebedee receives the original URI", func() {
+				req := httpClient.DoCalls()[0].Req
+				So(req.URL.Query().Get("uri"), ShouldEqual, contentURI)
+				So(req.URL.Query().Get("recursive"), ShouldEqual, "false")
+			})
+		})
+
+		Convey("When GetContent is called with a content URI containing reserved characters", func() {
+			_, _ = zebedeeClient.GetContent(session, collectionId, contentURI)
+
+			Convey("Then Zebedee receives the original URI", func() {
+				req := httpClient.DoCalls()[0].Req
+				So(req.URL.Query().Get("uri"), ShouldEqual, contentURI)
+			})
+		})
+
+		Convey("When ApproveCollection is called with an ID containing path characters", func() {
+			_ = zebedeeClient.ApproveCollection(session, id)
+
+			Convey("Then the ID cannot change the endpoint path", func() {
+				req := httpClient.DoCalls()[0].Req
+				So(req.URL.Path, ShouldEqual, "/approve/"+id)
+				So(req.URL.EscapedPath(), ShouldEqual, "/approve/collection%2F..%2Fid%3Fx")
+				So(req.URL.RawQuery, ShouldBeEmpty)
+			})
+		})
+	})
+}
@@ -1,14 +1,19 @@
 package zebedee
 
 import (
-	"fmt"
+	"context"
 	"net/http"
 )
 
 // CreateUser a new CMS user
 func (z *zebedeeClient) CreateUser(s Session, u User) (User, error) {
+	return z.CreateUserContext(context.Background(), s, u)
+}
+
+// CreateUserContext a new CMS user, bound to the provided context
+func (z *zebedeeClient) CreateUserContext(ctx context.Context, s Session, u User) (User, error) {
 	var user User
-	req, err := z.newAuthenticatedRequest("/users", s.ID, http.MethodPost, u)
+	req, err := z.newAuthenticatedRequest(ctx, "/users", s, http.MethodPost, u)
 	if err != nil {
 		return user, err
 	}
@@ -22,10 +27,15 @@ func (z *zebedeeClient) CreateUser(s Session, u User) (User, error) {
 
 // GetUser a CMS user by email
 func (z *zebedeeClient) GetUser(s Session, email string) (User, error) {
+	return z.GetUserContext(context.Background(), s, email)
+}
+
+// GetUserContext a CMS user by email, bound to the provided context
+func (z *zebedeeClient) GetUserContext(ctx context.Context, s Session, email string) (User, error) {
 	var user User
 
-	uri := fmt.Sprintf("/users?email=%s", email)
-	req, err := z.newAuthenticatedRequest(uri, s.ID, http.MethodGet, nil)
+	uri := newEndpoint("users").query("email", email).String()
+	req, err := z.newAuthenticatedRequest(ctx, uri, s, http.MethodGet, nil)
 	if err != nil {
 		return user, err
 	}
@@ -40,7 +50,12 @@ func (z *zebedeeClient) GetUser(s Session, email string) (User, error) {
 
 // GetUsers a list of the CMS users
 func (z *zebedeeClient) GetUsers(s Session) ([]User, error) {
-	req, err := z.newAuthenticatedRequest("/users", s.ID, http.MethodGet, nil)
+	return z.GetUsersContext(context.Background(), s)
+}
+
+// GetUsersContext a list of the CMS users, bound to the provided context
+func (z *zebedeeClient) GetUsersContext(ctx context.Context, s Session) ([]User, error) {
+	req, err := z.newAuthenticatedRequest(ctx, "/users", s, http.MethodGet, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +71,13 @@ func (z *zebedeeClient) GetUsers(s Session) ([]User, error) {
 
 // DeleteUser delete a CMS user.
 func (z *zebedeeClient) DeleteUser(s Session, email string) error {
-	req, err := z.newAuthenticatedRequest("/users?email="+email, s.ID, http.MethodDelete, nil)
+	return z.DeleteUserContext(context.Background(), s, email)
+}
+
+// DeleteUserContext delete a CMS user, bound to the provided context.
+func (z *zebedeeClient) DeleteUserContext(ctx context.Context, s Session, email string) error {
+	uri := newEndpoint("users").query("email", email).String()
+	req, err := z.newAuthenticatedRequest(ctx, uri, s, http.MethodDelete, nil)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,163 @@
+package zebedee
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// PageTypes are the page types accepted by Zebedee.
+var PageTypes = []string{
+	"home_page", "taxonomy_landing_page", "product_page",
+	"bulletin", "article", "article_download",
+	"compendium_landing_page", "compendium_chapter", "compendium_data",
+	"dataset_landing_page", "dataset", "timeseries_dataset", "timeseries",
+	"static_page", "static_landing_page", "static_article", "static_methodology", "static_methodology_download",
+	"static_qmi", "static_foi", "static_adhoc",
+	"release", "chart", "table", "equation", "image", "visualisation",
+}
+
+// pageTypesWithoutDescription are the content types which are not pages and do not have a description
+var pageTypesWithoutDescription = map[string]bool{
+	"chart":    true,
+	"table":    true,
+	"equation": true,
+	"image":    true,
+}
+
+// FieldError is a problem with a single field of page JSON.
+type FieldError struct {
+	// Field is the path to the field, e.g. "description.title" or "breadcrumb[1].uri".
+	Field   string
+	Message string
+}
+
+func (fe FieldError) String() string {
+	if fe.Field == "" {
+		return fe.Message
+	}
+	return fe.Field + " " + fe.Message
+}
+
+// PageValidationError is returned by UpdateCollectionContent when page validation is enabled and the content is not
+// valid page JSON.
+type PageValidationError struct {
+	URI      string
+	Problems []FieldError
+}
+
+func (err *PageValidationError) Error() string {
+	problems := make([]string, 0, len(err.Problems))
+	for _, p := range err.Problems {
+		problems = append(problems, p.String())
+	}
+	return fmt.Sprintf("invalid page json for %s: %s", err.URI, strings.Join(problems, "; "))
+}
+
+// Is reports whether the target is ErrValidation.
+func (err *PageValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// ValidatePage check the content is valid page JSON for the content URI, returning the problems found. The content is
+// encoded as JSON in the same way as UpdateCollectionContent. The checks are:
+//   - type is one of PageTypes
+//   - uri is the page URI for the content URI, e.g. "/about" for "/about/data.json"
+//   - description.title is set, other than for charts, tables, equations and images
+//   - each breadcrumb has the uri of a parent of the page
+func ValidatePage(contentURI string, content interface{}) []FieldError {
+	var problems []FieldError
+	addProblem := func(field, format string, args ...interface{}) {
+		problems = append(problems, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	b, err := json.Marshal(content)
+	if err != nil {
+		addProblem("", "cannot be encoded as json: %v", err)
+		return problems
+	}
+
+	var page map[string]interface{}
+	if err := json.Unmarshal(b, &page); err != nil || page == nil {
+		addProblem("", "must be a json object")
+		return problems
+	}
+
+	pageType, ok := page["type"].(string)
+	switch {
+	case !ok || pageType == "":
+		addProblem("type", "is required")
+	case !isPageType(pageType):
+		addProblem("type", "%q is not a valid page type", pageType)
+	}
+
+	pageURI, ok := page["uri"].(string)
+	expectedURI := pageURIFor(contentURI)
+	switch {
+	case !ok || pageURI == "":
+		addProblem("uri", "is required")
+	case path.Clean("/"+pageURI) != expectedURI:
+		addProblem("uri", "%q does not match the content uri, expected %q", pageURI, expectedURI)
+	}
+
+	if !pageTypesWithoutDescription[pageType] {
+		description, ok := page["description"].(map[string]interface{})
+		if !ok {
+			addProblem("description", "is required")
+		} else if title, _ := description["title"].(string); strings.TrimSpace(title) == "" {
+			addProblem("description.title", "is required")
+		}
+	}
+
+	if raw, exists := page["breadcrumb"]; exists && raw != nil {
+		breadcrumb, ok := raw.([]interface{})
+		if !ok {
+			addProblem("breadcrumb", "must be a list")
+			return problems
+		}
+
+		for i, crumb := range breadcrumb {
+			field := fmt.Sprintf("breadcrumb[%d].uri", i)
+
+			link, _ := crumb.(map[string]interface{})
+			crumbURI, _ := link["uri"].(string)
+			switch {
+			case crumbURI == "":
+				addProblem(field, "is required")
+			case !isParentURI(crumbURI, expectedURI):
+				addProblem(field, "%q is not a parent of %q", crumbURI, expectedURI)
+			}
+		}
+	}
+
+	return problems
+}
+
+// pageURIFor return the page URI for the content URI, removing the data.json file or .json extension
+func pageURIFor(contentURI string) string {
+	uri := path.Clean("/" + contentURI)
+	if path.Base(uri) == "data.json" {
+		return path.Dir(uri)
+	}
+	return strings.TrimSuffix(uri, ".json")
+}
+
+// dataURIFor return the URI of the data.json file holding the page with the page URI
+func dataURIFor(pageURI string) string {
+	return path.Join("/", pageURI, "data.json")
+}
+
+func isParentURI(parent, uri string) bool {
+	parent = strings.TrimSuffix(parent, "/")
+	return parent == "" || strings.HasPrefix(uri, parent+"/")
+}
+
+func isPageType(t string) bool {
+	for _, pt := range PageTypes {
+		if t == pt {
+			return true
+		}
+	}
+	return false
+}
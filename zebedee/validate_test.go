@@ -0,0 +1,91 @@
+package zebedee
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_ValidatePage(t *testing.T) {
+	Convey("Given valid page content", t, func() {
+		content := map[string]interface{}{
+			"type":        "static_page",
+			"uri":         "/about/contactus",
+			"description": map[string]interface{}{"title": "Contact us"},
+			"breadcrumb":  []interface{}{map[string]interface{}{"uri": "/"}, map[string]interface{}{"uri": "/about"}},
+		}
+
+		Convey("Then no problems are returned", func() {
+			So(ValidatePage("/about/contactus/data.json", content), ShouldBeEmpty)
+		})
+	})
+
+	Convey("Given the home page", t, func() {
+		content := `{"type":"home_page","uri":"/","description":{"title":"Home"}}`
+
+		Convey("Then no problems are returned", func() {
+			So(ValidatePage("/data.json", rawJSON(content)), ShouldBeEmpty)
+		})
+	})
+
+	Convey("Given a chart without a description", t, func() {
+		content := `{"type":"chart","uri":"/economy/bulletins/gdp/abc123"}`
+
+		Convey("Then no problems are returned", func() {
+			So(ValidatePage("/economy/bulletins/gdp/abc123.json", rawJSON(content)), ShouldBeEmpty)
+		})
+	})
+
+	Convey("Given page content with problems in each field", t, func() {
+		content := `{"type":"static","uri":"/about/us","description":{"title":" "},"breadcrumb":[{"uri":"/"},{"uri":"/economy"},{}]}`
+
+		Convey("Then a problem is returned for each field", func() {
+			So(ValidatePage("/about/contactus/data.json", rawJSON(content)), ShouldResemble, []FieldError{
+				{Field: "type", Message: `"static" is not a valid page type`},
+				{Field: "uri", Message: `"/about/us" does not match the content uri, expected "/about/contactus"`},
+				{Field: "description.title", Message: "is required"},
+				{Field: "breadcrumb[1].uri", Message: `"/economy" is not a parent of "/about/contactus"`},
+				{Field: "breadcrumb[2].uri", Message: "is required"},
+			})
+		})
+	})
+
+	Convey("Given content which is not a JSON object", t, func() {
+		Convey("Then a single problem is returned", func() {
+			So(ValidatePage("/a/data.json", []string{"a"}), ShouldResemble, []FieldError{{Message: "must be a json object"}})
+		})
+	})
+}
+
+func Test_UpdateCollectionContent_PageValidation(t *testing.T) {
+	Convey("Given a client with page validation enabled", t, func() {
+		httpClient := mockHttpResponse(http.StatusOK, "true")
+		cli := NewClientWithOptions(host, WithHttpClient(httpClient), WithPageValidation())
+
+		Convey("When invalid content is updated", func() {
+			err := cli.UpdateCollectionContent(newSession(), collectionId, "/a/data.json", rawJSON(`{"type":"static_page","uri":"/a"}`))
+
+			Convey("Then a validation error is returned without a request to Zebedee", func() {
+				So(errors.Is(err, ErrValidation), ShouldBeTrue)
+				So(err.Error(), ShouldEqual, "invalid page json for /a/data.json: description is required")
+				So(httpClient.DoCalls(), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When valid content is updated", func() {
+			err := cli.UpdateCollectionContent(newSession(), collectionId, "/a/data.json", rawJSON(`{"type":"static_page","uri":"/a","description":{"title":"A"}}`))
+
+			Convey("Then the request is sent", func() {
+				So(err, ShouldBeNil)
+				So(httpClient.DoCalls(), ShouldHaveLength, 1)
+			})
+		})
+	})
+}
+
+func rawJSON(s string) interface{} {
+	return json.RawMessage(s)
+}
@@ -0,0 +1,181 @@
+package zebedee
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultPollInterval is the interval between polls used by WaitForPublish if none is configured.
+const DefaultPollInterval = 5 * time.Second
+
+// WaitOptions configure WaitForPublish.
+type WaitOptions struct {
+	// PollInterval between requests for the collection. Defaults to DefaultPollInterval.
+	PollInterval time.Duration
+	// Timeout for the whole wait. Zero waits until the context is done.
+	Timeout time.Duration
+	// OnPoll is called with the progress after each poll if set.
+	OnPoll func(WaitProgress)
+}
+
+// WaitProgress is passed to the WaitOptions.OnPoll callback after each poll.
+type WaitProgress struct {
+	Poll       int
+	Elapsed    time.Duration
+	Collection CollectionDescription
+}
+
+// PublishSummary is a summary of the latest publish result of a collection.
+type PublishSummary struct {
+	CollectionID  string
+	Complete      bool
+	Failed        bool
+	Message       string
+	TransactionID string
+	Start         time.Time
+	End           time.Time
+	URIs          []URIResult
+	// FailedURIs are the URIs which reported an error.
+	FailedURIs []string
+	// Errors reported by the publishing transaction.
+	Errors []string
+	// Retries is the total number of verification retries across all URIs.
+	Retries int
+}
+
+// Duration returns the duration of the publishing transaction, or zero if it has not finished.
+func (s PublishSummary) Duration() time.Duration {
+	if s.Start.IsZero() || s.End.IsZero() {
+		return 0
+	}
+	return s.End.Sub(s.Start)
+}
+
+// URIResult is the publish result of a single URI.
+type URIResult struct {
+	URI                string
+	Action             string
+	Duration           time.Duration
+	VerificationStatus string
+	Retries            int
+	Error              string
+}
+
+// PublishFailedError is returned by WaitForPublish when the publish result of the collection reports an error.
+type PublishFailedError struct {
+	ID      string
+	Message string
+	Errors  []string
+}
+
+func (err *PublishFailedError) Error() string {
+	if len(err.Errors) == 0 {
+		return fmt.Sprintf("publish failed for collection %s: %s", err.ID, err.Message)
+	}
+	return fmt.Sprintf("publish failed for collection %s: %s: %s", err.ID, err.Message, strings.Join(err.Errors, ", "))
+}
+
+// Is reports whether the target is ErrPublishFailed.
+func (err *PublishFailedError) Is(target error) bool {
+	return target == ErrPublishFailed
+}
+
+// WaitForPublish polls the collection until it has been published, returning a summary of the publish result. A
+// *PublishFailedError is returned with the summary if the publish result reports an error. If the timeout is reached or
+// the context is done the summary of the latest poll is returned with the context error.
+func WaitForPublish(ctx context.Context, cli CollectionsAPI, s Session, id string, opts WaitOptions) (PublishSummary, error) {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultPollInterval
+	}
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	summary := PublishSummary{CollectionID: id}
+
+	for poll := 1; ; poll++ {
+		desc, err := cli.GetCollectionByIDContext(ctx, s, id)
+		if err != nil {
+			return summary, err
+		}
+
+		summary = NewPublishSummary(desc)
+		if opts.OnPoll != nil {
+			opts.OnPoll(WaitProgress{Poll: poll, Elapsed: time.Since(start), Collection: desc})
+		}
+
+		if summary.Failed {
+			return summary, &PublishFailedError{ID: id, Message: summary.Message, Errors: summary.Errors}
+		}
+
+		if summary.Complete {
+			return summary, nil
+		}
+
+		if err := sleep(ctx, opts.PollInterval); err != nil {
+			return summary, fmt.Errorf("waiting for collection %s to publish: %w", id, err)
+		}
+	}
+}
+
+// NewPublishSummary create a summary of the latest publish result of the collection.
+func NewPublishSummary(desc CollectionDescription) PublishSummary {
+	summary := PublishSummary{
+		CollectionID: desc.ID,
+		Complete:     desc.PublishComplete,
+		URIs:         make([]URIResult, 0),
+		FailedURIs:   make([]string, 0),
+		Errors:       make([]string, 0),
+	}
+
+	if len(desc.PublishResults) == 0 {
+		return summary
+	}
+
+	result := desc.PublishResults[len(desc.PublishResults)-1]
+	tx := result.Transactions
+
+	summary.Failed = result.Error || len(tx.Errors) > 0
+	summary.Message = result.Message
+	summary.TransactionID = tx.ID
+	summary.Start = parseCollectionDate(tx.StartDate)
+	summary.End = parseCollectionDate(tx.EndDate)
+	summary.Errors = append(summary.Errors, tx.Errors...)
+
+	for _, info := range tx.UriInfos {
+		summary.URIs = append(summary.URIs, URIResult{
+			URI:                info.URI,
+			Action:             info.Action,
+			Duration:           time.Duration(info.Duration) * time.Millisecond,
+			VerificationStatus: info.VerificationStatus,
+			Retries:            info.VerificationRetryCount,
+			Error:              info.Error,
+		})
+		summary.Retries += info.VerificationRetryCount
+
+		if info.Error != "" {
+			summary.FailedURIs = append(summary.FailedURIs, info.URI)
+		}
+	}
+
+	if len(summary.FailedURIs) > 0 {
+		summary.Failed = true
+	}
+
+	return summary
+}
+
+// parseCollectionDate parse a date in the CollectionDateFMT format, returning the zero time if it is invalid
+func parseCollectionDate(s string) time.Time {
+	t, err := time.Parse(CollectionDateFMT, s)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
@@ -0,0 +1,99 @@
+package zebedee
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const (
+	approvedCollection  = `{"id":"collectionID","approvalStatus":"COMPLETE","publishComplete":false}`
+	publishedCollection = `{"id":"collectionID","approvalStatus":"COMPLETE","publishComplete":true,"publishResults":[{
+		"message":"Published","error":false,"transaction":{"id":"tx1","startDate":"2021-01-01T09:30:00.000Z","endDate":"2021-01-01T09:30:02.500Z","errors":[],
+		"uriInfos":[
+			{"action":"created","uri":"/a","duration":1200,"verificationStatus":"VERIFIED","verificationRetryCount":0},
+			{"action":"updated","uri":"/b","duration":800,"verificationStatus":"VERIFIED","verificationRetryCount":2}
+		]}}]}`
+	failedCollection = `{"id":"collectionID","approvalStatus":"COMPLETE","publishComplete":false,"publishResults":[{
+		"message":"Publish failed","error":true,"transaction":{"id":"tx1","errors":["train unavailable"],
+		"uriInfos":[{"action":"created","uri":"/a","verificationStatus":"VERIFY_FAILED","error":"timeout"}]}}]}`
+)
+
+func Test_WaitForPublish(t *testing.T) {
+	ctx := context.Background()
+	s := newSession()
+
+	Convey("Given a collection which is published on the third poll", t, func() {
+		httpClient := mockHttpResponseSequence(
+			mockResponse{status: http.StatusOK, body: approvedCollection},
+			mockResponse{status: http.StatusOK, body: approvedCollection},
+			mockResponse{status: http.StatusOK, body: publishedCollection},
+		)
+		cli := NewClient(host, httpClient)
+
+		Convey("When WaitForPublish is called", func() {
+			var polls []int
+			summary, err := WaitForPublish(ctx, cli, s, collectionId, WaitOptions{
+				PollInterval: time.Millisecond,
+				OnPoll: func(p WaitProgress) {
+					polls = append(polls, p.Poll)
+				},
+			})
+
+			Convey("Then the collection is polled until it is published", func() {
+				So(err, ShouldBeNil)
+				So(httpClient.DoCalls(), ShouldHaveLength, 3)
+				So(polls, ShouldResemble, []int{1, 2, 3})
+			})
+
+			Convey("Then a summary of the publish result is returned", func() {
+				So(summary.Complete, ShouldBeTrue)
+				So(summary.Failed, ShouldBeFalse)
+				So(summary.TransactionID, ShouldEqual, "tx1")
+				So(summary.Duration(), ShouldEqual, 2500*time.Millisecond)
+				So(summary.Retries, ShouldEqual, 2)
+				So(summary.FailedURIs, ShouldBeEmpty)
+				So(summary.URIs, ShouldResemble, []URIResult{
+					{URI: "/a", Action: "created", Duration: 1200 * time.Millisecond, VerificationStatus: "VERIFIED"},
+					{URI: "/b", Action: "updated", Duration: 800 * time.Millisecond, VerificationStatus: "VERIFIED", Retries: 2},
+				})
+			})
+		})
+	})
+
+	Convey("Given a collection whose publish result reports an error", t, func() {
+		cli := NewClient(host, mockHttpResponse(http.StatusOK, failedCollection))
+
+		Convey("When WaitForPublish is called", func() {
+			summary, err := WaitForPublish(ctx, cli, s, collectionId, WaitOptions{PollInterval: time.Millisecond})
+
+			Convey("Then a publish failed error is returned with the summary", func() {
+				So(errors.Is(err, ErrPublishFailed), ShouldBeTrue)
+				So(err.Error(), ShouldEqual, "publish failed for collection collectionID: Publish failed: train unavailable")
+				So(summary.Failed, ShouldBeTrue)
+				So(summary.FailedURIs, ShouldResemble, []string{"/a"})
+			})
+		})
+	})
+
+	Convey("Given a collection which is never published", t, func() {
+		cli := NewClient(host, mockHttpResponse(http.StatusOK, approvedCollection))
+
+		Convey("When WaitForPublish is called with a timeout", func() {
+			summary, err := WaitForPublish(ctx, cli, s, collectionId, WaitOptions{
+				PollInterval: time.Millisecond,
+				Timeout:      20 * time.Millisecond,
+			})
+
+			Convey("Then the deadline exceeded error is returned with the latest summary", func() {
+				So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+				So(summary.CollectionID, ShouldEqual, collectionId)
+				So(summary.Complete, ShouldBeFalse)
+			})
+		})
+	})
+}
@@ -0,0 +1,377 @@
+package zebedee
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Approval statuses reported by Zebedee in CollectionDescription.ApprovalStatus and CollectionDetails.ApprovalStatus.
+const (
+	ApprovalNotStarted = "NOT_STARTED"
+	ApprovalInProgress = "IN_PROGRESS"
+	ApprovalComplete   = "COMPLETE"
+	ApprovalError      = "ERROR"
+)
+
+// ContentState is the state of a content item within a collection.
+type ContentState string
+
+// Content states, in the order content moves through them.
+const (
+	ContentInProgress ContentState = "in progress"
+	ContentComplete   ContentState = "complete"
+	ContentReviewed   ContentState = "reviewed"
+)
+
+// Transition is a change to the state of a collection or one of its content items.
+type Transition string
+
+// Content transitions apply to a single content item, collection transitions apply to the whole collection.
+const (
+	TransitionEdit     Transition = "edit"
+	TransitionDelete   Transition = "delete"
+	TransitionComplete Transition = "complete"
+	TransitionReview   Transition = "review"
+	TransitionApprove  Transition = "approve"
+	TransitionUnlock   Transition = "unlock"
+	TransitionPublish  Transition = "publish"
+)
+
+var (
+	contentTransitions    = []Transition{TransitionEdit, TransitionDelete, TransitionComplete, TransitionReview}
+	collectionTransitions = []Transition{TransitionApprove, TransitionUnlock, TransitionPublish}
+)
+
+// IsContentTransition returns true if the transition applies to a single content item.
+func (t Transition) IsContentTransition() bool {
+	for _, c := range contentTransitions {
+		if t == c {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitionError is returned when a transition is not allowed from the current state of the collection.
+type TransitionError struct {
+	// Transition that was rejected.
+	Transition Transition
+	// URI of the content item for content transitions.
+	URI string
+	// Reason the transition is not allowed, e.g. "2 items still in progress".
+	Reason string
+}
+
+func (err *TransitionError) Error() string {
+	if err.URI != "" {
+		return fmt.Sprintf("cannot %s %s: %s", err.Transition, err.URI, err.Reason)
+	}
+	return fmt.Sprintf("cannot %s: %s", err.Transition, err.Reason)
+}
+
+// Is reports whether the target is ErrInvalidTransition.
+func (err *TransitionError) Is(target error) bool {
+	return target == ErrInvalidTransition
+}
+
+// CollectionState is a client side model of the workflow state of a collection and its content.
+type CollectionState struct {
+	ID             string
+	Type           PublishType
+	ApprovalStatus string
+	Published      bool
+	Content        map[string]ContentState
+}
+
+// NewCollectionState create the state of a collection from its details.
+func NewCollectionState(details CollectionDetails) CollectionState {
+	state := CollectionState{
+		ID:             details.ID,
+		Type:           details.Type,
+		ApprovalStatus: details.ApprovalStatus,
+		Content:        make(map[string]ContentState),
+	}
+
+	for _, c := range details.InProgress {
+		state.Content[c.URI] = ContentInProgress
+	}
+	for _, c := range details.Complete {
+		state.Content[c.URI] = ContentComplete
+	}
+	for _, c := range details.Reviewed {
+		state.Content[c.URI] = ContentReviewed
+	}
+
+	return state
+}
+
+// contentURIs return the URIs of the content in the collection, sorted
+func contentURIs(details CollectionDetails) []string {
+	uris := make([]string, 0)
+	for uri := range NewCollectionState(details).Content {
+		uris = append(uris, uri)
+	}
+	sort.Strings(uris)
+	return uris
+}
+
+// Locked returns true if the content of the collection cannot be changed as it is approved or being approved.
+func (c CollectionState) Locked() bool {
+	return c.ApprovalStatus == ApprovalComplete || c.ApprovalStatus == ApprovalInProgress
+}
+
+// URIs return the URIs of the content in the state provided, sorted.
+func (c CollectionState) URIs(state ContentState) []string {
+	uris := make([]string, 0)
+	for uri, s := range c.Content {
+		if s == state {
+			uris = append(uris, uri)
+		}
+	}
+	sort.Strings(uris)
+	return uris
+}
+
+// Allowed returns the collection transitions allowed from the current state.
+func (c CollectionState) Allowed() []Transition {
+	return c.allowed(collectionTransitions, "")
+}
+
+// AllowedFor returns the transitions allowed for the content item at the URI from the current state.
+func (c CollectionState) AllowedFor(uri string) []Transition {
+	return c.allowed(contentTransitions, uri)
+}
+
+func (c CollectionState) allowed(transitions []Transition, uri string) []Transition {
+	allowed := make([]Transition, 0)
+	for _, t := range transitions {
+		if c.Check(t, uri) == nil {
+			allowed = append(allowed, t)
+		}
+	}
+	return allowed
+}
+
+// Check returns a *TransitionError if the transition is not allowed from the current state. The URI is ignored for
+// collection transitions.
+func (c CollectionState) Check(t Transition, uri string) error {
+	if !t.IsContentTransition() {
+		uri = ""
+	}
+
+	reject := func(format string, args ...interface{}) error {
+		return &TransitionError{Transition: t, URI: uri, Reason: fmt.Sprintf(format, args...)}
+	}
+
+	if c.Published {
+		return reject("collection has been published")
+	}
+
+	state, exists := c.Content[uri]
+
+	switch t {
+	case TransitionEdit, TransitionDelete, TransitionComplete, TransitionReview:
+		if uri == "" {
+			return reject("content uri is required")
+		}
+		if c.Locked() {
+			return reject("collection is approved")
+		}
+		if t == TransitionEdit {
+			return nil
+		}
+		if !exists {
+			return reject("content is not in the collection")
+		}
+		if t == TransitionComplete && state != ContentInProgress {
+			return reject("content is %s", state)
+		}
+		if t == TransitionReview && state != ContentComplete {
+			return reject("content is %s", state)
+		}
+		return nil
+
+	case TransitionApprove:
+		if c.Locked() {
+			return reject("collection is already approved")
+		}
+		var pending []string
+		if n := len(c.URIs(ContentInProgress)); n > 0 {
+			pending = append(pending, fmt.Sprintf("%s still in progress", itemCount(n)))
+		}
+		if n := len(c.URIs(ContentComplete)); n > 0 {
+			pending = append(pending, fmt.Sprintf("%s awaiting review", itemCount(n)))
+		}
+		if len(pending) > 0 {
+			return reject("%s", strings.Join(pending, ", "))
+		}
+		return nil
+
+	case TransitionUnlock:
+		if c.ApprovalStatus != ApprovalComplete && c.ApprovalStatus != ApprovalError {
+			return reject("collection is not approved")
+		}
+		return nil
+
+	case TransitionPublish:
+		if c.ApprovalStatus != ApprovalComplete {
+			return reject("collection is not approved")
+		}
+		return nil
+	}
+
+	return reject("unknown transition")
+}
+
+// Apply checks the transition is allowed and updates the state to reflect it, as Zebedee would.
+func (c *CollectionState) Apply(t Transition, uri string) error {
+	if err := c.Check(t, uri); err != nil {
+		return err
+	}
+
+	if c.Content == nil {
+		c.Content = make(map[string]ContentState)
+	}
+
+	switch t {
+	case TransitionEdit:
+		c.Content[uri] = ContentInProgress
+	case TransitionDelete:
+		delete(c.Content, uri)
+	case TransitionComplete:
+		c.Content[uri] = ContentComplete
+	case TransitionReview:
+		c.Content[uri] = ContentReviewed
+	case TransitionApprove:
+		c.ApprovalStatus = ApprovalComplete
+	case TransitionUnlock:
+		c.ApprovalStatus = ApprovalNotStarted
+	case TransitionPublish:
+		c.Published = true
+	}
+
+	return nil
+}
+
+// Copy returns a deep copy of the state.
+func (c CollectionState) Copy() CollectionState {
+	content := make(map[string]ContentState, len(c.Content))
+	for uri, s := range c.Content {
+		content[uri] = s
+	}
+	c.Content = content
+	return c
+}
+
+func itemCount(n int) string {
+	if n == 1 {
+		return "1 item"
+	}
+	return fmt.Sprintf("%d items", n)
+}
+
+// Workflow drives a collection through the content lifecycle, checking each transition against the client side
+// CollectionState before sending the request, so invalid transitions are rejected without a call to Zebedee.
+type Workflow struct {
+	cli   CollectionsAPI
+	state CollectionState
+}
+
+// NewWorkflow create a workflow for the collection with the ID, reading the current state from Zebedee.
+func NewWorkflow(ctx context.Context, cli CollectionsAPI, s Session, id string) (*Workflow, error) {
+	w := &Workflow{cli: cli, state: CollectionState{ID: id}}
+	if err := w.Refresh(ctx, s); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// State returns a copy of the current state of the collection.
+func (w *Workflow) State() CollectionState {
+	return w.state.Copy()
+}
+
+// Refresh reads the current state of the collection from Zebedee.
+func (w *Workflow) Refresh(ctx context.Context, s Session) error {
+	details, err := w.cli.GetCollectionDetailsContext(ctx, s, w.state.ID)
+	if err != nil {
+		return err
+	}
+
+	desc, err := w.cli.GetCollectionByIDContext(ctx, s, w.state.ID)
+	if err != nil {
+		return err
+	}
+
+	state := NewCollectionState(details)
+	state.ID = w.state.ID
+	state.Type = desc.Type
+	state.Published = desc.PublishComplete
+	w.state = state
+	return nil
+}
+
+// UpdateContent add or update the content at the URI, returning it to in progress.
+func (w *Workflow) UpdateContent(ctx context.Context, s Session, uri string, content interface{}) error {
+	return w.transition(TransitionEdit, uri, func() error {
+		return w.cli.UpdateCollectionContentContext(ctx, s, w.state.ID, uri, content)
+	})
+}
+
+// DeleteContent remove the content at the URI from the collection.
+func (w *Workflow) DeleteContent(ctx context.Context, s Session, uri string) error {
+	return w.transition(TransitionDelete, uri, func() error {
+		return w.cli.DeleteCollectionContentContext(ctx, s, w.state.ID, uri)
+	})
+}
+
+// CompleteContent mark the in progress content at the URI as complete.
+func (w *Workflow) CompleteContent(ctx context.Context, s Session, uri string) error {
+	return w.transition(TransitionComplete, uri, func() error {
+		return w.cli.CompleteCollectionContentContext(ctx, s, w.state.ID, uri)
+	})
+}
+
+// ReviewContent mark the complete content at the URI as reviewed. The session must be for a different user to the one
+// who completed the content.
+func (w *Workflow) ReviewContent(ctx context.Context, s Session, uri string) error {
+	return w.transition(TransitionReview, uri, func() error {
+		return w.cli.ReviewCollectionContentContext(ctx, s, w.state.ID, uri)
+	})
+}
+
+// Approve the collection once all of its content has been reviewed.
+func (w *Workflow) Approve(ctx context.Context, s Session) error {
+	return w.transition(TransitionApprove, "", func() error {
+		return w.cli.ApproveCollectionContext(ctx, s, w.state.ID)
+	})
+}
+
+// Unlock an approved collection so its content can be edited again.
+func (w *Workflow) Unlock(ctx context.Context, s Session) error {
+	return w.transition(TransitionUnlock, "", func() error {
+		return w.cli.UnlockCollectionContext(ctx, s, w.state.ID)
+	})
+}
+
+// Publish an approved collection.
+func (w *Workflow) Publish(ctx context.Context, s Session) error {
+	return w.transition(TransitionPublish, "", func() error {
+		return w.cli.PublishCollectionContext(ctx, s, w.state.ID)
+	})
+}
+
+// transition check the transition is allowed, send the request and update the state if it succeeds
+func (w *Workflow) transition(t Transition, uri string, send func() error) error {
+	if err := w.state.Check(t, uri); err != nil {
+		return err
+	}
+
+	if err := send(); err != nil {
+		return err
+	}
+
+	return w.state.Apply(t, uri)
+}
@@ -0,0 +1,139 @@
+package zebedee
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func newCollectionState() CollectionState {
+	var details CollectionDetails
+	details.ID = collectionId
+	details.ApprovalStatus = ApprovalNotStarted
+	details.InProgress = []ContentDetail{{URI: "/a"}, {URI: "/b"}}
+	details.Complete = []ContentDetail{{URI: "/c"}}
+	details.Reviewed = []ContentDetail{{URI: "/d"}}
+	return NewCollectionState(details)
+}
+
+func Test_NewCollectionState(t *testing.T) {
+	Convey("Given collection details with content in each state", t, func() {
+		state := newCollectionState()
+
+		Convey("Then the content states are read from the details", func() {
+			So(state.ID, ShouldEqual, collectionId)
+			So(state.URIs(ContentInProgress), ShouldResemble, []string{"/a", "/b"})
+			So(state.URIs(ContentComplete), ShouldResemble, []string{"/c"})
+			So(state.URIs(ContentReviewed), ShouldResemble, []string{"/d"})
+			So(state.Locked(), ShouldBeFalse)
+		})
+
+		Convey("Then the allowed transitions are returned for each content item", func() {
+			So(state.AllowedFor("/a"), ShouldResemble, []Transition{TransitionEdit, TransitionDelete, TransitionComplete})
+			So(state.AllowedFor("/c"), ShouldResemble, []Transition{TransitionEdit, TransitionDelete, TransitionReview})
+			So(state.AllowedFor("/d"), ShouldResemble, []Transition{TransitionEdit, TransitionDelete})
+			So(state.AllowedFor("/new"), ShouldResemble, []Transition{TransitionEdit})
+		})
+
+		Convey("Then the collection cannot be approved until all content is reviewed", func() {
+			So(state.Allowed(), ShouldBeEmpty)
+
+			err := state.Check(TransitionApprove, "")
+			So(errors.Is(err, ErrInvalidTransition), ShouldBeTrue)
+			So(err.Error(), ShouldEqual, "cannot approve: 2 items still in progress, 1 item awaiting review")
+		})
+
+		Convey("Then reviewing in progress content is rejected", func() {
+			err := state.Check(TransitionReview, "/a")
+			So(err, ShouldResemble, &TransitionError{Transition: TransitionReview, URI: "/a", Reason: "content is in progress"})
+			So(err.Error(), ShouldEqual, "cannot review /a: content is in progress")
+		})
+	})
+}
+
+func Test_CollectionState_Apply(t *testing.T) {
+	Convey("Given a collection state", t, func() {
+		state := newCollectionState()
+
+		Convey("When all content is completed and reviewed", func() {
+			So(state.Apply(TransitionComplete, "/a"), ShouldBeNil)
+			So(state.Apply(TransitionDelete, "/b"), ShouldBeNil)
+			So(state.Apply(TransitionReview, "/a"), ShouldBeNil)
+			So(state.Apply(TransitionReview, "/c"), ShouldBeNil)
+
+			Convey("Then the collection can be approved", func() {
+				So(state.Allowed(), ShouldResemble, []Transition{TransitionApprove})
+				So(state.Apply(TransitionApprove, ""), ShouldBeNil)
+
+				Convey("And the approved collection can be published or unlocked but not edited", func() {
+					So(state.Allowed(), ShouldResemble, []Transition{TransitionUnlock, TransitionPublish})
+					So(state.AllowedFor("/a"), ShouldBeEmpty)
+					So(state.Check(TransitionEdit, "/a").Error(), ShouldEqual, "cannot edit /a: collection is approved")
+				})
+
+				Convey("And once published no further transitions are allowed", func() {
+					So(state.Apply(TransitionPublish, ""), ShouldBeNil)
+					So(state.Allowed(), ShouldBeEmpty)
+					So(state.Check(TransitionUnlock, "").Error(), ShouldEqual, "cannot unlock: collection has been published")
+				})
+			})
+		})
+
+		Convey("When an invalid transition is applied", func() {
+			before := state.Copy()
+			err := state.Apply(TransitionPublish, "")
+
+			Convey("Then an error is returned and the state is unchanged", func() {
+				So(err.Error(), ShouldEqual, "cannot publish: collection is not approved")
+				So(state, ShouldResemble, before)
+			})
+		})
+	})
+}
+
+func Test_Workflow(t *testing.T) {
+	body := `{"id":"collectionID","approvalStatus":"NOT_STARTED","publishComplete":false,"inProgress":[{"uri":"/a"}]}`
+
+	Convey("Given a workflow for a collection with content in progress", t, func() {
+		httpClient := mockHttpResponse(http.StatusOK, body)
+		cli := NewClient(host, httpClient)
+		ctx := context.Background()
+
+		w, err := NewWorkflow(ctx, cli, newSession(), collectionId)
+		So(err, ShouldBeNil)
+		So(httpClient.DoCalls(), ShouldHaveLength, 2)
+
+		Convey("When the collection is approved", func() {
+			err := w.Approve(ctx, newSession())
+
+			Convey("Then the transition is rejected without a request to Zebedee", func() {
+				So(err.Error(), ShouldEqual, "cannot approve: 1 item still in progress")
+				So(httpClient.DoCalls(), ShouldHaveLength, 2)
+			})
+		})
+
+		Convey("When the content is completed", func() {
+			httpClient.DoFunc = mockHttpResponse(http.StatusOK, "true").DoFunc
+			err := w.CompleteContent(ctx, newSession(), "/a")
+
+			Convey("Then the request is sent and the state is updated", func() {
+				So(err, ShouldBeNil)
+				So(httpClient.DoCalls(), ShouldHaveLength, 3)
+				So(w.State().URIs(ContentComplete), ShouldResemble, []string{"/a"})
+			})
+		})
+
+		Convey("When Zebedee rejects a transition", func() {
+			httpClient.DoFunc = mockHttpResponse(http.StatusConflict, `{"message":"conflict"}`).DoFunc
+			err := w.CompleteContent(ctx, newSession(), "/a")
+
+			Convey("Then the error is returned and the state is unchanged", func() {
+				So(errors.Is(err, ErrConflict), ShouldBeTrue)
+				So(w.State().URIs(ContentInProgress), ShouldResemble, []string{"/a"})
+			})
+		})
+	})
+}
@@ -2,72 +2,138 @@ package zebedee
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
-
-	"github.com/ONSdigital/dp-net/v2/request"
+	"time"
 )
 
 // CollectionsAPI defines the collections endpoints in Zebedee CMS
 type CollectionsAPI interface {
 	GetCollectionByID(s Session, id string) (CollectionDescription, error)
+	GetCollectionByIDContext(ctx context.Context, s Session, id string) (CollectionDescription, error)
 	CreateCollection(s Session, desc CollectionDescription) (CollectionDescription, error)
+	CreateCollectionContext(ctx context.Context, s Session, desc CollectionDescription) (CollectionDescription, error)
 	DeleteCollection(s Session, id string) error
+	DeleteCollectionContext(ctx context.Context, s Session, id string) error
 	GetCollections(s Session) ([]CollectionDescription, error)
+	GetCollectionsContext(ctx context.Context, s Session) ([]CollectionDescription, error)
 	UpdateCollection(s Session, desc CollectionDescription) error
+	UpdateCollectionContext(ctx context.Context, s Session, desc CollectionDescription) error
 	UpdateCollectionContent(s Session, id, contentUri string, content interface{}) error
+	UpdateCollectionContentContext(ctx context.Context, s Session, id, contentUri string, content interface{}) error
+	UpdateCollectionContentWithOptions(s Session, id, contentUri string, content interface{}, opts ContentOptions) error
+	UpdateCollectionContentWithOptionsContext(ctx context.Context, s Session, id, contentUri string, content interface{}, opts ContentOptions) error
 	DeleteCollectionContent(s Session, id, contentUri string) error
+	DeleteCollectionContentContext(ctx context.Context, s Session, id, contentUri string) error
 	CompleteCollectionContent(s Session, id string, contentUri string) error
+	CompleteCollectionContentContext(ctx context.Context, s Session, id string, contentUri string) error
+	CompleteCollectionContentWithOptions(s Session, id string, contentUri string, opts ContentOptions) error
+	CompleteCollectionContentWithOptionsContext(ctx context.Context, s Session, id string, contentUri string, opts ContentOptions) error
 	ReviewCollectionContent(s Session, id string, contentUri string) error
+	ReviewCollectionContentContext(ctx context.Context, s Session, id string, contentUri string) error
+	ReviewCollectionContentWithOptions(s Session, id string, contentUri string, opts ContentOptions) error
+	ReviewCollectionContentWithOptionsContext(ctx context.Context, s Session, id string, contentUri string, opts ContentOptions) error
 	ApproveCollection(s Session, id string) error
+	ApproveCollectionContext(ctx context.Context, s Session, id string) error
 	UnlockCollection(s Session, id string) error
+	UnlockCollectionContext(ctx context.Context, s Session, id string) error
 	PublishCollection(s Session, id string) error
+	PublishCollectionContext(ctx context.Context, s Session, id string) error
 	GetCollectionDetails(s Session, id string) (CollectionDetails, error)
+	GetCollectionDetailsContext(ctx context.Context, s Session, id string) (CollectionDetails, error)
 }
 
 // PermissionsAPI defines the user permissions endpoints in Zebedee CMS
 type PermissionsAPI interface {
 	SetPermissions(s Session, p Permissions) error
+	SetPermissionsContext(ctx context.Context, s Session, p Permissions) error
 	GetPermissions(s Session, email string) (Permissions, error)
+	GetPermissionsContext(ctx context.Context, s Session, email string) (Permissions, error)
 }
 
 // UsersAPI defines the user endpoints in Zebedee CMS
 type UsersAPI interface {
 	CreateUser(s Session, u User) (User, error)
+	CreateUserContext(ctx context.Context, s Session, u User) (User, error)
 	GetUser(s Session, email string) (User, error)
+	GetUserContext(ctx context.Context, s Session, email string) (User, error)
 	GetUsers(s Session) ([]User, error)
+	GetUsersContext(ctx context.Context, s Session) ([]User, error)
 	DeleteUser(s Session, email string) error
+	DeleteUserContext(ctx context.Context, s Session, email string) error
 	SetPassword(s Session, c Credentials) error
+	SetPasswordContext(ctx context.Context, s Session, c Credentials) error
 }
 
 // AuthAPI defines the authentication endpoints in Zebedee CMS
 type AuthAPI interface {
 	OpenSession(c Credentials) (Session, error)
+	OpenSessionContext(ctx context.Context, c Credentials) (Session, error)
 	OpenSessionJWT(authToken string) (Session, error)
+	OpenSessionJWTContext(ctx context.Context, authToken string) (Session, error)
 }
 
 // TeamsAPI defines the teams endpoints in Zebedee CMS
 type TeamsAPI interface {
 	AddTeamMember(s Session, teamName, email string) error
+	AddTeamMemberContext(ctx context.Context, s Session, teamName, email string) error
 	RemoveTeamMember(s Session, teamName, email string) error
+	RemoveTeamMemberContext(ctx context.Context, s Session, teamName, email string) error
 	CreateTeam(s Session, teamName string) (bool, error)
+	CreateTeamContext(ctx context.Context, s Session, teamName string) (bool, error)
 	DeleteTeam(s Session, teamName string) error
+	DeleteTeamContext(ctx context.Context, s Session, teamName string) error
 	ListTeams(s Session) (TeamsList, error)
+	ListTeamsContext(ctx context.Context, s Session) (TeamsList, error)
 	GetTeam(s Session, teamName string) (Team, error)
+	GetTeamContext(ctx context.Context, s Session, teamName string) (Team, error)
 }
 
 // KeyringAPI defines the Keyring endpoints in Zebedee CMS
 type KeyringAPI interface {
 	ListUserKeyring(s Session) ([]string, error)
+	ListUserKeyringContext(ctx context.Context, s Session) ([]string, error)
 }
 
 type ContentAPI interface {
 	GetContent(s Session, collectionName string, uri string) ([]byte, error)
+	GetContentContext(ctx context.Context, s Session, collectionName string, uri string) ([]byte, error)
+	GetContentStream(ctx context.Context, s Session, collectionID, uri string) (io.ReadCloser, ContentInfo, error)
+	GetContentJSON(s Session, collectionID, uri string, v any) error
+	GetContentJSONContext(ctx context.Context, s Session, collectionID, uri string, v any) error
+	UploadFile(s Session, collectionID, uri string, r io.Reader, contentType string) error
+	UploadFileContext(ctx context.Context, s Session, collectionID, uri string, r io.Reader, contentType string) error
+	UploadFileWithOptions(s Session, collectionID, uri string, r io.Reader, contentType string, opts ContentOptions) error
+	UploadFileWithOptionsContext(ctx context.Context, s Session, collectionID, uri string, r io.Reader, contentType string, opts ContentOptions) error
+	DownloadFile(s Session, collectionID, uri string) (io.ReadCloser, error)
+	DownloadFileContext(ctx context.Context, s Session, collectionID, uri string) (io.ReadCloser, error)
+}
+
+// PublishedContentAPI defines the endpoints for reading the published content in Zebedee CMS, outside of any collection
+type PublishedContentAPI interface {
+	GetPublishedContent(s Session, uri string) ([]byte, error)
+	GetPublishedContentContext(ctx context.Context, s Session, uri string) ([]byte, error)
+	GetPublishedContentStream(ctx context.Context, s Session, uri string) (io.ReadCloser, ContentInfo, error)
+	GetPublishedData(s Session, uri string) ([]byte, error)
+	GetPublishedDataContext(ctx context.Context, s Session, uri string) ([]byte, error)
+	ListPublishedChildren(s Session, uri string) ([]ContentNode, error)
+	ListPublishedChildrenContext(ctx context.Context, s Session, uri string) ([]ContentNode, error)
 }
 
 // Client defines a client for the Zebedee CMS API
+//
+//go:generate moq -out mock/clientmock/client.go -pkg clientmock . Client
+//go:generate moq -out mock/clientmock/auth.go -pkg clientmock . AuthAPI
+//go:generate moq -out mock/clientmock/users.go -pkg clientmock . UsersAPI
+//go:generate moq -out mock/clientmock/permissions.go -pkg clientmock . PermissionsAPI
+//go:generate moq -out mock/clientmock/collections.go -pkg clientmock . CollectionsAPI
+//go:generate moq -out mock/clientmock/teams.go -pkg clientmock . TeamsAPI
+//go:generate moq -out mock/clientmock/keyring.go -pkg clientmock . KeyringAPI
+//go:generate moq -out mock/clientmock/content.go -pkg clientmock . ContentAPI
+//go:generate moq -out mock/clientmock/published.go -pkg clientmock . PublishedContentAPI
 type Client interface {
 	AuthAPI
 	UsersAPI
@@ -76,11 +142,19 @@ type Client interface {
 	TeamsAPI
 	KeyringAPI
 	ContentAPI
+	PublishedContentAPI
 }
 
 type zebedeeClient struct {
-	Host       string
-	HttpClient HttpClient
+	Host                 string
+	HttpClient           HttpClient
+	basePath             string
+	headers              http.Header
+	timeout              time.Duration
+	requestInterceptors  []RequestInterceptor
+	responseInterceptors []ResponseInterceptor
+	retryPolicy          *RetryPolicy
+	validatePages        bool
 }
 
 // NewClient create a new Client
@@ -91,7 +165,7 @@ func NewClient(host string, httpCli HttpClient) Client {
 	}
 }
 
-func (z *zebedeeClient) newAuthenticatedRequest(uri, authToken, method string, entity interface{}) (*http.Request, error) {
+func (z *zebedeeClient) newAuthenticatedRequest(ctx context.Context, uri string, s Session, method string, entity interface{}) (*http.Request, error) {
 	var body io.Reader
 	if entity != nil {
 		b, err := json.Marshal(entity)
@@ -102,14 +176,24 @@ func (z *zebedeeClient) newAuthenticatedRequest(uri, authToken, method string, e
 		body = bytes.NewReader(b)
 	}
 
-	url := fmt.Sprintf("%s%s", z.Host, uri)
-	req, err := http.NewRequest(method, url, body)
+	req, err := z.newRequest(ctx, uri, method, body)
 	if err != nil {
 		return nil, err
 	}
 
 	req.Header.Set("content-type", "application/json")
-	req.Header.Set(request.FlorenceHeaderKey, authToken)
+	return authenticate(req, s)
+}
+
+// newRequest create a new request for the Zebedee URI, bound to the provided context.
+func (z *zebedeeClient) newRequest(ctx context.Context, uri, method string, body io.Reader) (*http.Request, error) {
+	url := fmt.Sprintf("%s%s%s", z.Host, z.basePath, uri)
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	z.applyHeaders(req)
 	return req, nil
 }
 
@@ -137,8 +221,31 @@ func (z *zebedeeClient) requestObject(r *http.Request, expectedStatus int, entit
 	return nil
 }
 
+// do send the request to Zebedee, applying the configured interceptors, default timeout and retry policy.
+// The caller is responsible for closing the response body.
 func (z *zebedeeClient) do(req *http.Request) (*http.Response, error) {
-	return z.HttpClient.Do(req.Context(), req)
+	for _, intercept := range z.requestInterceptors {
+		if err := intercept(req); err != nil {
+			return nil, err
+		}
+	}
+
+	req, cancel := z.withTimeout(req)
+	resp, err := z.doAuthenticated(req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+
+	for _, intercept := range z.responseInterceptors {
+		if err := intercept(resp); err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+	}
+
+	return resp, nil
 }
 
 // discardResponse consume the response body and send it to dev/null
@@ -0,0 +1,389 @@
+package zebedeetest
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+)
+
+// Collection and content event types used by Zebedee
+const (
+	eventCreated   = "CREATED"
+	eventEdited    = "EDITED"
+	eventCompleted = "COMPLETED"
+	eventReviewed  = "REVIEWED"
+	eventApproved  = "APPROVED"
+	eventUnlocked  = "UNLOCKED"
+	eventPublished = "PUBLISHED"
+)
+
+const (
+	lockedMessage      = "This collection has been approved and cannot be edited"
+	publishedMessage   = "This collection has been published and cannot be edited"
+	notFoundMessage    = "Collection not found"
+	notApprovedMessage = "Collection approval must be completed before publishing"
+)
+
+type contentState int
+
+const (
+	inProgress contentState = iota
+	complete
+	reviewed
+)
+
+type collection struct {
+	desc   zebedee.CollectionDescription
+	items  map[string]*item
+	events []zebedee.CollectionEvent
+}
+
+type item struct {
+	uri          string
+	content      []byte
+	contentType  string
+	state        contentState
+	lastEditedBy string
+	events       []zebedee.CollectionEvent
+}
+
+// Collection returns the description of the collection with the ID, or false if it does not exist.
+func (s *Server) Collection(id string) (zebedee.CollectionDescription, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.collections[id]
+	if !ok {
+		return zebedee.CollectionDescription{}, false
+	}
+	return c.description(), true
+}
+
+func (s *Server) getCollections(w http.ResponseWriter, _ *http.Request, _ *user) {
+	list := make([]zebedee.CollectionDescription, 0, len(s.collections))
+	for _, c := range s.collections {
+		list = append(list, c.description())
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	writeJSON(w, list)
+}
+
+func (s *Server) createCollection(w http.ResponseWriter, r *http.Request, u *user) {
+	if !canEdit(u) {
+		writeError(w, http.StatusUnauthorized, "You do not have the right permission")
+		return
+	}
+
+	var desc zebedee.CollectionDescription
+	if !readJSON(w, r, &desc) {
+		return
+	}
+
+	if strings.TrimSpace(desc.Name) == "" {
+		writeError(w, http.StatusBadRequest, "Collection name cannot be blank")
+		return
+	}
+
+	for _, c := range s.collections {
+		if strings.EqualFold(c.desc.Name, desc.Name) {
+			writeError(w, http.StatusConflict, "Collection with this name already exists")
+			return
+		}
+	}
+
+	c := &collection{items: make(map[string]*item)}
+	c.desc.ID = collectionID(desc.Name)
+	c.desc.Name = desc.Name
+	c.desc.Type = desc.Type
+	c.desc.PublishDate = desc.PublishDate
+	c.desc.ReleaseURI = desc.ReleaseURI
+	c.desc.Teams = append([]string{}, desc.Teams...)
+	c.desc.ApprovalStatus = zebedee.ApprovalNotStarted
+	c.addEvent(eventCreated, u.Email)
+
+	s.collections[c.desc.ID] = c
+	writeJSON(w, c.description())
+}
+
+func (s *Server) getCollection(w http.ResponseWriter, r *http.Request, _ *user) {
+	c, ok := s.collections[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, notFoundMessage)
+		return
+	}
+
+	writeJSON(w, c.description())
+}
+
+func (s *Server) updateCollection(w http.ResponseWriter, r *http.Request, u *user) {
+	c, ok := s.collections[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, notFoundMessage)
+		return
+	}
+
+	if !canEdit(u) {
+		writeError(w, http.StatusUnauthorized, "You do not have the right permission")
+		return
+	}
+
+	var desc zebedee.CollectionDescription
+	if !readJSON(w, r, &desc) {
+		return
+	}
+
+	if desc.Name != "" {
+		c.desc.Name = desc.Name
+	}
+	c.desc.Type = desc.Type
+	c.desc.PublishDate = desc.PublishDate
+	c.desc.ReleaseURI = desc.ReleaseURI
+	c.desc.Teams = append([]string{}, desc.Teams...)
+
+	writeJSON(w, c.description())
+}
+
+func (s *Server) deleteCollection(w http.ResponseWriter, r *http.Request, u *user) {
+	id := r.PathValue("id")
+	if _, ok := s.collections[id]; !ok {
+		writeError(w, http.StatusNotFound, notFoundMessage)
+		return
+	}
+
+	if !canEdit(u) {
+		writeError(w, http.StatusUnauthorized, "You do not have the right permission")
+		return
+	}
+
+	delete(s.collections, id)
+	writeJSON(w, true)
+}
+
+func (s *Server) getCollectionDetails(w http.ResponseWriter, r *http.Request, _ *user) {
+	c, ok := s.collections[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, notFoundMessage)
+		return
+	}
+
+	writeJSON(w, c.details(s.teams))
+}
+
+func (s *Server) approveCollection(w http.ResponseWriter, r *http.Request, u *user) {
+	c, ok := s.editableCollection(w, r, u)
+	if !ok {
+		return
+	}
+
+	if len(c.urisInState(inProgress)) > 0 || len(c.urisInState(complete)) > 0 {
+		writeError(w, http.StatusConflict, "Collection contains content that has not been reviewed")
+		return
+	}
+
+	c.desc.ApprovalStatus = zebedee.ApprovalComplete
+	c.addEvent(eventApproved, u.Email)
+	writeJSON(w, true)
+}
+
+func (s *Server) unlockCollection(w http.ResponseWriter, r *http.Request, u *user) {
+	c, ok := s.collections[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, notFoundMessage)
+		return
+	}
+
+	if c.desc.PublishComplete {
+		writeError(w, http.StatusConflict, publishedMessage)
+		return
+	}
+
+	c.desc.ApprovalStatus = zebedee.ApprovalNotStarted
+	c.addEvent(eventUnlocked, u.Email)
+	writeJSON(w, true)
+}
+
+func (s *Server) publishCollection(w http.ResponseWriter, r *http.Request, u *user) {
+	c, ok := s.collections[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, notFoundMessage)
+		return
+	}
+
+	if c.desc.PublishComplete {
+		writeError(w, http.StatusConflict, publishedMessage)
+		return
+	}
+
+	if c.desc.ApprovalStatus != zebedee.ApprovalComplete {
+		writeError(w, http.StatusConflict, notApprovedMessage)
+		return
+	}
+
+	s.publishLocked(c)
+	c.addEvent(eventPublished, u.Email)
+	writeJSON(w, true)
+}
+
+// publishLocked copy the collection content to the published content and record the publish result
+func (s *Server) publishLocked(c *collection) {
+	start := time.Now().UTC()
+	tx := zebedee.PublishingTransaction{
+		ID:        newID(),
+		StartDate: start.Format(zebedee.CollectionDateFMT),
+		UriInfos:  make([]zebedee.URIInfo, 0, len(c.items)),
+		Errors:    []string{},
+	}
+
+	for _, uri := range c.urisInState(reviewed) {
+		it := c.items[uri]
+		action := "created"
+		if _, exists := s.published[uri]; exists {
+			action = "updated"
+		}
+		s.published[uri] = it.content
+
+		end := time.Now().UTC()
+		tx.UriInfos = append(tx.UriInfos, zebedee.URIInfo{
+			Action:             action,
+			URI:                uri,
+			Start:              start.Format(zebedee.CollectionDateFMT),
+			End:                end.Format(zebedee.CollectionDateFMT),
+			Duration:           int(end.Sub(start).Milliseconds()),
+			VerificationStatus: "VERIFIED",
+			VerificationEnd:    end.Format(zebedee.CollectionDateFMT),
+			Size:               len(it.content),
+		})
+	}
+
+	tx.EndDate = time.Now().UTC().Format(zebedee.CollectionDateFMT)
+	c.desc.PublishResults = append(c.desc.PublishResults, zebedee.PublishResult{
+		Message:      "Published",
+		Transactions: tx,
+	})
+	c.desc.PublishComplete = true
+}
+
+func (s *Server) listKeyring(w http.ResponseWriter, _ *http.Request, u *user) {
+	keys := make([]string, 0, len(s.collections))
+	if canEdit(u) {
+		for id := range s.collections {
+			keys = append(keys, id)
+		}
+	}
+	sort.Strings(keys)
+
+	writeJSON(w, keys)
+}
+
+// editableCollection return the collection from the request path if it exists and can be edited, otherwise write an
+// error response and return false
+func (s *Server) editableCollection(w http.ResponseWriter, r *http.Request, u *user) (*collection, bool) {
+	c, ok := s.collections[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, notFoundMessage)
+		return nil, false
+	}
+
+	if !canEdit(u) {
+		writeError(w, http.StatusUnauthorized, "You do not have the right permission")
+		return nil, false
+	}
+
+	if c.desc.PublishComplete {
+		writeError(w, http.StatusConflict, publishedMessage)
+		return nil, false
+	}
+
+	if c.desc.ApprovalStatus == zebedee.ApprovalComplete {
+		writeError(w, http.StatusConflict, lockedMessage)
+		return nil, false
+	}
+
+	return c, true
+}
+
+func (c *collection) addEvent(eventType, email string) {
+	c.events = append(c.events, newEvent(eventType, email))
+}
+
+func (c *collection) urisInState(state contentState) []string {
+	uris := make([]string, 0)
+	for uri, it := range c.items {
+		if it.state == state {
+			uris = append(uris, uri)
+		}
+	}
+	sort.Strings(uris)
+	return uris
+}
+
+func (c *collection) description() zebedee.CollectionDescription {
+	desc := c.desc
+	desc.Teams = append([]string{}, c.desc.Teams...)
+	desc.InProgressUris = c.urisInState(inProgress)
+	desc.CompleteUris = c.urisInState(complete)
+	desc.ReviewedUris = c.urisInState(reviewed)
+	desc.PublishResults = append([]zebedee.PublishResult{}, c.desc.PublishResults...)
+	return desc
+}
+
+func (c *collection) details(teams map[string]*zebedee.Team) zebedee.CollectionDetails {
+	var d zebedee.CollectionDetails
+	d.ID = c.desc.ID
+	d.Name = c.desc.Name
+	d.Type = c.desc.Type
+	d.PublishDate = c.desc.PublishDate
+	d.ReleaseURI = c.desc.ReleaseURI
+	d.Teams = append([]string{}, c.desc.Teams...)
+	d.ApprovalStatus = c.desc.ApprovalStatus
+	d.Events = append([]zebedee.CollectionEvent{}, c.events...)
+	d.InProgress = c.contentDetails(inProgress)
+	d.Complete = c.contentDetails(complete)
+	d.Reviewed = c.contentDetails(reviewed)
+
+	for _, name := range c.desc.Teams {
+		if t, ok := teams[name]; ok {
+			d.TeamDetails = append(d.TeamDetails, copyTeam(t))
+		}
+	}
+
+	return d
+}
+
+func (c *collection) contentDetails(state contentState) []zebedee.ContentDetail {
+	details := make([]zebedee.ContentDetail, 0)
+	for _, uri := range c.urisInState(state) {
+		details = append(details, c.items[uri].detail())
+	}
+	return details
+}
+
+func canEdit(u *user) bool {
+	return u.permissions.Admin || u.permissions.Editor
+}
+
+func newEvent(eventType, email string) zebedee.CollectionEvent {
+	return zebedee.CollectionEvent{
+		Date:      time.Now().UTC().Format(zebedee.CollectionDateFMT),
+		EventType: eventType,
+		Email:     email,
+	}
+}
+
+// collectionID generate a collection ID in the same form as Zebedee, the alphanumeric name followed by a random suffix
+func collectionID(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String() + "-" + newID()
+}
@@ -0,0 +1,257 @@
+package zebedeetest
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+)
+
+// page is the subset of the page JSON the fake reads to describe and validate content
+type page struct {
+	Type        string `json:"type"`
+	Description struct {
+		Title    string `json:"title"`
+		Edition  string `json:"edition"`
+		Language string `json:"language"`
+	} `json:"description"`
+}
+
+// getContent returns the content from the collection, falling back to the published content as Zebedee does
+func (s *Server) getContent(w http.ResponseWriter, r *http.Request, _ *user) {
+	c, ok := s.collections[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, notFoundMessage)
+		return
+	}
+
+	uri := r.URL.Query().Get("uri")
+	if it, ok := c.items[uri]; ok {
+		writeContent(w, it.contentType, it.content)
+		return
+	}
+
+	if b, ok := s.published[uri]; ok {
+		writeContent(w, "application/json", b)
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "Content not found")
+}
+
+func (s *Server) updateContent(w http.ResponseWriter, r *http.Request, u *user) {
+	c, ok := s.editableCollection(w, r, u)
+	if !ok {
+		return
+	}
+
+	q := r.URL.Query()
+	uri := q.Get("uri")
+	if uri == "" {
+		writeError(w, http.StatusBadRequest, "uri is required")
+		return
+	}
+
+	if other := s.collectionContaining(uri, c); other != nil {
+		writeError(w, http.StatusConflict, "This URI is already in another collection: "+other.desc.Name)
+		return
+	}
+
+	_, inCollection := c.items[uri]
+	_, isPublished := s.published[uri]
+	if q.Get("overwriteExisting") == "false" && (inCollection || isPublished) {
+		writeError(w, http.StatusConflict, "Content already exists at "+uri)
+		return
+	}
+
+	b, contentType, err := readContent(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return
+	}
+
+	if q.Get("validateJson") != "false" {
+		var p page
+		if err := json.Unmarshal(b, &p); err != nil || p.Type == "" {
+			writeError(w, http.StatusBadRequest, "Invalid page json")
+			return
+		}
+	}
+
+	it, ok := c.items[uri]
+	if !ok {
+		it = &item{uri: uri}
+		c.items[uri] = it
+	}
+
+	// editing content that has been completed or reviewed returns it to in progress
+	it.content = b
+	it.contentType = contentType
+	it.state = inProgress
+	it.lastEditedBy = u.Email
+	it.events = append(it.events, newEvent(eventEdited, u.Email))
+
+	writeJSON(w, true)
+}
+
+func (s *Server) deleteContent(w http.ResponseWriter, r *http.Request, u *user) {
+	c, ok := s.editableCollection(w, r, u)
+	if !ok {
+		return
+	}
+
+	uri := r.URL.Query().Get("uri")
+	if _, ok := c.items[uri]; !ok {
+		writeError(w, http.StatusNotFound, "Content not found")
+		return
+	}
+
+	delete(c.items, uri)
+	writeJSON(w, true)
+}
+
+func (s *Server) completeContent(w http.ResponseWriter, r *http.Request, u *user) {
+	c, ok := s.editableCollection(w, r, u)
+	if !ok {
+		return
+	}
+
+	items, ok := c.itemsForTransition(w, r, inProgress, "in progress")
+	if !ok {
+		return
+	}
+
+	for _, it := range items {
+		it.state = complete
+		it.lastEditedBy = u.Email
+		it.events = append(it.events, newEvent(eventCompleted, u.Email))
+	}
+
+	writeJSON(w, true)
+}
+
+func (s *Server) reviewContent(w http.ResponseWriter, r *http.Request, u *user) {
+	c, ok := s.editableCollection(w, r, u)
+	if !ok {
+		return
+	}
+
+	items, ok := c.itemsForTransition(w, r, complete, "complete")
+	if !ok {
+		return
+	}
+
+	for _, it := range items {
+		if it.lastEditedBy == u.Email {
+			writeError(w, http.StatusForbidden, "Reviewer must be a different user to the one who completed "+it.uri)
+			return
+		}
+	}
+
+	for _, it := range items {
+		it.state = reviewed
+		it.events = append(it.events, newEvent(eventReviewed, u.Email))
+	}
+
+	writeJSON(w, true)
+}
+
+// itemsForTransition return the items identified by the uri and recursive query parameters if they are all in the
+// expected state, otherwise write an error response and return false
+func (c *collection) itemsForTransition(w http.ResponseWriter, r *http.Request, from contentState, name string) ([]*item, bool) {
+	q := r.URL.Query()
+	uri := q.Get("uri")
+
+	it, ok := c.items[uri]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Content not found in collection: "+uri)
+		return nil, false
+	}
+
+	items := []*item{it}
+	if q.Get("recursive") == "true" {
+		dir := path.Dir(uri) + "/"
+		for u, other := range c.items {
+			if u != uri && strings.HasPrefix(u, dir) && other.state == from {
+				items = append(items, other)
+			}
+		}
+	}
+
+	if it.state != from {
+		writeError(w, http.StatusBadRequest, "Content is not "+name+": "+uri)
+		return nil, false
+	}
+
+	return items, true
+}
+
+// collectionContaining return the collection other than the one provided containing the uri, or nil
+func (s *Server) collectionContaining(uri string, exclude *collection) *collection {
+	for _, c := range s.collections {
+		if c == exclude || c.desc.PublishComplete {
+			continue
+		}
+		if _, ok := c.items[uri]; ok {
+			return c
+		}
+	}
+	return nil
+}
+
+func (it *item) detail() zebedee.ContentDetail {
+	d := zebedee.ContentDetail{
+		URI:         it.uri,
+		ContentPath: it.uri,
+		Events:      append([]zebedee.CollectionEvent{}, it.events...),
+	}
+
+	var p page
+	if json.Unmarshal(it.content, &p) == nil {
+		d.Type = p.Type
+		d.Description = zebedee.ContentDetailDescription{
+			Title:    p.Description.Title,
+			Edition:  p.Description.Edition,
+			Language: p.Description.Language,
+		}
+	}
+
+	return d
+}
+
+// readContent return the content from the request body, which is either JSON or a multipart file upload
+func readContent(r *http.Request) ([]byte, string, error) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "multipart/form-data" {
+		b, err := io.ReadAll(r.Body)
+		return b, "application/json", err
+	}
+
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return nil, "", err
+	}
+
+	for {
+		part, err := mr.NextPart()
+		if err != nil {
+			return nil, "", errors.New("multipart request has no file")
+		}
+
+		if part.FormName() == "file" {
+			b, err := io.ReadAll(part)
+			return b, part.Header.Get("Content-Type"), err
+		}
+	}
+}
+
+func writeContent(w http.ResponseWriter, contentType string, b []byte) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(b)
+}
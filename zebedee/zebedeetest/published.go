@@ -0,0 +1,79 @@
+package zebedeetest
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+)
+
+// getPublishedResource returns the published file at the URI
+func (s *Server) getPublishedResource(w http.ResponseWriter, r *http.Request, _ *user) {
+	uri := r.URL.Query().Get("uri")
+	b, ok := s.published[uri]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Content not found")
+		return
+	}
+
+	contentType := mime.TypeByExtension(path.Ext(uri))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	writeContent(w, contentType, b)
+}
+
+// getPublishedData returns the published page JSON for the page URI
+func (s *Server) getPublishedData(w http.ResponseWriter, r *http.Request, _ *user) {
+	b, ok := s.published[dataURI(r.URL.Query().Get("uri"))]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Content not found")
+		return
+	}
+
+	writeContent(w, "application/json", b)
+}
+
+// getPublishedChildren returns the published pages directly below the page URI
+func (s *Server) getPublishedChildren(w http.ResponseWriter, r *http.Request, _ *user) {
+	parent := strings.TrimSuffix(path.Clean("/"+r.URL.Query().Get("uri")), "/") + "/"
+
+	nodes := []zebedee.ContentNode{}
+	for uri, b := range s.published {
+		if path.Base(uri) != "data.json" || !strings.HasPrefix(uri, parent) {
+			continue
+		}
+
+		pageURI := path.Dir(uri)
+		if path.Dir(pageURI) != path.Clean(parent) {
+			continue
+		}
+
+		node := zebedee.ContentNode{URI: pageURI}
+		var p page
+		if json.Unmarshal(b, &p) == nil {
+			node.Type = p.Type
+			node.Description = zebedee.ContentDetailDescription{
+				Title:    p.Description.Title,
+				Edition:  p.Description.Edition,
+				Language: p.Description.Language,
+			}
+		}
+		nodes = append(nodes, node)
+	}
+
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].URI < nodes[j].URI })
+	writeJSON(w, nodes)
+}
+
+// dataURI return the URI of the data.json file for the page URI
+func dataURI(uri string) string {
+	if strings.HasSuffix(uri, ".json") {
+		return uri
+	}
+	return path.Join("/", uri, "data.json")
+}
@@ -0,0 +1,244 @@
+// Package zebedeetest provides an in-memory fake of the Zebedee CMS API for testing code that uses the zebedee package.
+//
+//	fake := zebedeetest.NewServer()
+//	defer fake.Close()
+//
+//	fake.AddUser(zebedee.User{Name: "Editor", Email: "editor@ons.gov.uk"}, "password", zebedee.Permissions{Editor: true})
+//	cli := zebedee.NewClient(fake.URL, fake.HttpClient())
+package zebedeetest
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/ONSdigital/dp-net/v2/request"
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+)
+
+// Server is an in-memory fake Zebedee CMS. All state is held in memory and lost when the server is closed.
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	users       map[string]*user
+	sessions    map[string]string
+	teams       map[string]*zebedee.Team
+	collections map[string]*collection
+	published   map[string][]byte
+	nextTeamID  int
+}
+
+type user struct {
+	zebedee.User
+	password    string
+	permissions zebedee.Permissions
+}
+
+// NewServer starts a new fake Zebedee server. The caller must call Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		users:       make(map[string]*user),
+		sessions:    make(map[string]string),
+		teams:       make(map[string]*zebedee.Team),
+		collections: make(map[string]*collection),
+		published:   make(map[string][]byte),
+	}
+
+	s.Server = httptest.NewServer(s.routes())
+	return s
+}
+
+func (s *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST /login", s.login)
+	mux.HandleFunc("POST /password", s.setPassword)
+	mux.HandleFunc("GET /permission", s.authenticated(s.getPermissions))
+	mux.HandleFunc("POST /permission", s.authenticated(s.setPermissions))
+
+	mux.HandleFunc("GET /users", s.authenticated(s.getUsers))
+	mux.HandleFunc("POST /users", s.authenticated(s.createUser))
+	mux.HandleFunc("DELETE /users", s.authenticated(s.deleteUser))
+
+	mux.HandleFunc("GET /teams", s.authenticated(s.listTeams))
+	mux.HandleFunc("GET /teams/{name}", s.authenticated(s.getTeam))
+	mux.HandleFunc("POST /teams/{name}", s.authenticated(s.postTeam))
+	mux.HandleFunc("DELETE /teams/{name}", s.authenticated(s.deleteTeam))
+
+	mux.HandleFunc("GET /ListKeyring", s.authenticated(s.listKeyring))
+
+	mux.HandleFunc("GET /collections", s.authenticated(s.getCollections))
+	mux.HandleFunc("POST /collection", s.authenticated(s.createCollection))
+	mux.HandleFunc("GET /collection/{id}", s.authenticated(s.getCollection))
+	mux.HandleFunc("PUT /collection/{id}", s.authenticated(s.updateCollection))
+	mux.HandleFunc("DELETE /collection/{id}", s.authenticated(s.deleteCollection))
+	mux.HandleFunc("GET /collectionDetails/{id}", s.authenticated(s.getCollectionDetails))
+
+	mux.HandleFunc("GET /content/{id}", s.authenticated(s.getContent))
+	mux.HandleFunc("POST /content/{id}", s.authenticated(s.updateContent))
+	mux.HandleFunc("DELETE /content/{id}", s.authenticated(s.deleteContent))
+	mux.HandleFunc("POST /complete/{id}", s.authenticated(s.completeContent))
+	mux.HandleFunc("POST /review/{id}", s.authenticated(s.reviewContent))
+	mux.HandleFunc("POST /approve/{id}", s.authenticated(s.approveCollection))
+	mux.HandleFunc("POST /unlock/{id}", s.authenticated(s.unlockCollection))
+	mux.HandleFunc("POST /publish/{id}", s.authenticated(s.publishCollection))
+
+	mux.HandleFunc("GET /resource", s.authenticated(s.getPublishedResource))
+	mux.HandleFunc("GET /data", s.authenticated(s.getPublishedData))
+	mux.HandleFunc("GET /taxonomy", s.authenticated(s.getPublishedChildren))
+
+	return mux
+}
+
+// HttpClient returns a zebedee.HttpClient that sends requests to the fake server without any retries.
+func (s *Server) HttpClient() zebedee.HttpClient {
+	return &httpClient{cli: s.Client()}
+}
+
+type httpClient struct {
+	cli *http.Client
+}
+
+func (c *httpClient) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return c.cli.Do(req.WithContext(ctx))
+}
+
+// AddUser adds a user with the password and permissions provided.
+func (s *Server) AddUser(u zebedee.User, password string, p zebedee.Permissions) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p.Email = u.Email
+	s.users[u.Email] = &user{User: u, password: password, permissions: p}
+}
+
+// NewSession opens a session for an existing user without logging in, returning the session or false if the user
+// does not exist.
+func (s *Server) NewSession(email string) (zebedee.Session, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[email]; !ok {
+		return zebedee.Session{}, false
+	}
+
+	return zebedee.Session{Email: email, ID: s.newSessionLocked(email)}, true
+}
+
+// ExpireSessions invalidates all open sessions, so subsequent requests receive 401 responses.
+func (s *Server) ExpireSessions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sessions = make(map[string]string)
+}
+
+// SetPublishedContent sets the published content at the URI.
+func (s *Server) SetPublishedContent(uri string, content []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.published[uri] = content
+}
+
+// PublishedContent returns the published content at the URI, or false if nothing is published there.
+func (s *Server) PublishedContent(uri string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.published[uri]
+	return b, ok
+}
+
+func (s *Server) newSessionLocked(email string) string {
+	token := newID()
+	s.sessions[token] = email
+	return token
+}
+
+// authenticated wrap a handler with session validation, passing the authenticated user to the handler
+func (s *Server) authenticated(h func(w http.ResponseWriter, r *http.Request, u *user)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		u := s.sessionUser(r)
+		if u == nil {
+			writeError(w, http.StatusUnauthorized, "Please log in")
+			return
+		}
+
+		h(w, r, u)
+	}
+}
+
+// sessionUser return the user for the session token in the request, or nil if there is no valid session
+func (s *Server) sessionUser(r *http.Request) *user {
+	email, ok := s.sessions[r.Header.Get(request.FlorenceHeaderKey)]
+	if !ok {
+		return nil
+	}
+	return s.users[email]
+}
+
+func (s *Server) login(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var c zebedee.Credentials
+	if !readJSON(w, r, &c) {
+		return
+	}
+
+	u, ok := s.users[c.Email]
+	if !ok || u.password != c.Password || u.Inactive {
+		writeError(w, http.StatusUnauthorized, "Authentication failed")
+		return
+	}
+
+	if u.TemporaryPassword {
+		writeError(w, http.StatusExpectationFailed, "Password change required")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = io.WriteString(w, s.newSessionLocked(u.Email))
+}
+
+// writeJSON write the entity as a JSON response with a 200 status
+func writeJSON(w http.ResponseWriter, entity interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(entity)
+}
+
+// writeError write a Zebedee style JSON error response
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"message":    message,
+		"statusCode": status,
+	})
+}
+
+// readJSON decode the request body into the entity, writing a 400 response and returning false if it is invalid
+func readJSON(w http.ResponseWriter, r *http.Request, entity interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(entity); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+func newID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,367 @@
+package zebedeetest_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee/zebedeetest"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const (
+	adminEmail    = "admin@ons.gov.uk"
+	editorEmail   = "editor@ons.gov.uk"
+	reviewerEmail = "reviewer@ons.gov.uk"
+	password      = "one two three four"
+	pageURI       = "/about/contactus/data.json"
+	pageJSON      = `{"type":"static_page","description":{"title":"Contact us"},"uri":"/about/contactus"}`
+)
+
+func newFake() (*zebedeetest.Server, zebedee.Client) {
+	fake := zebedeetest.NewServer()
+	fake.AddUser(zebedee.User{Name: "Admin", Email: adminEmail}, password, zebedee.Permissions{Admin: true, Editor: true})
+	fake.AddUser(zebedee.User{Name: "Editor", Email: editorEmail}, password, zebedee.Permissions{Editor: true})
+	fake.AddUser(zebedee.User{Name: "Reviewer", Email: reviewerEmail}, password, zebedee.Permissions{Editor: true})
+	return fake, zebedee.NewClient(fake.URL, fake.HttpClient())
+}
+
+func openSession(cli zebedee.Client, email string) zebedee.Session {
+	s, err := cli.OpenSession(zebedee.Credentials{Email: email, Password: password})
+	So(err, ShouldBeNil)
+	So(s.ID, ShouldNotBeEmpty)
+	return s
+}
+
+func pageContent() interface{} {
+	var content interface{}
+	So(json.Unmarshal([]byte(pageJSON), &content), ShouldBeNil)
+	return content
+}
+
+func TestServer_PublishWorkflow(t *testing.T) {
+	Convey("Given a fake Zebedee with an editor and a reviewer", t, func() {
+		fake, cli := newFake()
+		defer fake.Close()
+
+		editor := openSession(cli, editorEmail)
+		reviewer := openSession(cli, reviewerEmail)
+
+		Convey("When a collection is created and content is added", func() {
+			col, err := cli.CreateCollection(editor, zebedee.NewCollection("Contact us update"))
+			So(err, ShouldBeNil)
+			So(col.ID, ShouldStartWith, "contactusupdate-")
+
+			err = cli.UpdateCollectionContent(editor, col.ID, pageURI, pageContent())
+			So(err, ShouldBeNil)
+
+			Convey("Then the content is in progress", func() {
+				details, err := cli.GetCollectionDetails(editor, col.ID)
+				So(err, ShouldBeNil)
+				So(details.InProgress, ShouldHaveLength, 1)
+				So(details.InProgress[0].URI, ShouldEqual, pageURI)
+				So(details.InProgress[0].Type, ShouldEqual, "static_page")
+				So(details.InProgress[0].Description.Title, ShouldEqual, "Contact us")
+
+				b, err := cli.GetContent(editor, col.ID, pageURI)
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqualJSON, pageJSON)
+			})
+
+			Convey("Then the collection cannot be approved", func() {
+				err := cli.ApproveCollection(editor, col.ID)
+				So(errors.Is(err, zebedee.ErrConflict), ShouldBeTrue)
+			})
+
+			Convey("Then content that is not complete cannot be reviewed", func() {
+				err := cli.ReviewCollectionContent(reviewer, col.ID, pageURI)
+				So(errors.Is(err, zebedee.ErrValidation), ShouldBeTrue)
+			})
+
+			Convey("And the content is completed", func() {
+				So(cli.CompleteCollectionContent(editor, col.ID, pageURI), ShouldBeNil)
+
+				Convey("Then the editor cannot review their own content", func() {
+					err := cli.ReviewCollectionContent(editor, col.ID, pageURI)
+					So(errors.Is(err, zebedee.ErrForbidden), ShouldBeTrue)
+				})
+
+				Convey("Then a different user can review the content, approve and publish the collection", func() {
+					So(cli.ReviewCollectionContent(reviewer, col.ID, pageURI), ShouldBeNil)
+
+					desc, err := cli.GetCollectionByID(editor, col.ID)
+					So(err, ShouldBeNil)
+					So(desc.ReviewedUris, ShouldResemble, []string{pageURI})
+
+					So(cli.ApproveCollection(editor, col.ID), ShouldBeNil)
+
+					err = cli.UpdateCollectionContent(editor, col.ID, pageURI, pageContent())
+					So(errors.Is(err, zebedee.ErrCollectionLocked), ShouldBeTrue)
+
+					So(cli.PublishCollection(editor, col.ID), ShouldBeNil)
+
+					desc, err = cli.GetCollectionByID(editor, col.ID)
+					So(err, ShouldBeNil)
+					So(desc.PublishComplete, ShouldBeTrue)
+					So(desc.PublishResults, ShouldHaveLength, 1)
+					So(desc.PublishResults[0].Transactions.UriInfos[0].URI, ShouldEqual, pageURI)
+
+					published, ok := fake.PublishedContent(pageURI)
+					So(ok, ShouldBeTrue)
+					So(string(published), ShouldEqualJSON, pageJSON)
+				})
+
+				Convey("Then editing the content returns it to in progress", func() {
+					So(cli.UpdateCollectionContent(editor, col.ID, pageURI, pageContent()), ShouldBeNil)
+
+					desc, err := cli.GetCollectionByID(editor, col.ID)
+					So(err, ShouldBeNil)
+					So(desc.InProgressUris, ShouldResemble, []string{pageURI})
+					So(desc.CompleteUris, ShouldBeEmpty)
+				})
+			})
+		})
+
+		Convey("When a collection is approved and then unlocked", func() {
+			col, err := cli.CreateCollection(editor, zebedee.NewCollection("Empty"))
+			So(err, ShouldBeNil)
+			So(cli.ApproveCollection(editor, col.ID), ShouldBeNil)
+			So(cli.UnlockCollection(editor, col.ID), ShouldBeNil)
+
+			Convey("Then content can be added again", func() {
+				So(cli.UpdateCollectionContent(editor, col.ID, pageURI, pageContent()), ShouldBeNil)
+			})
+		})
+
+		Convey("When an unapproved collection is published", func() {
+			col, err := cli.CreateCollection(editor, zebedee.NewCollection("Not approved"))
+			So(err, ShouldBeNil)
+			err = cli.PublishCollection(editor, col.ID)
+
+			Convey("Then a conflict is returned", func() {
+				So(errors.Is(err, zebedee.ErrConflict), ShouldBeTrue)
+				So(errors.Is(err, zebedee.ErrCollectionLocked), ShouldBeFalse)
+			})
+		})
+
+		Convey("When the same content is added to two collections", func() {
+			first, err := cli.CreateCollection(editor, zebedee.NewCollection("First"))
+			So(err, ShouldBeNil)
+			second, err := cli.CreateCollection(editor, zebedee.NewCollection("Second"))
+			So(err, ShouldBeNil)
+
+			So(cli.UpdateCollectionContent(editor, first.ID, pageURI, pageContent()), ShouldBeNil)
+			err = cli.UpdateCollectionContent(editor, second.ID, pageURI, pageContent())
+
+			Convey("Then a conflict is returned", func() {
+				So(errors.Is(err, zebedee.ErrConflict), ShouldBeTrue)
+			})
+		})
+
+		Convey("When invalid page JSON is added", func() {
+			col, err := cli.CreateCollection(editor, zebedee.NewCollection("Invalid"))
+			So(err, ShouldBeNil)
+			err = cli.UpdateCollectionContent(editor, col.ID, pageURI, map[string]string{"title": "no type"})
+
+			Convey("Then a validation error is returned", func() {
+				So(errors.Is(err, zebedee.ErrValidation), ShouldBeTrue)
+			})
+		})
+
+		Convey("When a binary file is uploaded", func() {
+			col, err := cli.CreateCollection(editor, zebedee.NewCollection("Files"))
+			So(err, ShouldBeNil)
+
+			png := []byte{0x89, 'P', 'N', 'G', 0x0d, 0x0a, 0x1a, 0x0a, 0x00, 0xff}
+			So(cli.UploadFile(editor, col.ID, "/about/contactus/logo.png", bytes.NewReader(png), ""), ShouldBeNil)
+
+			Convey("Then the same bytes are downloaded", func() {
+				body, err := cli.DownloadFile(editor, col.ID, "/about/contactus/logo.png")
+				So(err, ShouldBeNil)
+				defer body.Close()
+
+				b, err := io.ReadAll(body)
+				So(err, ShouldBeNil)
+				So(b, ShouldResemble, png)
+			})
+
+			Convey("Then the file is in progress in the collection", func() {
+				desc, err := cli.GetCollectionByID(editor, col.ID)
+				So(err, ShouldBeNil)
+				So(desc.InProgressUris, ShouldResemble, []string{"/about/contactus/logo.png"})
+			})
+		})
+
+		Convey("When collections are listed, updated and deleted", func() {
+			col, err := cli.CreateCollection(editor, zebedee.NewCollection("Listed"))
+			So(err, ShouldBeNil)
+
+			col.Name = "Renamed"
+			col.Teams = []string{"Economy"}
+			So(cli.UpdateCollection(editor, col), ShouldBeNil)
+
+			list, err := cli.GetCollections(editor)
+			So(err, ShouldBeNil)
+			So(list, ShouldHaveLength, 1)
+			So(list[0].Name, ShouldEqual, "Renamed")
+			So(list[0].Teams, ShouldResemble, []string{"Economy"})
+
+			keys, err := cli.ListUserKeyring(editor)
+			So(err, ShouldBeNil)
+			So(keys, ShouldResemble, []string{col.ID})
+
+			So(cli.DeleteCollection(editor, col.ID), ShouldBeNil)
+
+			Convey("Then the deleted collection is not found", func() {
+				_, err := cli.GetCollectionByID(editor, col.ID)
+				So(errors.Is(err, zebedee.ErrNotFound), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestServer_UsersAndTeams(t *testing.T) {
+	Convey("Given a fake Zebedee with an admin user", t, func() {
+		fake, cli := newFake()
+		defer fake.Close()
+
+		admin := openSession(cli, adminEmail)
+
+		Convey("When a new user is created and given a temporary password", func() {
+			newUser := zebedee.User{Name: "New User", Email: "new.user+cms@ons.gov.uk"}
+			created, err := cli.CreateUser(admin, newUser)
+			So(err, ShouldBeNil)
+			So(created.Email, ShouldEqual, newUser.Email)
+
+			So(cli.SetPassword(admin, zebedee.Credentials{Email: newUser.Email, Password: "temporary"}), ShouldBeNil)
+			So(cli.SetPermissions(admin, zebedee.Permissions{Email: newUser.Email, Editor: true}), ShouldBeNil)
+
+			Convey("Then the user must change their password before logging in", func() {
+				u, err := cli.GetUser(admin, newUser.Email)
+				So(err, ShouldBeNil)
+				So(u.TemporaryPassword, ShouldBeTrue)
+				So(u.LastAdmin, ShouldEqual, adminEmail)
+
+				_, err = cli.OpenSession(zebedee.Credentials{Email: newUser.Email, Password: "temporary"})
+				var apiErr *zebedee.APIError
+				So(errors.As(err, &apiErr), ShouldBeTrue)
+				So(apiErr.ActualStatus, ShouldEqual, http.StatusExpectationFailed)
+
+				So(cli.SetPassword(zebedee.Session{}, zebedee.Credentials{Email: newUser.Email, Password: password, OldPassword: "temporary"}), ShouldBeNil)
+
+				s := openSession(cli, newUser.Email)
+				p, err := cli.GetPermissions(s, newUser.Email)
+				So(err, ShouldBeNil)
+				So(p.Editor, ShouldBeTrue)
+				So(p.Admin, ShouldBeFalse)
+			})
+
+			Convey("Then the user can be added to and removed from a team", func() {
+				ok, err := cli.CreateTeam(admin, "Economic Statistics")
+				So(err, ShouldBeNil)
+				So(ok, ShouldBeTrue)
+
+				So(cli.AddTeamMember(admin, "Economic Statistics", newUser.Email), ShouldBeNil)
+
+				team, err := cli.GetTeam(admin, "Economic Statistics")
+				So(err, ShouldBeNil)
+				So(team.Members, ShouldResemble, []string{newUser.Email})
+
+				So(cli.RemoveTeamMember(admin, "Economic Statistics", newUser.Email), ShouldBeNil)
+				teams, err := cli.ListTeams(admin)
+				So(err, ShouldBeNil)
+				So(teams.Teams, ShouldHaveLength, 1)
+				So(teams.Teams[0].Members, ShouldBeEmpty)
+
+				So(cli.DeleteTeam(admin, "Economic Statistics"), ShouldBeNil)
+				_, err = cli.GetTeam(admin, "Economic Statistics")
+				So(errors.Is(err, zebedee.ErrNotFound), ShouldBeTrue)
+			})
+
+			Convey("Then the user can be deleted", func() {
+				So(cli.DeleteUser(admin, newUser.Email), ShouldBeNil)
+
+				users, err := cli.GetUsers(admin)
+				So(err, ShouldBeNil)
+				So(users, ShouldHaveLength, 3)
+			})
+		})
+
+		Convey("When a non admin user creates a user", func() {
+			editor := openSession(cli, editorEmail)
+			_, err := cli.CreateUser(editor, zebedee.User{Name: "Someone", Email: "someone@ons.gov.uk"})
+
+			Convey("Then an unauthorized error is returned", func() {
+				So(errors.Is(err, zebedee.ErrUnauthorized), ShouldBeTrue)
+			})
+		})
+
+		Convey("When the sessions expire", func() {
+			fake.ExpireSessions()
+			_, err := cli.GetUsers(admin)
+
+			Convey("Then an unauthorized error is returned", func() {
+				So(errors.Is(err, zebedee.ErrUnauthorized), ShouldBeTrue)
+			})
+		})
+
+		Convey("When an incorrect password is used", func() {
+			_, err := cli.OpenSession(zebedee.Credentials{Email: adminEmail, Password: "wrong"})
+
+			Convey("Then an unauthorized error is returned", func() {
+				So(errors.Is(err, zebedee.ErrUnauthorized), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestServer_PublishedContent(t *testing.T) {
+	Convey("Given a fake Zebedee with published content", t, func() {
+		fake, cli := newFake()
+		defer fake.Close()
+
+		fake.SetPublishedContent("/about/data.json", []byte(`{"type":"static_landing_page","description":{"title":"About"},"uri":"/about"}`))
+		fake.SetPublishedContent(pageURI, []byte(pageJSON))
+		fake.SetPublishedContent("/about/contactus/contacts.csv", []byte("name,email\n"))
+		fake.SetPublishedContent("/about/contactus/team/data.json", []byte(`{"type":"static_page","uri":"/about/contactus/team"}`))
+
+		editor := openSession(cli, editorEmail)
+
+		Convey("Then the page JSON can be read by page URI", func() {
+			b, err := cli.GetPublishedData(editor, "/about/contactus")
+			So(err, ShouldBeNil)
+			So(string(b), ShouldEqualJSON, pageJSON)
+		})
+
+		Convey("Then a published file can be read by URI", func() {
+			body, info, err := cli.GetPublishedContentStream(context.Background(), editor, "/about/contactus/contacts.csv")
+			So(err, ShouldBeNil)
+			defer body.Close()
+
+			b, err := io.ReadAll(body)
+			So(err, ShouldBeNil)
+			So(string(b), ShouldEqual, "name,email\n")
+			So(info.ContentType, ShouldStartWith, "text/csv")
+		})
+
+		Convey("Then only the pages directly below a URI are listed", func() {
+			nodes, err := cli.ListPublishedChildren(editor, "/about")
+			So(err, ShouldBeNil)
+			So(nodes, ShouldHaveLength, 1)
+			So(nodes[0].URI, ShouldEqual, "/about/contactus")
+			So(nodes[0].Type, ShouldEqual, "static_page")
+			So(nodes[0].Description.Title, ShouldEqual, "Contact us")
+		})
+
+		Convey("Then reading unpublished content returns a not found error", func() {
+			_, err := cli.GetPublishedContent(editor, "/about/missing/data.json")
+			So(errors.Is(err, zebedee.ErrNotFound), ShouldBeTrue)
+		})
+	})
+}
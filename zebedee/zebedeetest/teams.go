@@ -0,0 +1,113 @@
+package zebedeetest
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+)
+
+func (s *Server) listTeams(w http.ResponseWriter, _ *http.Request, _ *user) {
+	list := zebedee.TeamsList{Teams: make([]zebedee.Team, 0, len(s.teams))}
+	for _, t := range s.teams {
+		list.Teams = append(list.Teams, copyTeam(t))
+	}
+	sort.Slice(list.Teams, func(i, j int) bool {
+		return list.Teams[i].Name < list.Teams[j].Name
+	})
+
+	writeJSON(w, list)
+}
+
+func (s *Server) getTeam(w http.ResponseWriter, r *http.Request, _ *user) {
+	t, ok := s.teams[r.PathValue("name")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Team not found")
+		return
+	}
+
+	writeJSON(w, copyTeam(t))
+}
+
+// postTeam adds a member to the team if an email is provided, otherwise creates the team
+func (s *Server) postTeam(w http.ResponseWriter, r *http.Request, caller *user) {
+	if !caller.permissions.Admin {
+		writeError(w, http.StatusUnauthorized, "You do not have the right permission")
+		return
+	}
+
+	name := r.PathValue("name")
+	email := r.URL.Query().Get("email")
+
+	if email == "" {
+		if _, exists := s.teams[name]; exists {
+			writeError(w, http.StatusConflict, "Team "+name+" already exists")
+			return
+		}
+
+		s.nextTeamID++
+		s.teams[name] = &zebedee.Team{ID: strconv.Itoa(s.nextTeamID), Name: name, Members: []string{}}
+		writeJSON(w, true)
+		return
+	}
+
+	t, ok := s.teams[name]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Team not found")
+		return
+	}
+
+	if _, ok := s.users[email]; !ok {
+		writeError(w, http.StatusNotFound, "User not found")
+		return
+	}
+
+	for _, m := range t.Members {
+		if m == email {
+			writeJSON(w, true)
+			return
+		}
+	}
+
+	t.Members = append(t.Members, email)
+	writeJSON(w, true)
+}
+
+// deleteTeam removes a member from the team if an email is provided, otherwise deletes the team
+func (s *Server) deleteTeam(w http.ResponseWriter, r *http.Request, caller *user) {
+	if !caller.permissions.Admin {
+		writeError(w, http.StatusUnauthorized, "You do not have the right permission")
+		return
+	}
+
+	name := r.PathValue("name")
+	t, ok := s.teams[name]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Team not found")
+		return
+	}
+
+	email := r.URL.Query().Get("email")
+	if email == "" {
+		delete(s.teams, name)
+		writeJSON(w, true)
+		return
+	}
+
+	members := t.Members[:0]
+	for _, m := range t.Members {
+		if m != email {
+			members = append(members, m)
+		}
+	}
+	t.Members = members
+
+	writeJSON(w, true)
+}
+
+func copyTeam(t *zebedee.Team) zebedee.Team {
+	c := *t
+	c.Members = append([]string{}, t.Members...)
+	return c
+}
@@ -0,0 +1,154 @@
+package zebedeetest
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/ONSdigital/dp-zebedee-sdk-go/zebedee"
+)
+
+// setPassword lets a user change their own password using their old password, without needing a session, or lets an
+// admin set another user's password
+func (s *Server) setPassword(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var c zebedee.Credentials
+	if !readJSON(w, r, &c) {
+		return
+	}
+
+	target, ok := s.users[c.Email]
+	if !ok {
+		writeError(w, http.StatusNotFound, "User not found")
+		return
+	}
+
+	caller := s.sessionUser(r)
+	switch {
+	case c.OldPassword != "" && (caller == nil || caller.Email == c.Email):
+		if target.password != c.OldPassword {
+			writeError(w, http.StatusUnauthorized, "Authentication failed")
+			return
+		}
+		target.TemporaryPassword = false
+	case caller != nil && caller.permissions.Admin:
+		// an admin setting another user's password forces them to change it on next login
+		target.TemporaryPassword = true
+		target.LastAdmin = caller.Email
+	case caller == nil:
+		writeError(w, http.StatusUnauthorized, "Please log in")
+		return
+	default:
+		writeError(w, http.StatusUnauthorized, "You do not have the right permission")
+		return
+	}
+
+	target.password = c.Password
+	target.Inactive = false
+	writeJSON(w, "Password updated for "+c.Email)
+}
+
+func (s *Server) getPermissions(w http.ResponseWriter, r *http.Request, _ *user) {
+	u, ok := s.users[r.URL.Query().Get("email")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "User not found")
+		return
+	}
+
+	writeJSON(w, u.permissions)
+}
+
+func (s *Server) setPermissions(w http.ResponseWriter, r *http.Request, caller *user) {
+	if !caller.permissions.Admin {
+		writeError(w, http.StatusUnauthorized, "You do not have the right permission")
+		return
+	}
+
+	var p zebedee.Permissions
+	if !readJSON(w, r, &p) {
+		return
+	}
+
+	u, ok := s.users[p.Email]
+	if !ok {
+		writeError(w, http.StatusNotFound, "User not found")
+		return
+	}
+
+	u.permissions = p
+	writeJSON(w, "Permissions updated for "+p.Email)
+}
+
+func (s *Server) getUsers(w http.ResponseWriter, r *http.Request, _ *user) {
+	if email := r.URL.Query().Get("email"); email != "" {
+		u, ok := s.users[email]
+		if !ok {
+			writeError(w, http.StatusNotFound, "User not found")
+			return
+		}
+		writeJSON(w, u.User)
+		return
+	}
+
+	users := make([]zebedee.User, 0, len(s.users))
+	for _, u := range s.users {
+		users = append(users, u.User)
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Email < users[j].Email
+	})
+
+	writeJSON(w, users)
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request, caller *user) {
+	if !caller.permissions.Admin {
+		writeError(w, http.StatusUnauthorized, "You do not have the right permission")
+		return
+	}
+
+	var u zebedee.User
+	if !readJSON(w, r, &u) {
+		return
+	}
+
+	if u.Email == "" || u.Name == "" {
+		writeError(w, http.StatusBadRequest, "User details cannot be blank")
+		return
+	}
+
+	if _, exists := s.users[u.Email]; exists {
+		writeError(w, http.StatusConflict, "User "+u.Email+" already exists")
+		return
+	}
+
+	u.Inactive = true
+	u.TemporaryPassword = true
+	u.LastAdmin = caller.Email
+	s.users[u.Email] = &user{User: u, permissions: zebedee.Permissions{Email: u.Email}}
+
+	writeJSON(w, u)
+}
+
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request, caller *user) {
+	if !caller.permissions.Admin {
+		writeError(w, http.StatusUnauthorized, "You do not have the right permission")
+		return
+	}
+
+	email := r.URL.Query().Get("email")
+	if _, ok := s.users[email]; !ok {
+		writeError(w, http.StatusNotFound, "User not found")
+		return
+	}
+
+	delete(s.users, email)
+	for token, e := range s.sessions {
+		if e == email {
+			delete(s.sessions, token)
+		}
+	}
+
+	writeJSON(w, true)
+}